@@ -9,6 +9,12 @@ func SchemaLocation() *schema.Schema {
 	return location.Schema()
 }
 
+// SchemaLocationForResourceType returns the Schema for a Location field which validates that the ARM
+// Resource Type (e.g. `Microsoft.Compute/virtualMachines`) is available in the specified Location
+func SchemaLocationForResourceType(resourceType string) *schema.Schema {
+	return location.SchemaForResourceType(resourceType)
+}
+
 func SchemaLocationOptional() *schema.Schema {
 	return location.SchemaOptional()
 }
@@ -9,6 +9,8 @@ import (
 
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/fakearm"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
@@ -51,12 +53,19 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// recorder is used to record/replay the requests made to Azure, when enabled
+	recorder *recording.Recorder
 }
 
 // BuildTestData generates some test data for the given resource
 func BuildTestData(t *testing.T, resourceType string, resourceLabel string) TestData {
 	EnsureProvidersAreInitialised()
 
+	if fakearm.Enabled() {
+		configureOfflineEnvironment()
+	}
+
 	env, err := Environment()
 	if err != nil {
 		t.Fatalf("Error retrieving Environment: %+v", err)
@@ -84,6 +93,14 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		}
 	}
 
+	// there's no value in recording the requests made to the Fake ARM Server
+	if !fakearm.Enabled() {
+		if recorder := recorderForTest(t); recorder != nil {
+			testData.recorder = recorder
+			testData.recordTestData(t)
+		}
+	}
+
 	return testData
 }
 
@@ -122,5 +139,10 @@ func (td *TestData) RandomStringOfLength(len int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
-	return acctest.RandString(len)
+	value := acctest.RandString(len)
+	if td.recorder != nil {
+		return td.recorder.Variable(fmt.Sprintf("RandomStringOfLength%d", len), value)
+	}
+
+	return value
 }
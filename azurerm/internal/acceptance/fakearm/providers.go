@@ -0,0 +1,78 @@
+package fakearm
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// handleProviders handles listing, retrieving and registering Resource Providers, where
+// segments is `subscriptions/{id}/providers[/{namespace}[/register]]`
+func (s *Server) handleProviders(w http.ResponseWriter, req *http.Request, segments []string) {
+	subscriptionId := segments[1]
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(segments) == 3 {
+		if req.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s is not supported for Resource Providers", req.Method))
+			return
+		}
+
+		namespaces := make([]string, 0)
+		for k := range s.providers {
+			namespaces = append(namespaces, k)
+		}
+		sort.Strings(namespaces)
+
+		values := make([]interface{}, 0)
+		for _, namespace := range namespaces {
+			values = append(values, providerBody(subscriptionId, namespace, s.providers[namespace]))
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": values,
+		})
+		return
+	}
+
+	namespace := s.providerNamespace(segments[3])
+	if namespace == "" {
+		writeError(w, http.StatusNotFound, "InvalidResourceNamespace", fmt.Sprintf("the Resource Provider %q was not found", segments[3]))
+		return
+	}
+
+	switch {
+	case len(segments) == 4 && req.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, providerBody(subscriptionId, namespace, s.providers[namespace]))
+
+	case len(segments) == 5 && strings.EqualFold(segments[4], "register") && req.Method == http.MethodPost:
+		s.providers[namespace] = "Registered"
+		writeJSON(w, http.StatusOK, providerBody(subscriptionId, namespace, s.providers[namespace]))
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s is not supported for %q", req.Method, req.URL.Path))
+	}
+}
+
+// providerNamespace returns the casing of the Resource Provider used by the Server, or an
+// empty string if this Resource Provider doesn't exist
+func (s *Server) providerNamespace(input string) string {
+	for k := range s.providers {
+		if strings.EqualFold(k, input) {
+			return k
+		}
+	}
+
+	return ""
+}
+
+func providerBody(subscriptionId, namespace, registrationState string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                fmt.Sprintf("/subscriptions/%s/providers/%s", subscriptionId, namespace),
+		"namespace":         namespace,
+		"registrationState": registrationState,
+		"resourceTypes":     []interface{}{},
+	}
+}
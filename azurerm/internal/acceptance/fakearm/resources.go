@@ -0,0 +1,288 @@
+package fakearm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// operationsNamespace is the (fake) Resource Provider used for long-running operations
+const operationsNamespace = "Microsoft.FakeResourceManager"
+
+// handleResource handles a request to a Resource ID (when segments contains an even number of
+// segments) or a collection/action (when segments contains an odd number of segments)
+func (s *Server) handleResource(w http.ResponseWriter, req *http.Request, segments []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	id := "/" + strings.Join(segments, "/")
+	if len(segments)%2 != 0 {
+		parentId := "/" + strings.Join(segments[:len(segments)-1], "/")
+		switch req.Method {
+		case http.MethodGet:
+			s.listResources(w, parentId, segments[len(segments)-1])
+		case http.MethodPost:
+			// actions (e.g. `listKeys`) are accepted for any existing resource, but return no data
+			if _, ok := s.resources[strings.ToLower(parentId)]; !ok {
+				writeNotFound(w, parentId)
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{})
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s is not supported for %q", req.Method, id))
+		}
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		existing, ok := s.resources[strings.ToLower(id)]
+		if !ok {
+			writeNotFound(w, id)
+			return
+		}
+		writeJSON(w, http.StatusOK, existing)
+
+	case http.MethodHead:
+		if _, ok := s.resources[strings.ToLower(id)]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	case http.MethodPut:
+		s.putResource(w, req, id, segments)
+
+	case http.MethodPatch:
+		s.patchResource(w, req, id)
+
+	case http.MethodDelete:
+		s.deleteResource(w, req, id)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s is not supported for %q", req.Method, id))
+	}
+}
+
+func (s *Server) putResource(w http.ResponseWriter, req *http.Request, id string, segments []string) {
+	body, err := decodeBody(req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	if parentId := parentResourceId(segments); parentId != "" {
+		if _, ok := s.resources[strings.ToLower(parentId)]; !ok {
+			writeNotFound(w, parentId)
+			return
+		}
+	}
+
+	body["id"] = id
+	body["name"] = segments[len(segments)-1]
+	body["type"] = resourceType(segments)
+	if properties, ok := body["properties"].(map[string]interface{}); ok {
+		properties["provisioningState"] = "Succeeded"
+	}
+
+	_, exists := s.resources[strings.ToLower(id)]
+	s.resources[strings.ToLower(id)] = body
+
+	if exists {
+		writeJSON(w, http.StatusOK, body)
+		return
+	}
+
+	// new resources are created using a long-running operation, which completes after being polled
+	w.Header().Set("Azure-AsyncOperation", s.newOperation(req, segments[1]))
+	w.Header().Set("Retry-After", "0")
+	writeJSON(w, http.StatusCreated, body)
+}
+
+func (s *Server) patchResource(w http.ResponseWriter, req *http.Request, id string) {
+	existing, ok := s.resources[strings.ToLower(id)]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+
+	body, err := decodeBody(req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	for k, v := range body {
+		if k == "id" || k == "name" || k == "type" {
+			continue
+		}
+
+		existingProperties, existingIsMap := existing[k].(map[string]interface{})
+		properties, isMap := v.(map[string]interface{})
+		if k == "properties" && existingIsMap && isMap {
+			for pk, pv := range properties {
+				existingProperties[pk] = pv
+			}
+			continue
+		}
+
+		existing[k] = v
+	}
+
+	writeJSON(w, http.StatusOK, existing)
+}
+
+func (s *Server) deleteResource(w http.ResponseWriter, req *http.Request, id string) {
+	key := strings.ToLower(id)
+	if _, ok := s.resources[key]; !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// deleting a resource also deletes any nested resources, as in Azure
+	for k := range s.resources {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(s.resources, k)
+		}
+	}
+
+	operationUri := s.newOperation(req, strings.Split(strings.Trim(id, "/"), "/")[1])
+	w.Header().Set("Azure-AsyncOperation", operationUri)
+	w.Header().Set("Location", operationUri)
+	w.Header().Set("Retry-After", "0")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// listResources lists the resources of the specified type directly within the specified parent,
+// or all resources within the parent when the type is `resources` (e.g. for a Resource Group)
+func (s *Server) listResources(w http.ResponseWriter, parentId string, typeName string) {
+	prefix := strings.ToLower(parentId) + "/"
+	parentDepth := len(strings.Split(strings.Trim(parentId, "/"), "/"))
+
+	keys := make([]string, 0)
+	for k := range s.resources {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+
+		segments := strings.Split(strings.Trim(k, "/"), "/")
+		if !strings.EqualFold(typeName, "resources") {
+			if len(segments) != parentDepth+2 || !strings.EqualFold(segments[parentDepth], typeName) {
+				continue
+			}
+		}
+
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	values := make([]interface{}, 0)
+	for _, k := range keys {
+		values = append(values, s.resources[k])
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"value": values,
+	})
+}
+
+// newOperation creates a long-running operation and returns the URI used to poll it
+func (s *Server) newOperation(req *http.Request, subscriptionId string) string {
+	s.operationSequence++
+	name := fmt.Sprintf("operation%d", s.operationSequence)
+	s.operations[name] = &operation{
+		status: "InProgress",
+	}
+
+	return fmt.Sprintf("%s/subscriptions/%s/providers/%s/operations/%s", baseUri(req), subscriptionId, operationsNamespace, name)
+}
+
+// handleOperation returns the status of the specified long-running operation, which
+// is `InProgress` when first polled and `Succeeded` from then on
+func (s *Server) handleOperation(w http.ResponseWriter, name string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	op, ok := s.operations[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("the operation %q was not found", name))
+		return
+	}
+
+	if op.polls > 0 {
+		op.status = "Succeeded"
+	}
+	op.polls++
+
+	w.Header().Set("Retry-After", "0")
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"name":   name,
+		"status": op.status,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, id string) {
+	code := "ResourceNotFound"
+	if strings.EqualFold(resourceType(strings.Split(strings.Trim(id, "/"), "/")), "Microsoft.Resources/resourceGroups") {
+		code = "ResourceGroupNotFound"
+	}
+
+	writeError(w, http.StatusNotFound, code, fmt.Sprintf("the resource %q was not found", id))
+}
+
+func decodeBody(req *http.Request) (map[string]interface{}, error) {
+	body := make(map[string]interface{})
+	if req.Body == nil || req.Body == http.NoBody {
+		return body, nil
+	}
+
+	decoder := json.NewDecoder(req.Body)
+	if err := decoder.Decode(&body); err != nil && err.Error() != "EOF" {
+		return nil, fmt.Errorf("parsing request body: %+v", err)
+	}
+
+	return body, nil
+}
+
+// parentResourceId returns the ID of the resource which must exist for the specified resource
+// to be created (e.g. the Resource Group or parent resource), or an empty string if there's none
+func parentResourceId(segments []string) string {
+	// a Resource Group's parent is the Subscription, which always exists
+	if len(segments) <= 4 {
+		return ""
+	}
+
+	parent := segments[:len(segments)-2]
+	if strings.EqualFold(parent[len(parent)-2], "providers") {
+		parent = parent[:len(parent)-2]
+	}
+
+	if len(parent) < 4 {
+		return ""
+	}
+
+	return "/" + strings.Join(parent, "/")
+}
+
+// resourceType returns the Resource Type (e.g. `Microsoft.Network/virtualNetworks/subnets`)
+// for the resource with the specified segments
+func resourceType(segments []string) string {
+	providersIndex := -1
+	for i, v := range segments {
+		if strings.EqualFold(v, "providers") {
+			providersIndex = i
+		}
+	}
+
+	if providersIndex == -1 || providersIndex+2 >= len(segments) {
+		return "Microsoft.Resources/resourceGroups"
+	}
+
+	types := []string{segments[providersIndex+1]}
+	for i := providersIndex + 2; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+
+	return strings.Join(types, "/")
+}
@@ -0,0 +1,175 @@
+package fakearm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+)
+
+// EnvVar is the Environment Variable used to run the Acceptance Tests against the Fake ARM Server
+const EnvVar = "ARM_TEST_FAKE_RESOURCE_MANAGER"
+
+// Enabled returns whether the Acceptance Tests should be run against the Fake ARM Server
+func Enabled() bool {
+	return strings.EqualFold(os.Getenv(EnvVar), "true")
+}
+
+var (
+	sharedServer     *Server
+	sharedServerOnce sync.Once
+
+	sharedEndpoint     string
+	sharedEndpointOnce sync.Once
+)
+
+// Shared returns the Fake ARM Server shared across all tests in this process, such that
+// resources created by the Provider can be retrieved by the test client (e.g. in checks)
+func Shared() *Server {
+	sharedServerOnce.Do(func() {
+		sharedServer = NewServer()
+	})
+	return sharedServer
+}
+
+// Endpoint returns the URI of the Shared Fake ARM Server, which is exposed over HTTP so that it can be
+// used as the Resource Manager Endpoint for both the Provider and the test client
+//
+// NOTE: this listens for the lifetime of the test process, since it's shared across all tests
+func Endpoint() string {
+	sharedEndpointOnce.Do(func() {
+		server := httptest.NewServer(Shared())
+		sharedEndpoint = fmt.Sprintf("%s/", server.URL)
+		log.Printf("[DEBUG] Fake ARM Server listening on %q", sharedEndpoint)
+	})
+	return sharedEndpoint
+}
+
+// Server is an in-process stand-in for Azure Resource Manager, which supports generic
+// PUT/PATCH/GET/HEAD/DELETE operations on Resource IDs (including long-running operations
+// polled via the `Azure-AsyncOperation` header) and Resource Provider registration.
+//
+// Resources are stored as-is, with the `id`, `name` and `type` fields (and the
+// `properties.provisioningState` field when `properties` is present) populated by the Server.
+type Server struct {
+	lock              sync.Mutex
+	resources         map[string]map[string]interface{}
+	providers         map[string]string
+	operations        map[string]*operation
+	operationSequence int
+}
+
+type operation struct {
+	polls  int
+	status string
+}
+
+// NewServer returns a new Fake ARM Server with no resources, where all of the Resource
+// Providers required by the Provider are available but not yet registered
+func NewServer() *Server {
+	providers := make(map[string]string)
+	for k := range resourceproviders.Required() {
+		providers[k] = "NotRegistered"
+	}
+
+	return &Server{
+		resources:  map[string]map[string]interface{}{},
+		providers:  providers,
+		operations: map[string]*operation{},
+	}
+}
+
+// Sender returns a Sender which sends requests directly to this Server (regardless of the host
+// being requested) without any network access - allowing it to be used with the default endpoints
+func (s *Server) Sender() autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		recorder := httptest.NewRecorder()
+		s.ServeHTTP(recorder, req)
+
+		resp := recorder.Result()
+		resp.Request = req
+		return resp, nil
+	})
+}
+
+// ServeHTTP implements http.Handler, such that this Server can also be exposed over HTTP
+// (for example using `httptest.NewServer`)
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	log.Printf("[DEBUG] Fake ARM Server: %s %s", req.Method, req.URL.Path)
+
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		writeError(w, http.StatusNotFound, "InvalidResourceType", fmt.Sprintf("the path %q is not supported", req.URL.Path))
+		return
+	}
+
+	if len(segments) >= 3 && strings.EqualFold(segments[2], "providers") {
+		if len(segments) == 6 && strings.EqualFold(segments[3], operationsNamespace) && strings.EqualFold(segments[4], "operations") {
+			s.handleOperation(w, segments[5])
+			return
+		}
+
+		if len(segments) <= 5 {
+			s.handleProviders(w, req, segments)
+			return
+		}
+	}
+
+	if len(segments) == 2 && req.Method == http.MethodGet {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":             fmt.Sprintf("/subscriptions/%s", segments[1]),
+			"subscriptionId": segments[1],
+			"displayName":    "Fake Subscription",
+			"state":          "Enabled",
+		})
+		return
+	}
+
+	s.handleResource(w, req, segments)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if body == nil {
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("[DEBUG] Fake ARM Server: encoding response: %+v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+// baseUri returns the scheme and host used to make this request, for use in polling URIs
+func baseUri(req *http.Request) string {
+	scheme := req.URL.Scheme
+	if scheme == "" {
+		scheme = "http"
+		if req.TLS != nil {
+			scheme = "https"
+		}
+	}
+
+	host := req.URL.Host
+	if host == "" {
+		host = req.Host
+	}
+
+	return fmt.Sprintf("%s://%s", scheme, host)
+}
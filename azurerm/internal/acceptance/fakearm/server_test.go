@@ -0,0 +1,195 @@
+package fakearm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	legacyResources "github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const subscriptionId = "00000000-0000-0000-0000-000000000000"
+
+func configureClient(client *autorest.Client, server *Server) {
+	client.Sender = server.Sender()
+	client.Authorizer = autorest.NullAuthorizer{}
+}
+
+func TestServerResourceGroupLifecycle(t *testing.T) {
+	ctx := context.TODO()
+	server := NewServer()
+	client := resources.NewGroupsClient(subscriptionId)
+	configureClient(&client.Client, server)
+
+	if _, err := client.Get(ctx, "example"); err == nil {
+		t.Fatalf("expected an error retrieving a Resource Group which doesn't exist but didn't get one")
+	}
+	if resp, err := client.CheckExistence(ctx, "example"); err != nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a 404 checking the existence of a missing Resource Group but got %+v / %+v", resp.Response, err)
+	}
+
+	group, err := client.CreateOrUpdate(ctx, "example", resources.Group{
+		Location: utils.String("westeurope"),
+		Tags: map[string]*string{
+			"env": utils.String("test"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("creating Resource Group: %+v", err)
+	}
+	if *group.ID != "/subscriptions/"+subscriptionId+"/resourcegroups/example" {
+		t.Fatalf("unexpected ID %q", *group.ID)
+	}
+
+	existing, err := client.Get(ctx, "example")
+	if err != nil {
+		t.Fatalf("retrieving Resource Group: %+v", err)
+	}
+	if *existing.Location != "westeurope" || *existing.Tags["env"] != "test" {
+		t.Fatalf("unexpected Resource Group: %+v", existing)
+	}
+	if resp, err := client.CheckExistence(ctx, "example"); err != nil || resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected a 204 checking the existence of the Resource Group but got %+v / %+v", resp.Response, err)
+	}
+
+	future, err := client.Delete(ctx, "example")
+	if err != nil {
+		t.Fatalf("deleting Resource Group: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		t.Fatalf("waiting for the deletion of the Resource Group: %+v", err)
+	}
+
+	if resp, err := client.Get(ctx, "example"); err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a 404 retrieving the deleted Resource Group but got %d / %+v", resp.StatusCode, err)
+	}
+}
+
+func TestServerGenericResourceLongRunningOperations(t *testing.T) {
+	ctx := context.TODO()
+	server := NewServer()
+	groupsClient := resources.NewGroupsClient(subscriptionId)
+	configureClient(&groupsClient.Client, server)
+	client := resources.NewClient(subscriptionId)
+	configureClient(&client.Client, server)
+
+	networkId := "/subscriptions/" + subscriptionId + "/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1"
+	subnetId := networkId + "/subnets/subnet1"
+	parameters := resources.GenericResource{
+		Location: utils.String("westeurope"),
+		Properties: map[string]interface{}{
+			"addressSpace": map[string]interface{}{
+				"addressPrefixes": []interface{}{"10.0.0.0/16"},
+			},
+		},
+	}
+
+	// the Resource Group has to exist first
+	if _, err := client.CreateOrUpdateByID(ctx, networkId, "2020-05-01", parameters); err == nil {
+		t.Fatalf("expected an error creating a resource in a missing Resource Group but didn't get one")
+	}
+
+	if _, err := groupsClient.CreateOrUpdate(ctx, "example", resources.Group{Location: utils.String("westeurope")}); err != nil {
+		t.Fatalf("creating Resource Group: %+v", err)
+	}
+
+	future, err := client.CreateOrUpdateByID(ctx, networkId, "2020-05-01", parameters)
+	if err != nil {
+		t.Fatalf("creating Virtual Network: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		t.Fatalf("waiting for creation of the Virtual Network: %+v", err)
+	}
+
+	network, err := client.GetByID(ctx, networkId, "2020-05-01")
+	if err != nil {
+		t.Fatalf("retrieving Virtual Network: %+v", err)
+	}
+	if *network.Type != "Microsoft.Network/virtualNetworks" || *network.Name != "network1" {
+		t.Fatalf("unexpected Virtual Network: %+v", network)
+	}
+	if v := network.Properties.(map[string]interface{})["provisioningState"]; v != "Succeeded" {
+		t.Fatalf("expected the provisioningState to be `Succeeded` but got %v", v)
+	}
+
+	subnetFuture, err := client.CreateOrUpdateByID(ctx, subnetId, "2020-05-01", resources.GenericResource{
+		Properties: map[string]interface{}{
+			"addressPrefix": "10.0.1.0/24",
+		},
+	})
+	if err != nil {
+		t.Fatalf("creating Subnet: %+v", err)
+	}
+	if err := subnetFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		t.Fatalf("waiting for creation of the Subnet: %+v", err)
+	}
+
+	list, err := client.ListByResourceGroup(ctx, "example", "", "", nil)
+	if err != nil {
+		t.Fatalf("listing resources: %+v", err)
+	}
+	if len(list.Values()) != 2 {
+		t.Fatalf("expected 2 resources in the Resource Group but got %d", len(list.Values()))
+	}
+
+	// deleting the parent resource also deletes nested resources
+	deleteFuture, err := client.DeleteByID(ctx, networkId, "2020-05-01")
+	if err != nil {
+		t.Fatalf("deleting Virtual Network: %+v", err)
+	}
+	if err := deleteFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		t.Fatalf("waiting for deletion of the Virtual Network: %+v", err)
+	}
+	if resp, err := client.GetByID(ctx, subnetId, "2020-05-01"); err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a 404 retrieving the deleted Subnet but got %d / %+v", resp.StatusCode, err)
+	}
+}
+
+func TestServerResourceProviders(t *testing.T) {
+	ctx := context.TODO()
+	server := NewServer()
+	client := legacyResources.NewProvidersClient(subscriptionId)
+	configureClient(&client.Client, server)
+
+	providerList, err := client.List(ctx, nil, "")
+	if err != nil {
+		t.Fatalf("listing Resource Providers: %+v", err)
+	}
+
+	required := resourceproviders.Required()
+	if err := resourceproviders.EnsureRegistered(ctx, client, providerList.Values(), required); err != nil {
+		t.Fatalf("registering Resource Providers: %+v", err)
+	}
+
+	provider, err := client.Get(ctx, "Microsoft.Network", "")
+	if err != nil {
+		t.Fatalf("retrieving Resource Provider: %+v", err)
+	}
+	if *provider.RegistrationState != "Registered" {
+		t.Fatalf("expected the Resource Provider to be Registered but got %q", *provider.RegistrationState)
+	}
+}
+
+func TestSharedServerEndpoint(t *testing.T) {
+	ctx := context.TODO()
+	client := resources.NewGroupsClientWithBaseURI(Endpoint(), subscriptionId)
+	client.Authorizer = autorest.NullAuthorizer{}
+
+	if _, err := client.CreateOrUpdate(ctx, "endpoint-example", resources.Group{
+		Location: utils.String("westeurope"),
+	}); err != nil {
+		t.Fatalf("creating Resource Group via the Endpoint: %+v", err)
+	}
+
+	// the Shared Server should be the one exposed via the Endpoint
+	server := Shared()
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	if _, ok := server.resources["/subscriptions/"+subscriptionId+"/resourcegroups/endpoint-example"]; !ok {
+		t.Fatalf("expected the Resource Group to exist in the Shared Server but it didn't")
+	}
+}
@@ -0,0 +1,101 @@
+package acceptance
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/recording"
+)
+
+var (
+	recorders     = map[*testing.T]*recording.Recorder{}
+	recordersLock = &sync.Mutex{}
+)
+
+// recorderForTest returns the Recorder for this test when the requests made to Azure are being
+// recorded/replayed (as configured via `ARM_TEST_RECORDING_MODE`), otherwise nil
+func recorderForTest(t *testing.T) *recording.Recorder {
+	mode, err := recording.ModeFromEnvironment()
+	if err != nil {
+		t.Fatalf("determining the Recording Mode: %+v", err)
+	}
+	if mode == recording.ModeLive {
+		return nil
+	}
+
+	recordersLock.Lock()
+	defer recordersLock.Unlock()
+
+	// BuildTestData can be called multiple times within a single test, which share a Cassette
+	if recorder, ok := recorders[t]; ok {
+		return recorder
+	}
+
+	recorder, err := recording.NewRecorder(t.Name(), mode)
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+	recorders[t] = recorder
+
+	t.Cleanup(func() {
+		recordersLock.Lock()
+		delete(recorders, t)
+		recordersLock.Unlock()
+		recorder.Deactivate()
+
+		// a failed test can't be replayed, so there's no point recording it
+		if t.Failed() {
+			log.Printf("[DEBUG] Test %q failed - not saving the Cassette", t.Name())
+			return
+		}
+
+		if err := recorder.Save(); err != nil {
+			t.Errorf("saving Cassette: %+v", err)
+		}
+	})
+
+	return recorder
+}
+
+// recordTestData records the random values used in the TestData when recording, or
+// replaces them with the recorded values when replaying
+func (td *TestData) recordTestData(t *testing.T) {
+	randomInteger, err := strconv.Atoi(td.recorder.Variable("RandomInteger", strconv.Itoa(td.RandomInteger)))
+	if err != nil {
+		t.Fatalf("parsing the recorded RandomInteger: %+v", err)
+	}
+	td.RandomInteger = randomInteger
+	td.RandomString = td.recorder.Variable("RandomString", td.RandomString)
+
+	td.Locations = Regions{
+		Primary:   td.recorder.Variable("LocationPrimary", td.Locations.Primary),
+		Secondary: td.recorder.Variable("LocationSecondary", td.Locations.Secondary),
+		Ternary:   td.recorder.Variable("LocationTernary", td.Locations.Ternary),
+	}
+
+	// the shared test client (used in checks) routes requests to this Recorder based on the random values
+	td.recorder.Activate(strconv.Itoa(td.RandomInteger), td.RandomString)
+}
+
+// configureOfflineEnvironment sets placeholder credentials and locations when requests aren't sent to
+// Azure (when replaying requests, or using the Fake ARM Server) - since these are required to configure
+// the Provider, but aren't used
+func configureOfflineEnvironment() {
+	placeholders := map[string]string{
+		"ARM_CLIENT_ID":          recording.RedactedID,
+		"ARM_CLIENT_SECRET":      "offline",
+		"ARM_SUBSCRIPTION_ID":    recording.RedactedID,
+		"ARM_TENANT_ID":          recording.RedactedID,
+		"ARM_TEST_LOCATION":      "westeurope",
+		"ARM_TEST_LOCATION_ALT":  "northeurope",
+		"ARM_TEST_LOCATION_ALT2": "westus2",
+	}
+	for k, v := range placeholders {
+		if os.Getenv(k) == "" {
+			os.Setenv(k, v)
+		}
+	}
+}
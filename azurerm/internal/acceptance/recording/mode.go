@@ -0,0 +1,58 @@
+package recording
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Mode defines whether requests made to Azure are sent to Azure, recorded or replayed
+type Mode string
+
+const (
+	// ModeLive sends all requests to Azure without recording them (the default)
+	ModeLive Mode = "live"
+
+	// ModeRecord sends all requests to Azure and records them to a Cassette once the test completes
+	ModeRecord Mode = "record"
+
+	// ModeReplay replays the requests from a previously recorded Cassette without sending them to Azure
+	ModeReplay Mode = "replay"
+)
+
+const (
+	// ModeEnvVar is the Environment Variable used to configure the Recording Mode
+	ModeEnvVar = "ARM_TEST_RECORDING_MODE"
+
+	// DirectoryEnvVar is the Environment Variable used to override the directory Cassettes are stored in
+	DirectoryEnvVar = "ARM_TEST_RECORDING_DIR"
+
+	// defaultDirectory is the directory (relative to the package being tested) Cassettes are stored in
+	defaultDirectory = "testdata/recordings"
+)
+
+// ModeFromEnvironment returns the Recording Mode defined in the `ARM_TEST_RECORDING_MODE`
+// Environment Variable, defaulting to `live` when this isn't set
+func ModeFromEnvironment() (Mode, error) {
+	v := strings.ToLower(strings.TrimSpace(os.Getenv(ModeEnvVar)))
+	if v == "" {
+		return ModeLive, nil
+	}
+
+	for _, mode := range []Mode{ModeLive, ModeRecord, ModeReplay} {
+		if v == string(mode) {
+			return mode, nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported value %q for `%s` - supported values are `live`, `record` and `replay`", v, ModeEnvVar)
+}
+
+// directory returns the directory Cassettes should be stored in
+func directory() string {
+	if v := os.Getenv(DirectoryEnvVar); v != "" {
+		return v
+	}
+
+	return defaultDirectory
+}
@@ -0,0 +1,263 @@
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// Cassette is the on-disk representation of the requests made to Azure during a test
+type Cassette struct {
+	// Variables contains the randomly generated values used within the test (e.g. the
+	// Random Integer) so that the same configuration is used when replaying
+	Variables map[string]string `json:"variables"`
+
+	// Interactions is a list of the requests made to Azure and the responses returned, in order
+	Interactions []Interaction `json:"interactions"`
+}
+
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder records the requests made to Azure to a Cassette, or replays them from a Cassette
+type Recorder struct {
+	mode     Mode
+	path     string
+	redactor redactor
+
+	lock              sync.Mutex
+	cassette          Cassette
+	replayed          []bool
+	variableSequences map[string]int
+}
+
+// NewRecorder returns a Recorder for the specified test, which in `replay` mode loads the
+// Cassette for this test - the Cassette is stored at `testdata/recordings/{name}.json`
+// relative to the package being tested, unless overridden using `ARM_TEST_RECORDING_DIR`
+func NewRecorder(name string, mode Mode) (*Recorder, error) {
+	if mode != ModeRecord && mode != ModeReplay {
+		return nil, fmt.Errorf("a Recorder can only be used in `record` or `replay` mode but got %q", string(mode))
+	}
+
+	recorder := &Recorder{
+		mode:     mode,
+		path:     filepath.Join(directory(), fmt.Sprintf("%s.json", filepath.FromSlash(name))),
+		redactor: newRedactor(),
+		cassette: Cassette{
+			Variables:    map[string]string{},
+			Interactions: []Interaction{},
+		},
+		variableSequences: map[string]int{},
+	}
+
+	if mode == ModeReplay {
+		contents, err := ioutil.ReadFile(recorder.path)
+		if err != nil {
+			return nil, fmt.Errorf("loading Cassette %q: %+v", recorder.path, err)
+		}
+
+		if err := json.Unmarshal(contents, &recorder.cassette); err != nil {
+			return nil, fmt.Errorf("parsing Cassette %q: %+v", recorder.path, err)
+		}
+
+		recorder.replayed = make([]bool, len(recorder.cassette.Interactions))
+	}
+
+	return recorder, nil
+}
+
+// Mode returns the Mode this Recorder is running in
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Replaying returns whether requests are being replayed, rather than sent to Azure
+func (r *Recorder) Replaying() bool {
+	return r.mode == ModeReplay
+}
+
+// Variable returns the recorded value for the specified variable when replaying, otherwise
+// recording the specified value. Each call for the same name is recorded separately, in order.
+func (r *Recorder) Variable(name string, value string) string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := fmt.Sprintf("%s/%d", name, r.variableSequences[name])
+	r.variableSequences[name]++
+
+	if r.mode == ModeReplay {
+		if v, ok := r.cassette.Variables[key]; ok {
+			return v
+		}
+
+		log.Printf("[WARN] Variable %q was not found in the Cassette %q - using %q", key, r.path, value)
+		return value
+	}
+
+	r.cassette.Variables[key] = value
+	return value
+}
+
+// Sender returns a Sender which records the requests sent using the specified Sender, or
+// which replays the recorded responses without sending the request when replaying
+func (r *Recorder) Sender(sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		if r.mode == ModeReplay {
+			return r.replay(req)
+		}
+
+		return r.record(sender, req)
+	})
+}
+
+// Save writes the recorded requests to the Cassette on disk, this is a no-op when replaying
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	contents, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing Cassette: %+v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return fmt.Errorf("creating directory for Cassette %q: %+v", r.path, err)
+	}
+
+	if err := ioutil.WriteFile(r.path, contents, 0644); err != nil {
+		return fmt.Errorf("writing Cassette %q: %+v", r.path, err)
+	}
+
+	log.Printf("[DEBUG] Recorded %d interactions to %q", len(r.cassette.Interactions), r.path)
+	return nil
+}
+
+func (r *Recorder) record(sender autorest.Sender, req *http.Request) (*http.Response, error) {
+	requestBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %+v", err)
+	}
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		// connection-level errors can't be replayed, so aren't recorded
+		return resp, err
+	}
+
+	responseBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %+v", err)
+	}
+
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    r.redactor.URL(req.URL.String()),
+			Body:   r.redactor.Body(requestBody),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    r.redactor.Headers(resp.Header),
+			Body:       r.redactor.ResponseBody(req, responseBody),
+		},
+	}
+
+	r.lock.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.lock.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	method := req.Method
+	url := r.redactor.URL(req.URL.String())
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// requests are matched on the first unused interaction with the same Method and URL, since
+	// the same request can be made multiple times (e.g. when polling a long-running operation)
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] {
+			continue
+		}
+
+		if interaction.Request.Method != method || !strings.EqualFold(interaction.Request.URL, url) {
+			continue
+		}
+
+		r.replayed[i] = true
+		return interaction.Response.toHttpResponse(req), nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction was found in %q for %s %s", r.path, method, url)
+}
+
+func (r Response) toHttpResponse(req *http.Request) *http.Response {
+	headers := http.Header{}
+	for k, v := range r.Headers {
+		headers[k] = append([]string{}, v...)
+	}
+
+	// there's no need to wait between polling requests when replaying
+	if headers.Get("Retry-After") != "" || r.StatusCode == http.StatusCreated || r.StatusCode == http.StatusAccepted {
+		headers.Set("Retry-After", "0")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          ioutil.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// readBody reads the specified body and then replaces it, so that it can be read again
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+
+	contents, err := ioutil.ReadAll(*body)
+	if err != nil {
+		return "", err
+	}
+	(*body).Close()
+
+	*body = ioutil.NopCloser(bytes.NewReader(contents))
+	return string(contents), nil
+}
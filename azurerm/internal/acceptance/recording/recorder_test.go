@@ -0,0 +1,221 @@
+package recording
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const testSubscriptionId = "11111111-2222-3333-4444-555555555555"
+
+func TestModeFromEnvironment(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected Mode
+		Error    bool
+	}{
+		{
+			Input:    "",
+			Expected: ModeLive,
+		},
+		{
+			Input:    "live",
+			Expected: ModeLive,
+		},
+		{
+			Input:    "Record",
+			Expected: ModeRecord,
+		},
+		{
+			Input:    "REPLAY",
+			Expected: ModeReplay,
+		},
+		{
+			Input: "playback",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		os.Setenv(ModeEnvVar, v.Input)
+		actual, err := ModeFromEnvironment()
+		os.Unsetenv(ModeEnvVar)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", string(v.Expected), string(actual))
+		}
+	}
+}
+
+func TestRecorderRecordThenReplay(t *testing.T) {
+	directory, err := ioutil.TempDir("", "recordings")
+	if err != nil {
+		t.Fatalf("creating temp directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+
+	os.Setenv(DirectoryEnvVar, directory)
+	defer os.Unsetenv(DirectoryEnvVar)
+	os.Setenv("ARM_SUBSCRIPTION_ID", testSubscriptionId)
+	defer os.Unsetenv("ARM_SUBSCRIPTION_ID")
+
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/poll" {
+			polls++
+			w.Header().Set("Retry-After", "10")
+			fmt.Fprintf(w, `{"status":%q}`, map[bool]string{true: "Succeeded", false: "InProgress"}[polls > 1])
+			return
+		}
+
+		w.Header().Set("Set-Cookie", "secret=value")
+		w.Header().Set("Location", fmt.Sprintf("https://example.com/subscriptions/%s/poll", testSubscriptionId))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id":"/subscriptions/%s/resourceGroups/example","access_token":"abc123"}`, testSubscriptionId)
+	}))
+	defer server.Close()
+
+	resourceUrl := fmt.Sprintf("%s/subscriptions/%s/resourceGroups/example", server.URL, testSubscriptionId)
+	pollUrl := fmt.Sprintf("%s/poll", server.URL)
+
+	recorder, err := NewRecorder("TestExample/basic", ModeRecord)
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+	if v := recorder.Variable("RandomInteger", "123"); v != "123" {
+		t.Fatalf("expected the recorded variable to be %q but got %q", "123", v)
+	}
+	sender := recorder.Sender(&http.Client{})
+	first := sendRequests(t, sender, resourceUrl, pollUrl)
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("saving Cassette: %+v", err)
+	}
+
+	contents, err := ioutil.ReadFile(filepath.Join(directory, "TestExample", "basic.json"))
+	if err != nil {
+		t.Fatalf("reading Cassette: %+v", err)
+	}
+	for _, v := range []string{testSubscriptionId, "abc123", "secret=value"} {
+		if strings.Contains(string(contents), v) {
+			t.Fatalf("expected %q to be redacted from the Cassette but it wasn't: %s", v, string(contents))
+		}
+	}
+
+	server.Close()
+	replayer, err := NewRecorder("TestExample/basic", ModeReplay)
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+	if v := replayer.Variable("RandomInteger", "456"); v != "123" {
+		t.Fatalf("expected the replayed variable to be %q but got %q", "123", v)
+	}
+	second := sendRequests(t, replayer.Sender(nil), resourceUrl, pollUrl)
+
+	if second[0].StatusCode != first[0].StatusCode {
+		t.Fatalf("expected the replayed status code to be %d but got %d", first[0].StatusCode, second[0].StatusCode)
+	}
+	if v := second[0].Header.Get("Location"); v != "https://example.com/subscriptions/00000000-0000-0000-0000-000000000000/poll" {
+		t.Fatalf("unexpected replayed `Location` header: %q", v)
+	}
+	if v := second[1].Header.Get("Retry-After"); v != "0" {
+		t.Fatalf("expected the replayed `Retry-After` header to be `0` but got %q", v)
+	}
+	body, _ := ioutil.ReadAll(second[2].Body)
+	if string(body) != `{"status":"Succeeded"}` {
+		t.Fatalf("expected the polling requests to be replayed in order but got %q", string(body))
+	}
+
+	// all of the recorded interactions have been used
+	req, _ := http.NewRequest(http.MethodGet, pollUrl, nil)
+	if _, err := replayer.Sender(nil).Do(req); err == nil {
+		t.Fatalf("expected an error when no recorded interaction exists but didn't get one")
+	}
+}
+
+func TestRecorderReplayMissingCassette(t *testing.T) {
+	os.Setenv(DirectoryEnvVar, "does-not-exist")
+	defer os.Unsetenv(DirectoryEnvVar)
+
+	if _, err := NewRecorder("TestExample", ModeReplay); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func sendRequests(t *testing.T, sender autorest.Sender, resourceUrl, pollUrl string) []*http.Response {
+	out := make([]*http.Response, 0)
+	requests := []struct {
+		method string
+		url    string
+		body   string
+	}{
+		{method: http.MethodPut, url: resourceUrl, body: `{"location":"westeurope"}`},
+		{method: http.MethodGet, url: pollUrl},
+		{method: http.MethodGet, url: pollUrl},
+	}
+	for _, v := range requests {
+		req, err := http.NewRequest(v.method, v.url, strings.NewReader(v.body))
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		resp, err := sender.Do(req)
+		if err != nil {
+			t.Fatalf("sending %s %s: %+v", v.method, v.url, err)
+		}
+		out = append(out, resp)
+	}
+	return out
+}
+
+func TestSharedSenderRoutesToActiveRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	first, _ := NewRecorder("TestFirst", ModeRecord)
+	first.Activate("acctestRG-1234")
+	defer first.Deactivate()
+	second, _ := NewRecorder("TestSecond", ModeRecord)
+	second.Activate("acctestRG-5678")
+	defer second.Deactivate()
+
+	sender := SharedSender(ModeRecord, &http.Client{})
+	for _, path := range []string{"/resourceGroups/acctestRG-5678", "/resourceGroups/other"} {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		if _, err := sender.Do(req); err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+	}
+
+	if len(first.cassette.Interactions) != 0 {
+		t.Fatalf("expected no interactions to be recorded for the first Recorder but got %d", len(first.cassette.Interactions))
+	}
+	if len(second.cassette.Interactions) != 1 {
+		t.Fatalf("expected 1 interaction to be recorded for the second Recorder but got %d", len(second.cassette.Interactions))
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/resourceGroups/other", nil)
+	if _, err := SharedSender(ModeReplay, nil).Do(req); err == nil {
+		t.Fatalf("expected an error when replaying a request without an active Recorder but didn't get one")
+	}
+}
@@ -0,0 +1,185 @@
+package recording
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// RedactedID is the value which Subscription, Tenant and Client IDs are replaced with in a Cassette
+const RedactedID = "00000000-0000-0000-0000-000000000000"
+
+const redactedValue = "REDACTED"
+
+// redactedHeaders are Response Headers which are removed from the Cassette entirely
+var redactedHeaders = []string{
+	"Authorization",
+	"Set-Cookie",
+	"X-Ms-Authorization-Auxiliary",
+}
+
+// tokenRegex matches any tokens/secrets/passwords within a JSON body, e.g. `adminPassword`,
+// `primaryKey`, `sasToken` and `primaryConnectionString`
+var tokenRegex = regexp.MustCompile(`(?i)"([a-z0-9_]*(?:token|secret|password|connectionstring|primarykey|secondarykey|accountkey|accesskey|sharedkey|sharedaccesssignature))"\s*:\s*"(?:[^"\\]|\\.)*"`)
+
+// keyValueRegex matches the `value` of a named key, e.g. `{"keyName":"key1","value":"..."}`
+var keyValueRegex = regexp.MustCompile(`(?i)("keyName"\s*:\s*"[^"]*"\s*,\s*"value")\s*:\s*"(?:[^"\\]|\\.)*"`)
+
+// sasRegex matches the signature of a SAS Token, either within a URI or a JSON body
+var sasRegex = regexp.MustCompile(`(?i)([?&]sig=)[^&"\s]+`)
+
+type redactor struct {
+	ids []*regexp.Regexp
+}
+
+// newRedactor returns a redactor for the Client, Subscription and Tenant IDs used for authentication
+func newRedactor() redactor {
+	ids := make([]*regexp.Regexp, 0)
+	for _, variable := range []string{"ARM_CLIENT_ID", "ARM_SUBSCRIPTION_ID", "ARM_TENANT_ID"} {
+		v := os.Getenv(variable)
+		if v == "" || v == RedactedID {
+			continue
+		}
+
+		ids = append(ids, regexp.MustCompile("(?i)"+regexp.QuoteMeta(v)))
+	}
+
+	return redactor{
+		ids: ids,
+	}
+}
+
+// String replaces any Client, Subscription or Tenant IDs within the input with the RedactedID
+func (r redactor) String(input string) string {
+	for _, id := range r.ids {
+		input = id.ReplaceAllString(input, RedactedID)
+	}
+	return input
+}
+
+// Body redacts any IDs and any tokens/secrets from the specified request/response body
+func (r redactor) Body(input string) string {
+	input = tokenRegex.ReplaceAllString(input, `"$1":"`+redactedValue+`"`)
+	input = keyValueRegex.ReplaceAllString(input, `$1:"`+redactedValue+`"`)
+	input = sasRegex.ReplaceAllString(input, "${1}"+redactedValue)
+	return r.String(input)
+}
+
+// URL redacts any IDs and the signature of any SAS Token from the specified URL - which is
+// used both when recording and replaying so that the redacted URL can be matched
+func (r redactor) URL(input string) string {
+	input = sasRegex.ReplaceAllString(input, "${1}"+redactedValue)
+	return r.String(input)
+}
+
+// ResponseBody redacts the response body for the specified request - the `list*` actions (e.g. `listKeys`
+// and `listCredentials`) exist to return secrets, so the secret values within these are also redacted,
+// retaining the structure of the response so that it can still be unmarshalled when replaying
+func (r redactor) ResponseBody(req *http.Request, input string) string {
+	input = r.Body(input)
+	if !isListAction(req) {
+		return input
+	}
+
+	var body interface{}
+	if err := json.Unmarshal([]byte(input), &body); err != nil {
+		return input
+	}
+
+	contents, err := json.Marshal(redactSecrets("", body))
+	if err != nil {
+		return input
+	}
+	return string(contents)
+}
+
+// isListAction returns whether the specified request is a POST to a `list*` action, such as `listKeys`
+func isListAction(req *http.Request) bool {
+	if req == nil || req.URL == nil || req.Method != http.MethodPost {
+		return false
+	}
+
+	return strings.HasPrefix(strings.ToLower(path.Base(req.URL.Path)), "list")
+}
+
+// secretKeyRegex matches the names of fields returned from a `list*` action which contain secrets,
+// e.g. `primaryMasterKey`, `passwords` and `keys`
+var secretKeyRegex = regexp.MustCompile(`(?i)(key|password|secret|token|connectionstring|signature|credential)s?$`)
+
+// kubeConfigSecretRegex matches the secrets within a Kube Config, e.g. `client-key-data` and `token`
+var kubeConfigSecretRegex = regexp.MustCompile(`(?m)^(\s*(?:client-key-data|token|password):\s*).+$`)
+
+// redactSecrets redacts the secret fields within the specified (unmarshalled) JSON value, where the key is
+// the name of the field containing this value - for example `{"passwords":[{"name":"password","value":"..."}]}`
+func redactSecrets(key string, input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			out[k] = redactSecrets(k, val)
+		}
+		return out
+
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+		for _, val := range v {
+			item, ok := val.(map[string]interface{})
+			if !ok {
+				out = append(out, redactSecrets(key, val))
+				continue
+			}
+
+			// a list of secrets contains the secret in the `value` field, alongside its name
+			redacted := redactSecrets("", item).(map[string]interface{})
+			if value, ok := item["value"].(string); ok {
+				if strings.EqualFold(key, "kubeconfigs") {
+					redacted["value"] = redactKubeConfig(value)
+				} else if secretKeyRegex.MatchString(key) {
+					redacted["value"] = redactedValue
+				}
+			}
+			out = append(out, redacted)
+		}
+		return out
+
+	case string:
+		if secretKeyRegex.MatchString(key) {
+			return redactedValue
+		}
+	}
+
+	return input
+}
+
+// redactKubeConfig redacts the secrets within the specified base64-encoded Kube Config, retaining
+// the remainder of the Kube Config so that it can still be parsed when replaying
+func redactKubeConfig(input string) string {
+	decoded, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		return redactedValue
+	}
+
+	redacted := kubeConfigSecretRegex.ReplaceAllString(string(decoded), "${1}"+redactedValue)
+	return base64.StdEncoding.EncodeToString([]byte(redacted))
+}
+
+// Headers returns a copy of the specified Headers with any sensitive headers removed
+// and any IDs redacted (e.g. from the `Location` and `Azure-AsyncOperation` headers)
+func (r redactor) Headers(input http.Header) http.Header {
+	out := make(http.Header)
+	for k, values := range input {
+		for _, v := range values {
+			out.Add(k, r.URL(v))
+		}
+	}
+
+	for _, k := range redactedHeaders {
+		out.Del(k)
+	}
+
+	return out
+}
@@ -0,0 +1,136 @@
+package recording
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRedactorResponseBody(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Method   string
+		Url      string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "No Secrets",
+			Method:   http.MethodGet,
+			Url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Input:    `{"name":"example","location":"westeurope"}`,
+			Expected: `{"name":"example","location":"westeurope"}`,
+		},
+		{
+			Name:     "Passwords",
+			Method:   http.MethodGet,
+			Url:      "https://management.azure.com/example",
+			Input:    `{"properties":{"administratorLogin":"admin","administratorLoginPassword":"P@ssw0rd!"}}`,
+			Expected: `{"properties":{"administratorLogin":"admin","administratorLoginPassword":"REDACTED"}}`,
+		},
+		{
+			Name:     "Escaped Quotes",
+			Method:   http.MethodGet,
+			Url:      "https://management.azure.com/example",
+			Input:    `{"password":"abc\"123","name":"example"}`,
+			Expected: `{"password":"REDACTED","name":"example"}`,
+		},
+		{
+			Name:     "Keys",
+			Method:   http.MethodGet,
+			Url:      "https://management.azure.com/example",
+			Input:    `{"keys":[{"keyName":"key1","value":"abc123","permissions":"FULL"}]}`,
+			Expected: `{"keys":[{"keyName":"key1","value":"REDACTED","permissions":"FULL"}]}`,
+		},
+		{
+			Name:     "SAS Token",
+			Method:   http.MethodGet,
+			Url:      "https://management.azure.com/example",
+			Input:    `{"uri":"https://example.blob.core.windows.net/container/blob?sv=2019-12-12&sig=abc%2F123&se=2021-01-01"}`,
+			Expected: `{"uri":"https://example.blob.core.windows.net/container/blob?sv=2019-12-12&sig=REDACTED&se=2021-01-01"}`,
+		},
+		{
+			Name:     "List Action",
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/example/listCredentials?api-version=2019-05-01",
+			Input:    `{"username":"example","passwords":[{"name":"password","value":"abc123"}],"enabled":true}`,
+			Expected: `{"enabled":true,"passwords":[{"name":"password","value":"REDACTED"}],"username":"example"}`,
+		},
+		{
+			Name:     "List Action with Keys",
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/example/listKeys?api-version=2020-04-01",
+			Input:    `{"primaryMasterKey":"abc123","secondaryMasterKey":"def456","id":"example"}`,
+			Expected: `{"id":"example","primaryMasterKey":"REDACTED","secondaryMasterKey":"REDACTED"}`,
+		},
+		{
+			Name:     "List Action with Kube Configs",
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/example/listClusterUserCredential?api-version=2020-12-01",
+			Input:    `{"kubeconfigs":[{"name":"clusterUser","value":"YXBpVmVyc2lvbjogdjEKdXNlcnM6Ci0gbmFtZTogY2x1c3RlclVzZXIKICB1c2VyOgogICAgY2xpZW50LWNlcnRpZmljYXRlLWRhdGE6IFkyVnlkQT09CiAgICBjbGllbnQta2V5LWRhdGE6IGEyVjUKICAgIHRva2VuOiBhYmMxMjMK"}]}`,
+			Expected: `{"kubeconfigs":[{"name":"clusterUser","value":"YXBpVmVyc2lvbjogdjEKdXNlcnM6Ci0gbmFtZTogY2x1c3RlclVzZXIKICB1c2VyOgogICAgY2xpZW50LWNlcnRpZmljYXRlLWRhdGE6IFkyVnlkQT09CiAgICBjbGllbnQta2V5LWRhdGE6IFJFREFDVEVECiAgICB0b2tlbjogUkVEQUNURUQK"}]}`,
+		},
+		{
+			Name:     "List Action which isn't JSON",
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/example/listKeys",
+			Input:    `abc123`,
+			Expected: `abc123`,
+		},
+		{
+			Name:     "GET of a List Action",
+			Method:   http.MethodGet,
+			Url:      "https://management.azure.com/example/listKeys",
+			Input:    `{"name":"example"}`,
+			Expected: `{"name":"example"}`,
+		},
+	}
+
+	r := redactor{}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		req, err := http.NewRequest(v.Method, v.Url, nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		actual := r.ResponseBody(req, v.Input)
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestRedactorURL(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "No Secrets",
+			Input:    "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01",
+			Expected: "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01",
+		},
+		{
+			Name:     "SAS Token",
+			Input:    "https://example.blob.core.windows.net/container/blob?sv=2019-12-12&sig=abc%2F123&se=2021-01-01",
+			Expected: "https://example.blob.core.windows.net/container/blob?sv=2019-12-12&sig=REDACTED&se=2021-01-01",
+		},
+		{
+			Name:     "SAS Token as the first Query Parameter",
+			Input:    "https://example.blob.core.windows.net/container/blob?sig=abc%2F123",
+			Expected: "https://example.blob.core.windows.net/container/blob?sig=REDACTED",
+		},
+	}
+
+	r := redactor{}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := r.URL(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
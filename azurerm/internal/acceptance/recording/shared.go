@@ -0,0 +1,73 @@
+package recording
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+var (
+	activeRecorders     = map[*Recorder][]string{}
+	activeRecordersLock = &sync.Mutex{}
+)
+
+// Activate registers this Recorder so that requests sent using the SharedSender whose URL contains
+// any of the specified identifiers (e.g. the random integer used in this test) are recorded/replayed
+// using this Recorder.
+func (r *Recorder) Activate(identifiers ...string) {
+	activeRecordersLock.Lock()
+	defer activeRecordersLock.Unlock()
+
+	values := make([]string, 0)
+	for _, v := range identifiers {
+		if v != "" {
+			values = append(values, strings.ToLower(v))
+		}
+	}
+	activeRecorders[r] = append(activeRecorders[r], values...)
+}
+
+// Deactivate removes this Recorder from the Recorders used by the SharedSender
+func (r *Recorder) Deactivate() {
+	activeRecordersLock.Lock()
+	defer activeRecordersLock.Unlock()
+
+	delete(activeRecorders, r)
+}
+
+// SharedSender returns a Sender for clients which are shared across tests (and as such can't be tied
+// to a single Recorder), which records/replays each request using the active Recorder for the test
+// that the request belongs to - requests which don't belong to a test are sent to Azure as-is.
+func SharedSender(mode Mode, sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		recorder := activeRecorderForUrl(req.URL.String())
+		if recorder != nil {
+			return recorder.Sender(sender).Do(req)
+		}
+
+		if mode == ModeReplay {
+			return nil, fmt.Errorf("no active Recorder was found for %s %s", req.Method, req.URL.String())
+		}
+
+		return sender.Do(req)
+	})
+}
+
+func activeRecorderForUrl(url string) *Recorder {
+	activeRecordersLock.Lock()
+	defer activeRecordersLock.Unlock()
+
+	url = strings.ToLower(url)
+	for recorder, identifiers := range activeRecorders {
+		for _, identifier := range identifiers {
+			if strings.Contains(url, identifier) {
+				return recorder
+			}
+		}
+	}
+
+	return nil
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azuread/azuread"
 
+	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/fakearm"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/helpers"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/testclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/types"
@@ -63,6 +65,16 @@ func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 			return aad, nil
 		},
 		"azurerm": func() (terraform.ResourceProvider, error) {
+			if fakearm.Enabled() {
+				azurerm := provider.TestAzureProviderWithResourceManagerEndpoint(fakearm.Endpoint())
+				return azurerm, nil
+			}
+
+			if td.recorder != nil {
+				azurerm := provider.TestAzureProviderWithSender(td.recorder.Sender(sender.BuildSender("AzureRM")), td.recorder.Replaying())
+				return azurerm, nil
+			}
+
 			azurerm := provider.TestAzureProvider()
 			return azurerm, nil
 		},
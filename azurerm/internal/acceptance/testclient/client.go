@@ -7,6 +7,9 @@ import (
 	"sync"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/go-azure-helpers/sender"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/fakearm"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
@@ -19,6 +22,11 @@ func Build() (*clients.Client, error) {
 	defer clientLock.Unlock()
 
 	if _client == nil {
+		mode, err := recording.ModeFromEnvironment()
+		if err != nil {
+			return nil, err
+		}
+
 		environment, exists := os.LookupEnv("ARM_ENVIRONMENT")
 		if !exists {
 			environment = "public"
@@ -47,6 +55,14 @@ func Build() (*clients.Client, error) {
 			Features:                 features.Default(),
 			StorageUseAzureAD:        false,
 		}
+		if fakearm.Enabled() {
+			clientBuilder.ResourceManagerEndpoint = fakearm.Endpoint()
+			clientBuilder.Offline = true
+		} else if mode != recording.ModeLive {
+			// this client is shared across tests, so requests are routed to the Recorder for the relevant test
+			clientBuilder.Sender = recording.SharedSender(mode, sender.BuildSender("AzureRM"))
+			clientBuilder.Offline = mode == recording.ModeReplay
+		}
 		client, err := clients.Build(context.TODO(), clientBuilder)
 		if err != nil {
 			return nil, err
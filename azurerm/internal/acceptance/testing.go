@@ -10,9 +10,17 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/fakearm"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/recording"
 )
 
 func PreCheck(t *testing.T) {
+	if mode, err := recording.ModeFromEnvironment(); fakearm.Enabled() || (err == nil && mode == recording.ModeReplay) {
+		// requests aren't sent to Azure, so credentials for Azure aren't required
+		configureOfflineEnvironment()
+		return
+	}
+
 	variables := []string{
 		"ARM_CLIENT_ID",
 		"ARM_CLIENT_SECRET",
@@ -16,6 +16,10 @@ type ResourceManagerAccount struct {
 	SkipResourceProviderRegistration bool
 	SubscriptionId                   string
 	TenantId                         string
+
+	// ResourceProvidersToRegister are the Resource Providers which are automatically registered by the Provider,
+	// when nil (and SkipResourceProviderRegistration is false) all of the Resource Providers are registered
+	ResourceProvidersToRegister map[string]struct{}
 }
 
 func NewResourceManagerAccount(ctx context.Context, config authentication.Config, env azure.Environment, skipResourceProviderRegistration bool) (*ResourceManagerAccount, error) {
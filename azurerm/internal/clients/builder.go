@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 )
 
 type ClientBuilder struct {
@@ -25,6 +26,19 @@ type ClientBuilder struct {
 	StorageUseAzureAD           bool
 	TerraformVersion            string
 	Features                    features.UserFeatures
+	MaxConcurrentWrites         int
+	Tags                        tags.ProviderConfig
+
+	// Sender is an optional Sender used to send all requests to Azure, used in testing to record/replay requests
+	Sender autorest.Sender
+
+	// Offline specifies that no requests should be made to Azure other than through the Sender, meaning that
+	// authentication is skipped - this is used when replaying previously recorded requests in testing
+	Offline bool
+
+	// ResourceManagerEndpoint optionally overrides the Resource Manager Endpoint for the Environment, this is
+	// used in testing to send requests to the Fake ARM Server rather than Azure
+	ResourceManagerEndpoint string
 }
 
 const azureStackEnvironmentError = `
@@ -54,9 +68,20 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	if builder.ResourceManagerEndpoint != "" {
+		overridden := *env
+		overridden.ResourceManagerEndpoint = builder.ResourceManagerEndpoint
+		env = &overridden
+	}
+
+	authConfig := *builder.AuthConfig
+	if builder.Offline {
+		// looking up the Object ID requires a request to Azure Active Directory
+		authConfig.GetAuthenticatedObjectID = nil
+	}
 
 	// client declarations:
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, builder.SkipProviderRegistration)
+	account, err := NewResourceManagerAccount(ctx, authConfig, *env, builder.SkipProviderRegistration)
 	if err != nil {
 		return nil, fmt.Errorf("Error building account: %+v", err)
 	}
@@ -65,6 +90,61 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		Account: account,
 	}
 
+	var auth *authorizers
+	if builder.Offline {
+		log.Printf("[DEBUG] Running Offline - skipping authentication")
+		auth = offlineAuthorizers()
+	} else {
+		auth, err = buildAuthorizers(builder, *env)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	o := &common.ClientOptions{
+		SubscriptionId:              builder.AuthConfig.SubscriptionID,
+		TenantID:                    builder.AuthConfig.TenantID,
+		PartnerId:                   builder.PartnerId,
+		TerraformVersion:            builder.TerraformVersion,
+		GraphAuthorizer:             auth.graph,
+		GraphEndpoint:               env.GraphEndpoint,
+		KeyVaultAuthorizer:          auth.keyVault,
+		ResourceManagerAuthorizer:   auth.resourceManager,
+		ResourceManagerEndpoint:     env.ResourceManagerEndpoint,
+		StorageAuthorizer:           auth.storage,
+		SynapseAuthorizer:           auth.synapse,
+		SkipProviderReg:             builder.SkipProviderRegistration,
+		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
+		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
+		Environment:                 *env,
+		Features:                    builder.Features,
+		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		Tags:                        builder.Tags,
+		Sender:                      builder.Sender,
+		Throttler:                   common.NewThrottler(env.ResourceManagerEndpoint, builder.MaxConcurrentWrites),
+	}
+
+	if err := client.Build(ctx, o); err != nil {
+		return nil, fmt.Errorf("Error building Client: %+v", err)
+	}
+
+	if features.EnhancedValidationEnabled() && !builder.Offline {
+		location.CacheSupportedLocations(ctx, env)
+		resourceproviders.CacheSupportedProviders(ctx, client.Resource.ProvidersClient, env)
+	}
+
+	return &client, nil
+}
+
+type authorizers struct {
+	graph           autorest.Authorizer
+	keyVault        autorest.Authorizer
+	resourceManager autorest.Authorizer
+	storage         autorest.Authorizer
+	synapse         autorest.Authorizer
+}
+
+func buildAuthorizers(builder ClientBuilder, env azure.Environment) (*authorizers, error) {
 	oauthConfig, err := builder.AuthConfig.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
 	if err != nil {
 		return nil, err
@@ -78,15 +158,13 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	sender := sender.BuildSender("AzureRM")
 
 	// Resource Manager endpoints
-	endpoint := env.ResourceManagerEndpoint
 	auth, err := builder.AuthConfig.GetAuthorizationToken(sender, oauthConfig, env.TokenAudience)
 	if err != nil {
 		return nil, err
 	}
 
 	// Graph Endpoints
-	graphEndpoint := env.GraphEndpoint
-	graphAuth, err := builder.AuthConfig.GetAuthorizationToken(sender, oauthConfig, graphEndpoint)
+	graphAuth, err := builder.AuthConfig.GetAuthorizationToken(sender, oauthConfig, env.GraphEndpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -111,34 +189,22 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	// Key Vault Endpoints
 	keyVaultAuth := builder.AuthConfig.BearerAuthorizerCallback(sender, oauthConfig)
 
-	o := &common.ClientOptions{
-		SubscriptionId:              builder.AuthConfig.SubscriptionID,
-		TenantID:                    builder.AuthConfig.TenantID,
-		PartnerId:                   builder.PartnerId,
-		TerraformVersion:            builder.TerraformVersion,
-		GraphAuthorizer:             graphAuth,
-		GraphEndpoint:               graphEndpoint,
-		KeyVaultAuthorizer:          keyVaultAuth,
-		ResourceManagerAuthorizer:   auth,
-		ResourceManagerEndpoint:     endpoint,
-		StorageAuthorizer:           storageAuth,
-		SynapseAuthorizer:           synapseAuth,
-		SkipProviderReg:             builder.SkipProviderRegistration,
-		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
-		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
-		Environment:                 *env,
-		Features:                    builder.Features,
-		StorageUseAzureAD:           builder.StorageUseAzureAD,
-	}
-
-	if err := client.Build(ctx, o); err != nil {
-		return nil, fmt.Errorf("Error building Client: %+v", err)
-	}
+	return &authorizers{
+		graph:           graphAuth,
+		keyVault:        keyVaultAuth,
+		resourceManager: auth,
+		storage:         storageAuth,
+		synapse:         synapseAuth,
+	}, nil
+}
 
-	if features.EnhancedValidationEnabled() {
-		location.CacheSupportedLocations(ctx, env)
-		resourceproviders.CacheSupportedProviders(ctx, client.Resource.ProvidersClient)
+// offlineAuthorizers returns Authorizers which don't add any credentials to the request
+func offlineAuthorizers() *authorizers {
+	return &authorizers{
+		graph:           autorest.NullAuthorizer{},
+		keyVault:        autorest.NullAuthorizer{},
+		resourceManager: autorest.NullAuthorizer{},
+		storage:         autorest.NullAuthorizer{},
+		synapse:         autorest.NullAuthorizer{},
 	}
-
-	return &client, nil
 }
@@ -95,6 +95,7 @@ import (
 	trafficManager "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/trafficmanager/client"
 	vmware "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/vmware/client"
 	web "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 )
 
 type Client struct {
@@ -104,6 +105,13 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// Tags contains the `default_tags` and `ignore_tags` defined in the Provider block
+	Tags tags.ProviderConfig
+
+	// CorrelationRequestID is the value sent in the `x-ms-correlation-request-id` header for each request
+	// to Azure, this is empty when sending the Correlation Request ID has been disabled
+	CorrelationRequestID string
+
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisServices.Client
 	ApiManagement         *apiManagement.Client
@@ -202,7 +210,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	validation.Disabled = true
 
 	client.Features = o.Features
+	client.Tags = o.Tags
 	client.StopContext = ctx
+	client.CorrelationRequestID = o.CorrelationRequestID()
 
 	client.Advisor = advisor.NewClient(o)
 	client.AnalysisServices = analysisServices.NewClient(o)
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform-plugin-sdk/meta"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
 
@@ -33,19 +34,45 @@ type ClientOptions struct {
 	Environment                 azure.Environment
 	Features                    features.UserFeatures
 	StorageUseAzureAD           bool
+
+	// Tags contains the `default_tags` and `ignore_tags` defined in the Provider block
+	Tags tags.ProviderConfig
+
+	// Throttler is shared across all clients and throttles requests to Resource Manager
+	Throttler *Throttler
+
+	// Sender is an optional Sender used to send all requests to Azure, used in testing to record/replay
+	// requests - when unset the default Sender (which logs all requests/responses) is used
+	Sender autorest.Sender
 }
 
 func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.Authorizer) {
 	setUserAgent(c, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = o.Sender
+	if c.Sender == nil {
+		c.Sender = sender.BuildSender("AzureRM")
+	}
+	if o.Throttler != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, o.Throttler.WithThrottling())
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		c.RequestInspector = withCorrelationRequestID(correlationRequestID())
 	}
 }
 
+// CorrelationRequestID returns the Correlation Request ID sent to Azure in the `x-ms-correlation-request-id`
+// header for each request, or an empty string when this has been disabled
+func (o ClientOptions) CorrelationRequestID() string {
+	if o.DisableCorrelationRequestID {
+		return ""
+	}
+
+	return correlationRequestID()
+}
+
 func setUserAgent(client *autorest.Client, tfVersion, partnerID string, disableTerraformPartnerID bool) {
 	tfUserAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io) Terraform Plugin SDK/%s", tfVersion, meta.SDKVersionString())
 
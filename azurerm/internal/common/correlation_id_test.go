@@ -29,3 +29,17 @@ func TestWithCorrelationRequestID(t *testing.T) {
 			HeaderCorrelationRequestID, uuid, req.Header.Get(HeaderCorrelationRequestID))
 	}
 }
+
+func TestClientOptionsCorrelationRequestID(t *testing.T) {
+	enabled := ClientOptions{}
+	if enabled.CorrelationRequestID() != correlationRequestID() {
+		t.Fatalf("expected the Correlation Request ID to be %q but got %q", correlationRequestID(), enabled.CorrelationRequestID())
+	}
+
+	disabled := ClientOptions{
+		DisableCorrelationRequestID: true,
+	}
+	if disabled.CorrelationRequestID() != "" {
+		t.Fatalf("expected no Correlation Request ID when disabled but got %q", disabled.CorrelationRequestID())
+	}
+}
@@ -0,0 +1,231 @@
+package common
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	headerRetryAfter                  = "Retry-After"
+	headerRemainingSubscriptionReads  = "x-ms-ratelimit-remaining-subscription-reads"
+	headerRemainingSubscriptionWrites = "x-ms-ratelimit-remaining-subscription-writes"
+)
+
+var (
+	// throttlingReserve is the number of remaining requests (as reported by Resource Manager) below
+	// which requests are paced, to avoid exhausting the rate-limit for the Subscription
+	throttlingReserve = 10
+
+	// throttlingPacingInterval is the minimum interval between requests whilst pacing requests
+	throttlingPacingInterval = time.Second
+
+	// throttlingDefaultRetryAfter is the duration requests are paused for when throttled, if
+	// Resource Manager doesn't return a valid `Retry-After` header
+	throttlingDefaultRetryAfter = 10 * time.Second
+
+	// throttlingMaxRetryAfter is the maximum duration requests are paused for when throttled
+	throttlingMaxRetryAfter = 5 * time.Minute
+)
+
+// Throttler is shared across all of the Service Clients and throttles the requests sent to Azure Resource
+// Manager using the rate-limit headers returned by Resource Manager: pausing all requests of the same kind
+// (reads or writes) when a request is throttled (a `429`) for the duration of the `Retry-After` header and
+// pacing requests when the remaining number of requests for the Subscription is running low.
+//
+// Optionally the number of concurrent in-flight write requests (PUT/PATCH/POST/DELETE) can also be limited.
+type Throttler struct {
+	host             string
+	concurrentWrites chan struct{}
+
+	reads  *throttlingBucket
+	writes *throttlingBucket
+}
+
+// NewThrottler returns a Throttler for requests sent to the specified Resource Manager Endpoint, where
+// maxConcurrentWrites is the maximum number of in-flight write requests (or 0 for no limit)
+func NewThrottler(resourceManagerEndpoint string, maxConcurrentWrites int) *Throttler {
+	host := ""
+	if u, err := url.Parse(resourceManagerEndpoint); err == nil {
+		host = u.Host
+	}
+
+	throttler := &Throttler{
+		host:   host,
+		reads:  newThrottlingBucket("reads"),
+		writes: newThrottlingBucket("writes"),
+	}
+	if maxConcurrentWrites > 0 {
+		throttler.concurrentWrites = make(chan struct{}, maxConcurrentWrites)
+	}
+	return throttler
+}
+
+// WithThrottling returns a SendDecorator which throttles requests sent to Azure Resource Manager
+func (t *Throttler) WithThrottling() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			// other API's (e.g. Storage/Key Vault data plane API's) have different rate-limits
+			if !strings.EqualFold(r.URL.Host, t.host) {
+				return s.Do(r)
+			}
+
+			ctx := r.Context()
+			isWrite := isWriteRequest(r.Method)
+			bucket := t.reads
+			if isWrite {
+				bucket = t.writes
+			}
+
+			if err := bucket.wait(ctx); err != nil {
+				return nil, err
+			}
+
+			if isWrite && t.concurrentWrites != nil {
+				select {
+				case t.concurrentWrites <- struct{}{}:
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				}
+				defer func() {
+					<-t.concurrentWrites
+				}()
+			}
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				t.reads.updateRemaining(resp.Header.Get(headerRemainingSubscriptionReads))
+				t.writes.updateRemaining(resp.Header.Get(headerRemainingSubscriptionWrites))
+
+				if resp.StatusCode == http.StatusTooManyRequests {
+					bucket.pause(retryAfter(resp))
+				}
+			}
+			return resp, err
+		})
+	}
+}
+
+func isWriteRequest(method string) bool {
+	switch method {
+	case http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// retryAfter returns the duration specified in the `Retry-After` header for this response
+func retryAfter(resp *http.Response) time.Duration {
+	v := resp.Header.Get(headerRetryAfter)
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return capRetryAfter(time.Duration(seconds) * time.Second)
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return capRetryAfter(time.Until(t))
+	}
+
+	return throttlingDefaultRetryAfter
+}
+
+func capRetryAfter(input time.Duration) time.Duration {
+	if input > throttlingMaxRetryAfter {
+		return throttlingMaxRetryAfter
+	}
+
+	return input
+}
+
+type throttlingBucket struct {
+	name string
+
+	lock sync.Mutex
+
+	// pausedUntil is the time at which requests can be sent again, after being throttled
+	pausedUntil time.Time
+
+	// remaining is the number of requests remaining for the Subscription, or -1 if unknown
+	remaining int
+
+	// nextRequest is the earliest time the next request can be sent, whilst pacing requests
+	nextRequest time.Time
+}
+
+func newThrottlingBucket(name string) *throttlingBucket {
+	return &throttlingBucket{
+		name:      name,
+		remaining: -1,
+	}
+}
+
+// wait blocks until a request can be sent, or the context is cancelled
+func (b *throttlingBucket) wait(ctx context.Context) error {
+	b.lock.Lock()
+	now := time.Now()
+	until := b.pausedUntil
+	if b.remaining >= 0 && b.remaining < throttlingReserve {
+		if b.nextRequest.After(until) {
+			until = b.nextRequest
+		}
+
+		// reserve the next slot, so that concurrent requests are spread out
+		next := until
+		if next.Before(now) {
+			next = now
+		}
+		b.nextRequest = next.Add(throttlingPacingInterval)
+	}
+	b.lock.Unlock()
+
+	delay := until.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Throttling %s to Resource Manager for %s", b.name, delay)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// pause pauses all requests for the specified duration
+func (b *throttlingBucket) pause(duration time.Duration) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	until := time.Now().Add(duration)
+	if until.After(b.pausedUntil) {
+		log.Printf("[DEBUG] Resource Manager is throttling %s - pausing %s for %s", b.name, b.name, duration)
+		b.pausedUntil = until
+	}
+}
+
+// updateRemaining updates the remaining number of requests from the rate-limit header, if present
+func (b *throttlingBucket) updateRemaining(header string) {
+	if header == "" {
+		return
+	}
+
+	remaining, err := strconv.Atoi(header)
+	if err != nil {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.remaining = remaining
+}
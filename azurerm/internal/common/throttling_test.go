@@ -0,0 +1,182 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestThrottlerPausesWhenThrottled(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sender := autorest.DecorateSender(server.Client(), NewThrottler(server.URL, 0).WithThrottling())
+
+	resp := sendThrottlingRequest(t, sender, http.MethodPut, server.URL)
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected the first request to be throttled but got %d", resp.StatusCode)
+	}
+
+	start := time.Now()
+	sendThrottlingRequest(t, sender, http.MethodGet, server.URL)
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Fatalf("expected reads not to be paused when writes are throttled but waited %s", elapsed)
+	}
+
+	sendThrottlingRequest(t, sender, http.MethodPut, server.URL)
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("expected writes to be paused for the `Retry-After` duration but only waited %s", elapsed)
+	}
+}
+
+func TestThrottlerPacesWhenRemainingIsLow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRemainingSubscriptionReads, "2")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sender := autorest.DecorateSender(server.Client(), NewThrottler(server.URL, 0).WithThrottling())
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		sendThrottlingRequest(t, sender, http.MethodGet, server.URL)
+	}
+
+	// the first request isn't paced, since the remaining count isn't known until it returns
+	if elapsed := time.Since(start); elapsed < throttlingPacingInterval {
+		t.Fatalf("expected requests to be paced but they took %s", elapsed)
+	}
+}
+
+func TestThrottlerLimitsConcurrentWrites(t *testing.T) {
+	lock := &sync.Mutex{}
+	inFlight := 0
+	maxInFlight := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		lock.Unlock()
+
+		time.Sleep(50 * time.Millisecond)
+
+		lock.Lock()
+		inFlight--
+		lock.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sender := autorest.DecorateSender(server.Client(), NewThrottler(server.URL, 2).WithThrottling())
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sendThrottlingRequest(t, sender, http.MethodPut, server.URL)
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight != 2 {
+		t.Fatalf("expected a maximum of 2 concurrent writes but got %d", maxInFlight)
+	}
+}
+
+func TestThrottlerIgnoresOtherHosts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	sender := autorest.DecorateSender(server.Client(), NewThrottler("https://management.azure.com/", 0).WithThrottling())
+
+	start := time.Now()
+	sendThrottlingRequest(t, sender, http.MethodPut, server.URL)
+	sendThrottlingRequest(t, sender, http.MethodPut, server.URL)
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Fatalf("expected requests to other hosts not to be throttled but waited %s", elapsed)
+	}
+}
+
+func TestThrottlerCancelledWhilstPaused(t *testing.T) {
+	throttler := NewThrottler("https://management.azure.com/", 0)
+	throttler.writes.pause(time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := throttler.writes.wait(ctx); err == nil {
+		t.Fatalf("expected an error when the context is cancelled but didn't get one")
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected time.Duration
+	}{
+		{
+			Input:    "",
+			Expected: throttlingDefaultRetryAfter,
+		},
+		{
+			Input:    "5",
+			Expected: 5 * time.Second,
+		},
+		{
+			Input:    "3600",
+			Expected: throttlingMaxRetryAfter,
+		},
+		{
+			Input:    "invalid",
+			Expected: throttlingDefaultRetryAfter,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		resp.Header.Set("Retry-After", v.Input)
+		if actual := retryAfter(resp); actual != v.Expected {
+			t.Fatalf("expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
+
+func sendThrottlingRequest(t *testing.T, sender autorest.Sender, method, uri string) *http.Response {
+	req, err := http.NewRequest(method, uri, nil)
+	if err != nil {
+		t.Errorf("building request: %+v", err)
+		return nil
+	}
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Errorf("sending request: %+v", err)
+		return nil
+	}
+	resp.Body.Close()
+	return resp
+}
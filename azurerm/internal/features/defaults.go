@@ -13,15 +13,26 @@ func Default() UserFeatures {
 		Network: NetworkFeatures{
 			RelaxedLocking: false,
 		},
+		ResourceGroup: ResourceGroupFeatures{
+			PreventDeletionIfContainsResources: false,
+		},
 		TemplateDeployment: TemplateDeploymentFeatures{
 			DeleteNestedItemsDuringDeletion: true,
 		},
 		VirtualMachine: VirtualMachineFeatures{
-			DeleteOSDiskOnDeletion: true,
-			GracefulShutdown:       false,
+			DeleteOSDiskOnDeletion:            true,
+			GracefulShutdown:                  false,
+			DeleteDataDisksOnDeletion:         false,
+			DeleteNetworkInterfacesOnDeletion: false,
+			DeletePublicIPAddressesOnDeletion: false,
+			DeletionDryRun:                    false,
 		},
 		VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
-			RollInstancesWhenRequired: true,
+			RollInstancesWhenRequired:                true,
+			ManualUpgradeBatchSize:                   1,
+			ManualUpgradeMaxUnhealthyInstancePercent: 20,
+			ManualUpgradeReimageInstances:            true,
+			ManualUpgradeWaitForHealthyInstances:     false,
 		},
 	}
 }
@@ -19,5 +19,13 @@ func EnhancedValidationEnabled() bool {
 		return true
 	}
 
-	return strings.EqualFold(value, "true")
+	return strings.EqualFold(value, "true") || strings.EqualFold(value, "offline")
+}
+
+// EnhancedValidationOffline returns whether Enhanced Validation should use the snapshot of the
+// Azure Locations and Resource Providers bundled with the Provider, rather than retrieving these
+// from Azure - which can be enabled by setting the Environment Variable `ARM_PROVIDER_ENHANCED_VALIDATION`
+// to `offline`.
+func EnhancedValidationOffline() bool {
+	return strings.EqualFold(os.Getenv("ARM_PROVIDER_ENHANCED_VALIDATION"), "offline")
 }
@@ -0,0 +1,59 @@
+package features
+
+import (
+	"os"
+	"testing"
+)
+
+func TestEnhancedValidation(t *testing.T) {
+	testData := []struct {
+		name            string
+		value           string
+		expectedEnabled bool
+		expectedOffline bool
+	}{
+		{
+			name:            "unset",
+			value:           "",
+			expectedEnabled: true,
+			expectedOffline: false,
+		},
+		{
+			name:            "enabled",
+			value:           "true",
+			expectedEnabled: true,
+			expectedOffline: false,
+		},
+		{
+			name:            "disabled",
+			value:           "false",
+			expectedEnabled: false,
+			expectedOffline: false,
+		},
+		{
+			name:            "offline",
+			value:           "offline",
+			expectedEnabled: true,
+			expectedOffline: true,
+		},
+		{
+			name:            "offline upper-case",
+			value:           "OFFLINE",
+			expectedEnabled: true,
+			expectedOffline: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q..", v.name)
+
+		os.Setenv("ARM_PROVIDER_ENHANCED_VALIDATION", v.value)
+		if actual := EnhancedValidationEnabled(); actual != v.expectedEnabled {
+			t.Fatalf("expected enabled to be %t but got %t", v.expectedEnabled, actual)
+		}
+		if actual := EnhancedValidationOffline(); actual != v.expectedOffline {
+			t.Fatalf("expected offline to be %t but got %t", v.expectedOffline, actual)
+		}
+	}
+	os.Unsetenv("ARM_PROVIDER_ENHANCED_VALIDATION")
+}
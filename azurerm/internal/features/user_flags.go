@@ -1,21 +1,38 @@
 package features
 
+import "time"
+
 type UserFeatures struct {
 	VirtualMachine         VirtualMachineFeatures
 	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
 	KeyVault               KeyVaultFeatures
 	Network                NetworkFeatures
+	ResourceGroup          ResourceGroupFeatures
 	TemplateDeployment     TemplateDeploymentFeatures
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
+	Timeouts               []TimeoutsFeatures
 }
 
 type VirtualMachineFeatures struct {
 	DeleteOSDiskOnDeletion bool
 	GracefulShutdown       bool
+
+	// the following are used to delete the resources attached to a Virtual Machine when it's deleted, which
+	// are only logged (rather than deleted) when DeletionDryRun is enabled
+	DeleteDataDisksOnDeletion         bool
+	DeleteNetworkInterfacesOnDeletion bool
+	DeletePublicIPAddressesOnDeletion bool
+	DeletionDryRun                    bool
 }
 
 type VirtualMachineScaleSetFeatures struct {
 	RollInstancesWhenRequired bool
+
+	// the following are used when rolling the instances within a Scale Set using a `Manual` Upgrade Policy
+	ManualUpgradeBatchSize                   int
+	ManualUpgradeMaxUnhealthyInstancePercent int
+	ManualUpgradeReimageInstances            bool
+	ManualUpgradeWaitForHealthyInstances     bool
 }
 
 type KeyVaultFeatures struct {
@@ -27,6 +44,10 @@ type NetworkFeatures struct {
 	RelaxedLocking bool
 }
 
+type ResourceGroupFeatures struct {
+	PreventDeletionIfContainsResources bool
+}
+
 type TemplateDeploymentFeatures struct {
 	DeleteNestedItemsDuringDeletion bool
 }
@@ -34,3 +55,14 @@ type TemplateDeploymentFeatures struct {
 type LogAnalyticsWorkspaceFeatures struct {
 	PermanentlyDeleteOnDestroy bool
 }
+
+// TimeoutsFeatures overrides the default Timeouts for the Resource Types matching ResourceType,
+// which can be either the name of a Resource Type or a glob (e.g. `azurerm_kubernetes_*`).
+// Operations which aren't overridden are nil.
+type TimeoutsFeatures struct {
+	ResourceType string
+	Create       *time.Duration
+	Read         *time.Duration
+	Update       *time.Duration
+	Delete       *time.Duration
+}
@@ -17,6 +17,15 @@ func Schema() *schema.Schema {
 	}
 }
 
+// SchemaForResourceType returns the Schema which should be used for Location fields where these are Required
+// and Cannot be Changed - which (when Enhanced Validation is enabled) validates that the ARM Resource Type
+// (for example `Microsoft.ContainerService/managedClusters`) is available in the specified Location
+func SchemaForResourceType(resourceType string) *schema.Schema {
+	s := Schema()
+	s.ValidateFunc = EnhancedValidateForResourceType(resourceType)
+	return s
+}
+
 // SchemaOptional returns the Schema for a Location field where this can be optionally specified
 func SchemaOptional() *schema.Schema {
 	return &schema.Schema{
@@ -47,6 +56,15 @@ func SchemaWithoutForceNew() *schema.Schema {
 	}
 }
 
+// SchemaWithoutForceNewForResourceType returns the Schema which should be used for Location fields where these are
+// Required and can be changed - which (when Enhanced Validation is enabled) validates that the ARM Resource Type
+// (for example `Microsoft.ContainerService/managedClusters`) is available in the specified Location
+func SchemaWithoutForceNewForResourceType(resourceType string) *schema.Schema {
+	s := SchemaWithoutForceNew()
+	s.ValidateFunc = EnhancedValidateForResourceType(resourceType)
+	return s
+}
+
 func DiffSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
 	return Normalize(old) == Normalize(new)
 }
@@ -5,19 +5,40 @@ import (
 	"log"
 
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/snapshot"
 )
 
 // supportedLocations can be (validly) nil - as such this shouldn't be relied on
 var supportedLocations *[]string
 
 // CacheSupportedLocations attempts to retrieve the supported locations from the Azure MetaData Service
-// and caches them, for used in enhanced validation
+// and caches them, for used in enhanced validation - falling back to the snapshot bundled with the
+// Provider when these can't be retrieved (or when Enhanced Validation is running offline)
 func CacheSupportedLocations(ctx context.Context, env *azure.Environment) {
-	locs, err := availableAzureLocations(ctx, env)
-	if err != nil {
-		log.Printf("[DEBUG] error retrieving locations: %s. Enhanced validation will be unavailable", err)
+	if features.EnhancedValidationOffline() {
+		cacheSupportedLocationsFromSnapshot(env.Name)
+		return
+	}
+
+	locs, err := AvailableLocations(ctx, env)
+	if err != nil || locs.Locations == nil {
+		log.Printf("[DEBUG] error retrieving locations: %v. Falling back to the bundled snapshot", err)
+		cacheSupportedLocationsFromSnapshot(env.Name)
 		return
 	}
 
 	supportedLocations = locs.Locations
 }
+
+func cacheSupportedLocationsFromSnapshot(environmentName string) {
+	s, err := snapshot.ForEnvironment(environmentName)
+	if err != nil {
+		log.Printf("[DEBUG] error loading the bundled snapshot of locations: %s. Enhanced validation will be unavailable", err)
+		return
+	}
+
+	log.Printf("[DEBUG] Using the bundled snapshot of locations for %q (generated on %s)", s.Environment, s.GeneratedOn)
+	locations := s.Locations
+	supportedLocations = &locations
+}
@@ -26,8 +26,8 @@ type metaDataResponse struct {
 	CloudEndpoint map[string]cloudEndpoint `json:"cloudEndpoint"`
 }
 
-// availableAzureLocations returns a list of the Azure Locations which are available on the specified endpoint
-func availableAzureLocations(ctx context.Context, env *azure.Environment) (*SupportedLocations, error) {
+// AvailableLocations returns a list of the Azure Locations which are available on the specified endpoint
+func AvailableLocations(ctx context.Context, env *azure.Environment) (*SupportedLocations, error) {
 	// e.g. https://management.azure.com/ but we need management.azure.com
 	endpoint := strings.TrimPrefix(env.ResourceManagerEndpoint, "https://")
 	endpoint = strings.TrimSuffix(endpoint, "/")
@@ -0,0 +1,36 @@
+package location
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestCacheSupportedLocationsOffline(t *testing.T) {
+	os.Setenv("ARM_PROVIDER_ENHANCED_VALIDATION", "offline")
+	defer func() {
+		os.Unsetenv("ARM_PROVIDER_ENHANCED_VALIDATION")
+		supportedLocations = nil
+	}()
+
+	env := azure.ChinaCloud
+	CacheSupportedLocations(context.TODO(), &env)
+
+	if supportedLocations == nil {
+		t.Fatalf("expected the Locations to be loaded from the bundled snapshot")
+	}
+
+	enhancedEnabled = true
+	defer func() {
+		enhancedEnabled = features.EnhancedValidationEnabled()
+	}()
+	if _, errors := EnhancedValidate("China North", "location"); len(errors) > 0 {
+		t.Fatalf("expected %q to be valid but got %+v", "China North", errors)
+	}
+	if _, errors := EnhancedValidate("West Europe", "location"); len(errors) == 0 {
+		t.Fatalf("expected %q to be invalid within the China Cloud", "West Europe")
+	}
+}
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 )
 
-// this is only here to aid testing
+// these are only here to aid testing
 var enhancedEnabled = features.EnhancedValidationEnabled()
+var locationsForResourceType = resourceproviders.LocationsForResourceType
 
 // EnhancedValidate returns a validation function which attempts to validate the location
 // against the list of Locations supported by this Azure Location.
@@ -60,3 +63,41 @@ func enhancedValidation(i interface{}, k string) ([]string, []error) {
 
 	return nil, nil
 }
+
+// EnhancedValidateForResourceType returns a validation function which (in addition to EnhancedValidate) attempts
+// to validate that the Resource Type (for example `Microsoft.ContainerService/managedClusters`) is available in
+// the location, using the Locations returned for this Resource Type by the Resource Providers API.
+//
+// NOTE: this is best-effort - if the Locations for this Resource Type aren't known we'll fall back to EnhancedValidate
+func EnhancedValidateForResourceType(resourceType string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		warnings, errors := EnhancedValidate(i, k)
+		if len(errors) > 0 || !enhancedEnabled {
+			return warnings, errors
+		}
+
+		return warnings, validateResourceTypeAvailable(resourceType, i.(string))
+	}
+}
+
+func validateResourceTypeAvailable(resourceType, input string) []error {
+	availableLocations, ok := locationsForResourceType(resourceType)
+	if !ok {
+		return nil
+	}
+
+	normalizedUserInput := Normalize(input)
+	normalizedLocations := make([]string, 0)
+	for _, loc := range availableLocations {
+		normalized := Normalize(loc)
+		if normalized == normalizedUserInput {
+			return nil
+		}
+
+		normalizedLocations = append(normalizedLocations, normalized)
+	}
+
+	return []error{
+		fmt.Errorf("the Resource Type %q is not available in the location %q - it's available in: %q", resourceType, normalizedUserInput, strings.Join(normalizedLocations, ",")),
+	}
+}
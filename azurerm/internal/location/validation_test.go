@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 )
 
 func TestEnhancedValidationDisabled(t *testing.T) {
@@ -176,6 +177,65 @@ func TestEnhancedValidationEnabled(t *testing.T) {
 	}
 }
 
+func TestEnhancedValidationForResourceType(t *testing.T) {
+	testCases := []struct {
+		resourceType string
+		input        string
+		valid        bool
+	}{
+		{
+			resourceType: "Microsoft.ContainerService/managedClusters",
+			input:        "",
+			valid:        false,
+		},
+		{
+			resourceType: "Microsoft.ContainerService/managedClusters",
+			input:        "West Europe",
+			valid:        true,
+		},
+		{
+			resourceType: "Microsoft.ContainerService/managedClusters",
+			input:        "westeurope",
+			valid:        true,
+		},
+		{
+			resourceType: "Microsoft.ContainerService/managedClusters",
+			input:        "westus",
+			valid:        false,
+		},
+		{
+			// the Locations for this Resource Type aren't known
+			resourceType: "Microsoft.Compute/virtualMachines",
+			input:        "westus",
+			valid:        true,
+		},
+	}
+	enhancedEnabled = true
+	supportedLocations = &publicLocations
+	locationsForResourceType = func(resourceType string) ([]string, bool) {
+		if resourceType == "Microsoft.ContainerService/managedClusters" {
+			return []string{"West Europe", "North Europe"}, true
+		}
+
+		return nil, false
+	}
+	defer func() {
+		enhancedEnabled = features.EnhancedValidationEnabled()
+		supportedLocations = nil
+		locationsForResourceType = resourceproviders.LocationsForResourceType
+	}()
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q in %q..", testCase.resourceType, testCase.input)
+
+		warnings, errors := EnhancedValidateForResourceType(testCase.resourceType)(testCase.input, "location")
+		valid := len(warnings) == 0 && len(errors) == 0
+		if testCase.valid != valid {
+			t.Errorf("Expected %t but got %t", testCase.valid, valid)
+		}
+	}
+}
+
 var (
 	chinaLocations  = []string{"chinaeast", "chinanorth", "chinanorth2", "chinaeast2"}
 	publicLocations = []string{
@@ -3,6 +3,7 @@ package locks
 import (
 	"log"
 	"sync"
+	"time"
 )
 
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
@@ -17,8 +18,9 @@ type mutexKV struct {
 // for the same key
 func (m *mutexKV) Lock(key string) {
 	log.Printf("[DEBUG] Locking %q", key)
+	start := time.Now()
 	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	log.Printf("[DEBUG] Locked %q (waited %s)", key, time.Since(start).Round(time.Millisecond))
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
@@ -0,0 +1,65 @@
+package locks
+
+import (
+	"sort"
+	"strings"
+)
+
+// ResourceID is a parsed Resource ID, such as those generated by the Resource ID generator
+type ResourceID interface {
+	ID() string
+}
+
+// ByResourceID locks the specified Resource ID
+//
+// Unlike ByName, this is unique per Resource - meaning that resources with the same name in
+// different Subscriptions/Resource Groups can be modified concurrently
+func ByResourceID(id ResourceID) {
+	armMutexKV.Lock(resourceIDLockKey(id))
+}
+
+// UnlockByResourceID unlocks the specified Resource ID, which must have been locked using ByResourceID
+func UnlockByResourceID(id ResourceID) {
+	armMutexKV.Unlock(resourceIDLockKey(id))
+}
+
+// MultipleByResourceID locks each of the specified Resource IDs (ignoring any duplicates)
+//
+// Locks are always acquired in a canonical order, regardless of the order of the Resource IDs,
+// to avoid deadlocks when different resources lock the same Resource IDs concurrently. Since this
+// orders by the Resource ID, a parent resource (e.g. a Virtual Network) is locked prior to any
+// nested resources (e.g. a Subnet) - as such resources should lock all of the Resource IDs they
+// require in a single call, rather than calling this multiple times.
+func MultipleByResourceID(ids ...ResourceID) {
+	for _, key := range resourceIDLockKeys(ids) {
+		armMutexKV.Lock(key)
+	}
+}
+
+// UnlockMultipleByResourceID unlocks each of the specified Resource IDs, which must have been
+// locked using MultipleByResourceID
+func UnlockMultipleByResourceID(ids ...ResourceID) {
+	keys := resourceIDLockKeys(ids)
+	for i := len(keys) - 1; i >= 0; i-- {
+		armMutexKV.Unlock(keys[i])
+	}
+}
+
+// resourceIDLockKey returns the key used to lock the Resource ID - since Resource ID's are
+// case-insensitive in Azure this is normalized
+func resourceIDLockKey(id ResourceID) string {
+	return strings.ToLower(id.ID())
+}
+
+// resourceIDLockKeys returns the unique keys used to lock these Resource ID's in the order
+// in which they should be locked
+func resourceIDLockKeys(ids []ResourceID) []string {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, resourceIDLockKey(id))
+	}
+
+	keys = removeDuplicatesFromStringArray(keys)
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,71 @@
+package locks
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testResourceID string
+
+func (id testResourceID) ID() string {
+	return string(id)
+}
+
+func TestResourceIDLockKeys(t *testing.T) {
+	virtualNetwork := testResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/hub")
+	subnet := testResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/hub/subnets/subnet1")
+	subnetDifferentCasing := testResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/GROUP1/providers/Microsoft.Network/virtualNetworks/hub/subnets/subnet1")
+	otherVirtualNetwork := testResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/virtualNetworks/hub")
+
+	expected := []string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/virtualnetworks/hub",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/virtualnetworks/hub/subnets/subnet1",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group2/providers/microsoft.network/virtualnetworks/hub",
+	}
+
+	cases := [][]ResourceID{
+		{virtualNetwork, subnet, otherVirtualNetwork},
+		{subnet, otherVirtualNetwork, virtualNetwork},
+		{otherVirtualNetwork, subnetDifferentCasing, virtualNetwork, subnet},
+	}
+	for _, ids := range cases {
+		if actual := resourceIDLockKeys(ids); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("expected %+v but got %+v", expected, actual)
+		}
+	}
+}
+
+func TestMultipleByResourceIDDifferentOrders(t *testing.T) {
+	first := testResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1")
+	second := testResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1")
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		ids := []ResourceID{first, second}
+		if i%2 == 0 {
+			ids = []ResourceID{second, first}
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			MultipleByResourceID(ids...)
+			time.Sleep(time.Millisecond)
+			UnlockMultipleByResourceID(ids...)
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatalf("timed out acquiring locks - expected locks to be acquired in a canonical order")
+	}
+}
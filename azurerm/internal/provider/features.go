@@ -1,7 +1,12 @@
 package provider
 
 import (
+	"fmt"
+	"path"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
@@ -54,6 +59,20 @@ func schemaFeatures(supportLegacyTestSuite bool) *schema.Schema {
 			},
 		},
 
+		"resource_group": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"prevent_deletion_if_contains_resources": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
 		"template_deployment": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -68,6 +87,40 @@ func schemaFeatures(supportLegacyTestSuite bool) *schema.Schema {
 			},
 		},
 
+		"timeouts": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"resource_type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateFeaturesTimeoutsResourceType,
+					},
+					"create": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateFeaturesTimeoutsDuration,
+					},
+					"read": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateFeaturesTimeoutsDuration,
+					},
+					"update": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateFeaturesTimeoutsDuration,
+					},
+					"delete": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateFeaturesTimeoutsDuration,
+					},
+				},
+			},
+		},
+
 		"virtual_machine": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -82,6 +135,22 @@ func schemaFeatures(supportLegacyTestSuite bool) *schema.Schema {
 						Type:     schema.TypeBool,
 						Optional: true,
 					},
+					"delete_data_disks_on_deletion": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+					"delete_network_interfaces_on_deletion": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+					"delete_public_ip_addresses_on_deletion": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+					"deletion_dry_run": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
 				},
 			},
 		},
@@ -96,6 +165,32 @@ func schemaFeatures(supportLegacyTestSuite bool) *schema.Schema {
 						Type:     schema.TypeBool,
 						Required: true,
 					},
+
+					"manual_upgrade_batch_size": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"manual_upgrade_max_unhealthy_instance_percent": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      20,
+						ValidateFunc: validation.IntBetween(0, 100),
+					},
+
+					"manual_upgrade_reimage_instances": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"manual_upgrade_wait_for_healthy_instances": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
 				},
 			},
 		},
@@ -167,6 +262,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["resource_group"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			resourceGroupRaw := items[0].(map[string]interface{})
+			if v, ok := resourceGroupRaw["prevent_deletion_if_contains_resources"]; ok {
+				features.ResourceGroup.PreventDeletionIfContainsResources = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["template_deployment"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -177,6 +282,18 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["timeouts"]; ok {
+		items := raw.([]interface{})
+		for _, item := range items {
+			if item == nil {
+				continue
+			}
+
+			timeoutsRaw := item.(map[string]interface{})
+			features.Timeouts = append(features.Timeouts, expandFeaturesTimeouts(timeoutsRaw))
+		}
+	}
+
 	if raw, ok := val["virtual_machine"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -187,6 +304,18 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := virtualMachinesRaw["graceful_shutdown"]; ok {
 				features.VirtualMachine.GracefulShutdown = v.(bool)
 			}
+			if v, ok := virtualMachinesRaw["delete_data_disks_on_deletion"]; ok {
+				features.VirtualMachine.DeleteDataDisksOnDeletion = v.(bool)
+			}
+			if v, ok := virtualMachinesRaw["delete_network_interfaces_on_deletion"]; ok {
+				features.VirtualMachine.DeleteNetworkInterfacesOnDeletion = v.(bool)
+			}
+			if v, ok := virtualMachinesRaw["delete_public_ip_addresses_on_deletion"]; ok {
+				features.VirtualMachine.DeletePublicIPAddressesOnDeletion = v.(bool)
+			}
+			if v, ok := virtualMachinesRaw["deletion_dry_run"]; ok {
+				features.VirtualMachine.DeletionDryRun = v.(bool)
+			}
 		}
 	}
 
@@ -197,8 +326,75 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := scaleSetRaw["roll_instances_when_required"]; ok {
 				features.VirtualMachineScaleSet.RollInstancesWhenRequired = v.(bool)
 			}
+			if v, ok := scaleSetRaw["manual_upgrade_batch_size"]; ok {
+				features.VirtualMachineScaleSet.ManualUpgradeBatchSize = v.(int)
+			}
+			if v, ok := scaleSetRaw["manual_upgrade_max_unhealthy_instance_percent"]; ok {
+				features.VirtualMachineScaleSet.ManualUpgradeMaxUnhealthyInstancePercent = v.(int)
+			}
+			if v, ok := scaleSetRaw["manual_upgrade_reimage_instances"]; ok {
+				features.VirtualMachineScaleSet.ManualUpgradeReimageInstances = v.(bool)
+			}
+			if v, ok := scaleSetRaw["manual_upgrade_wait_for_healthy_instances"]; ok {
+				features.VirtualMachineScaleSet.ManualUpgradeWaitForHealthyInstances = v.(bool)
+			}
 		}
 	}
 
 	return features
 }
+
+func expandFeaturesTimeouts(input map[string]interface{}) features.TimeoutsFeatures {
+	output := features.TimeoutsFeatures{
+		ResourceType: input["resource_type"].(string),
+	}
+
+	// these have been validated at this point, so parsing errors can be ignored
+	parse := func(key string) *time.Duration {
+		if v, ok := input[key].(string); ok && v != "" {
+			if duration, err := time.ParseDuration(v); err == nil {
+				return &duration
+			}
+		}
+
+		return nil
+	}
+	output.Create = parse("create")
+	output.Read = parse("read")
+	output.Update = parse("update")
+	output.Delete = parse("delete")
+
+	return output
+}
+
+func validateFeaturesTimeoutsResourceType(i interface{}, k string) (warnings []string, errors []error) {
+	if warnings, errors = validation.StringIsNotEmpty(i, k); len(errors) > 0 {
+		return warnings, errors
+	}
+
+	if _, err := path.Match(i.(string), ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a Resource Type or a glob matching Resource Types (e.g. `azurerm_kubernetes_*`): %+v", k, err))
+	}
+
+	return warnings, errors
+}
+
+func validateFeaturesTimeoutsDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration (e.g. `30m` or `3h`): %+v", k, err))
+		return warnings, errors
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+	}
+
+	return warnings, errors
+}
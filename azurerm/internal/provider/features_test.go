@@ -3,6 +3,7 @@ package provider
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
@@ -32,7 +33,10 @@ func TestExpandFeatures(t *testing.T) {
 					DeleteOSDiskOnDeletion: true,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
-					RollInstancesWhenRequired: true,
+					RollInstancesWhenRequired:                true,
+					ManualUpgradeBatchSize:                   1,
+					ManualUpgradeMaxUnhealthyInstancePercent: 20,
+					ManualUpgradeReimageInstances:            true,
 				},
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
@@ -59,6 +63,11 @@ func TestExpandFeatures(t *testing.T) {
 							"relaxed_locking": true,
 						},
 					},
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_contains_resources": true,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": true,
@@ -88,6 +97,9 @@ func TestExpandFeatures(t *testing.T) {
 				Network: features.NetworkFeatures{
 					RelaxedLocking: true,
 				},
+				ResourceGroup: features.ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: true,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -96,7 +108,10 @@ func TestExpandFeatures(t *testing.T) {
 					GracefulShutdown:       true,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
-					RollInstancesWhenRequired: true,
+					RollInstancesWhenRequired:                true,
+					ManualUpgradeBatchSize:                   1,
+					ManualUpgradeMaxUnhealthyInstancePercent: 20,
+					ManualUpgradeReimageInstances:            true,
 				},
 			},
 		},
@@ -157,7 +172,10 @@ func TestExpandFeatures(t *testing.T) {
 					GracefulShutdown:       false,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
-					RollInstancesWhenRequired: false,
+					RollInstancesWhenRequired:                false,
+					ManualUpgradeBatchSize:                   1,
+					ManualUpgradeMaxUnhealthyInstancePercent: 20,
+					ManualUpgradeReimageInstances:            true,
 				},
 			},
 		},
@@ -307,6 +325,71 @@ func TestExpandFeaturesNetwork(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesResourceGroup(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_group": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGroup: features.ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: false,
+				},
+			},
+		},
+		{
+			Name: "Prevent Deletion If Contains Resources Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_contains_resources": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGroup: features.ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: true,
+				},
+			},
+		},
+		{
+			Name: "Prevent Deletion If Contains Resources Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_group": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_contains_resources": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceGroup: features.ResourceGroupFeatures{
+					PreventDeletionIfContainsResources: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ResourceGroup, testCase.Expected.ResourceGroup) {
+			t.Fatalf("Expected %+v but got %+v", result.ResourceGroup, testCase.Expected.ResourceGroup)
+		}
+	}
+}
+
 func TestExpandFeaturesTemplateDeployment(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -431,6 +514,33 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Delete Attached Resources with a Dry Run",
+			Input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion":             true,
+							"graceful_shutdown":                      false,
+							"delete_data_disks_on_deletion":          true,
+							"delete_network_interfaces_on_deletion":  true,
+							"delete_public_ip_addresses_on_deletion": true,
+							"deletion_dry_run":                       true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion:            true,
+					GracefulShutdown:                  false,
+					DeleteDataDisksOnDeletion:         true,
+					DeleteNetworkInterfacesOnDeletion: true,
+					DeletePublicIPAddressesOnDeletion: true,
+					DeletionDryRun:                    true,
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testData {
@@ -458,7 +568,10 @@ func TestExpandFeaturesVirtualMachineScaleSet(t *testing.T) {
 			},
 			Expected: features.UserFeatures{
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
-					RollInstancesWhenRequired: true,
+					RollInstancesWhenRequired:                true,
+					ManualUpgradeBatchSize:                   1,
+					ManualUpgradeMaxUnhealthyInstancePercent: 20,
+					ManualUpgradeReimageInstances:            true,
 				},
 			},
 		},
@@ -475,7 +588,10 @@ func TestExpandFeaturesVirtualMachineScaleSet(t *testing.T) {
 			},
 			Expected: features.UserFeatures{
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
-					RollInstancesWhenRequired: true,
+					RollInstancesWhenRequired:                true,
+					ManualUpgradeBatchSize:                   1,
+					ManualUpgradeMaxUnhealthyInstancePercent: 20,
+					ManualUpgradeReimageInstances:            true,
 				},
 			},
 		},
@@ -492,7 +608,35 @@ func TestExpandFeaturesVirtualMachineScaleSet(t *testing.T) {
 			},
 			Expected: features.UserFeatures{
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
-					RollInstancesWhenRequired: false,
+					RollInstancesWhenRequired:                false,
+					ManualUpgradeBatchSize:                   1,
+					ManualUpgradeMaxUnhealthyInstancePercent: 20,
+					ManualUpgradeReimageInstances:            true,
+				},
+			},
+		},
+		{
+			Name: "Manual Upgrade",
+			Input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine_scale_set": []interface{}{
+						map[string]interface{}{
+							"roll_instances_when_required":                  true,
+							"manual_upgrade_batch_size":                     10,
+							"manual_upgrade_max_unhealthy_instance_percent": 5,
+							"manual_upgrade_reimage_instances":              false,
+							"manual_upgrade_wait_for_healthy_instances":     true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
+					RollInstancesWhenRequired:                true,
+					ManualUpgradeBatchSize:                   10,
+					ManualUpgradeMaxUnhealthyInstancePercent: 5,
+					ManualUpgradeReimageInstances:            false,
+					ManualUpgradeWaitForHealthyInstances:     true,
 				},
 			},
 		},
@@ -570,3 +714,70 @@ func TestExpandFeaturesLogAnalyticsWorkspace(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesTimeouts(t *testing.T) {
+	threeHours := 3 * time.Hour
+	thirtyMinutes := 30 * time.Minute
+
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"timeouts": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Timeouts: nil,
+			},
+		},
+		{
+			Name: "Multiple Overrides",
+			Input: []interface{}{
+				map[string]interface{}{
+					"timeouts": []interface{}{
+						map[string]interface{}{
+							"resource_type": "azurerm_kubernetes_*",
+							"create":        "3h",
+							"read":          "",
+							"update":        "3h",
+							"delete":        "",
+						},
+						map[string]interface{}{
+							"resource_type": "azurerm_resource_group",
+							"create":        "",
+							"read":          "",
+							"update":        "",
+							"delete":        "30m",
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Timeouts: []features.TimeoutsFeatures{
+					{
+						ResourceType: "azurerm_kubernetes_*",
+						Create:       &threeHours,
+						Update:       &threeHours,
+					},
+					{
+						ResourceType: "azurerm_resource_group",
+						Delete:       &thirtyMinutes,
+					},
+				},
+			},
+		},
+	}
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Timeouts, testCase.Expected.Timeouts) {
+			t.Fatalf("Expected %+v but got %+v", result.Timeouts, testCase.Expected.Timeouts)
+		}
+	}
+}
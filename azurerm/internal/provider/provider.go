@@ -8,6 +8,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -15,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,6 +28,22 @@ func TestAzureProvider() terraform.ResourceProvider {
 	return azureProvider(true)
 }
 
+// TestAzureProviderWithSender returns a Provider for use in Acceptance Tests which sends all requests to Azure
+// using the specified Sender (e.g. to record/replay requests) - when `offline` is true authentication is skipped
+func TestAzureProviderWithSender(sender autorest.Sender, offline bool) terraform.ResourceProvider {
+	p := azureProvider(true).(*schema.Provider)
+	p.ConfigureFunc = providerConfigureWithSender(p, sender, offline, "")
+	return p
+}
+
+// TestAzureProviderWithResourceManagerEndpoint returns a Provider for use in Acceptance Tests which sends all
+// Resource Manager requests to the specified endpoint (e.g. the Fake ARM Server) - skipping authentication
+func TestAzureProviderWithResourceManagerEndpoint(endpoint string) terraform.ResourceProvider {
+	p := azureProvider(true).(*schema.Provider)
+	p.ConfigureFunc = providerConfigureWithSender(p, nil, true, endpoint)
+	return p
+}
+
 func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 	// avoids this showing up in test output
 	debugLog := func(f string, v ...interface{}) {
@@ -98,6 +116,12 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 		}
 	}
 
+	// add `tags_all` to all Resources supporting Tags, which includes the `default_tags` from the Provider block
+	applyProviderTags(resources)
+
+	// make the `timeouts` blocks from the Provider's `features` block available when determining each Resource's Timeouts
+	applyTimeoutDefaultOverrides(resources)
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -212,6 +236,26 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 				Description: "This will disable the Terraform Partner ID which is used if a custom `partner_id` isn't specified.",
 			},
 
+			"max_concurrent_writes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_CONCURRENT_WRITES", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of concurrent write requests (PUT/PATCH/POST/DELETE) which should be sent to Azure Resource Manager. Defaults to `0` (no limit).",
+			},
+
+			"default_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: tags.Validate,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Tags which should be assigned to every Resource which supports Tags, unless a Tag with the same key is defined on the Resource.",
+			},
+
+			"ignore_tags": schemaIgnoreTags(),
+
 			"features": schemaFeatures(supportLegacyTestSuite),
 
 			// Advanced feature flags
@@ -222,6 +266,32 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 				Description: "Should the AzureRM Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
 
+			"resource_provider_registrations": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RESOURCE_PROVIDER_REGISTRATIONS", resourceproviders.RegistrationsAll),
+				ValidateFunc: validation.StringInSlice(resourceproviders.PossibleRegistrations(), false),
+				Description:  "The set of Resource Providers which should be automatically registered for the Subscription. Possible values are `all`, `core` and `none`. Defaults to `all`.",
+			},
+
+			"resource_providers_to_register": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				Description: "A list of additional Resource Providers which should be registered for the Subscription.",
+			},
+
+			"resource_provider_registration_cache_ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RESOURCE_PROVIDER_REGISTRATION_CACHE_TTL", resourceproviders.DefaultRegistrationCacheTTL.String()),
+				ValidateFunc: validateResourceProviderRegistrationCacheTTL,
+				Description:  "The duration for which the registered Resource Providers are cached on disk, to avoid listing the Resource Providers on each run. Setting this to `0` disables the cache.",
+			},
+
 			"storage_use_azuread": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -249,6 +319,10 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 }
 
 func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
+	return providerConfigureWithSender(p, nil, false, "")
+}
+
+func providerConfigureWithSender(p *schema.Provider, sender autorest.Sender, offline bool, resourceManagerEndpoint string) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
 		var auxTenants []string
 		if v, ok := d.Get("auxiliary_tenant_ids").([]interface{}); ok && len(v) > 0 {
@@ -305,7 +379,14 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			terraformVersion = "0.11+compatible"
 		}
 
-		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
+		userFeatures := expandFeatures(d.Get("features").([]interface{}))
+
+		requiredResourceProviders, err := resourceProvidersToRegister(d)
+		if err != nil {
+			return nil, err
+		}
+
+		skipProviderRegistration := len(requiredResourceProviders) == 0
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:                  config,
 			SkipProviderRegistration:    skipProviderRegistration,
@@ -313,8 +394,13 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			PartnerId:                   d.Get("partner_id").(string),
 			DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 			DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
-			Features:                    expandFeatures(d.Get("features").([]interface{})),
+			Features:                    userFeatures,
+			MaxConcurrentWrites:         d.Get("max_concurrent_writes").(int),
 			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
+			Tags:                        expandProviderTags(d.Get("default_tags").(map[string]interface{}), d.Get("ignore_tags").([]interface{})),
+			Sender:                      sender,
+			Offline:                     offline,
+			ResourceManagerEndpoint:     resourceManagerEndpoint,
 		}
 		client, err := clients.Build(p.StopContext(), clientBuilder)
 		if err != nil {
@@ -323,21 +409,12 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 
 		client.StopContext = p.StopContext()
 
-		if !skipProviderRegistration {
-			// List all the available providers and their registration state to avoid unnecessary
-			// requests. This also lets us check if the provider credentials are correct.
-			ctx := client.StopContext
-			providerList, err := client.Resource.ProvidersClient.List(ctx, nil, "")
-			if err != nil {
-				return nil, fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
-					"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
-					"error: %s", err)
-			}
+		client.Account.ResourceProvidersToRegister = requiredResourceProviders
 
-			availableResourceProviders := providerList.Values()
-			requiredResourceProviders := resourceproviders.Required()
-
-			if err := resourceproviders.EnsureRegistered(ctx, *client.Resource.ProvidersClient, availableResourceProviders, requiredResourceProviders); err != nil {
+		if !skipProviderRegistration {
+			// the on-disk cache is only used when talking to Azure, since requests are recorded/replayed in tests
+			useCache := sender == nil && !offline
+			if err := registerResourceProviders(client.StopContext, d, client, requiredResourceProviders, useCache); err != nil {
 				return nil, fmt.Errorf(resourceProviderRegistrationErrorFmt, err)
 			}
 		}
@@ -351,7 +428,9 @@ const resourceProviderRegistrationErrorFmt = `Error ensuring Resource Providers
 Terraform automatically attempts to register the Resource Providers it supports to
 ensure it's able to provision resources.
 
-If you don't have permission to register Resource Providers you may wish to use the
+If you don't have permission to register Resource Providers you may wish to limit the
+Resource Providers which are registered using the "resource_provider_registrations" and
+"resource_providers_to_register" fields in the Provider block - or to use the
 "skip_provider_registration" flag in the Provider block to disable this functionality.
 
 Please note that if you opt out of Resource Provider Registration and Terraform tries
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func TestProvider(t *testing.T) {
@@ -89,3 +90,75 @@ func TestResourcesSupportCustomTimeouts(t *testing.T) {
 func TestProvider_impl(t *testing.T) {
 	_ = AzureProvider()
 }
+
+func TestResourceIDRegistrationsReferenceResources(t *testing.T) {
+	provider := TestAzureProvider().(*schema.Provider)
+
+	registrations := resourceid.Match("")
+	if len(registrations) != 0 {
+		t.Fatalf("Expected an empty Resource ID not to match any Registrations but got %d", len(registrations))
+	}
+
+	subnetId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	resourceTypes := resourceid.ResourceTypesForID(subnetId)
+	if len(resourceTypes) != 1 || resourceTypes[0] != "azurerm_subnet" {
+		t.Fatalf("Expected the Subnet ID to match `azurerm_subnet` but got %+v", resourceTypes)
+	}
+
+	// the Example ID for each Registration should match (at least) that Registration, and
+	// the Resource Types should be those within the Provider
+	for _, registration := range resourceid.Registrations() {
+		matched := false
+		for _, match := range resourceid.Match(registration.ExampleID) {
+			if match.ServicePackageName == registration.ServicePackageName && match.TypeName == registration.TypeName {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			t.Fatalf("the Example ID for %s/%s didn't match its own Registration", registration.ServicePackageName, registration.TypeName)
+		}
+
+		for _, resourceType := range registration.ResourceTypes {
+			if _, ok := provider.ResourcesMap[resourceType]; !ok {
+				t.Fatalf("the Registration for %s/%s references the Resource %q which doesn't exist", registration.ServicePackageName, registration.TypeName, resourceType)
+			}
+		}
+	}
+}
+
+func TestResourceIDRegistrationsDefineResourceTypes(t *testing.T) {
+	// these Resource ID Types are for nested items (or Data Sources) rather than a Terraform Resource
+	withoutResources := map[string]struct{}{
+		"compute/HybridMachine":                            {},
+		"frontdoor/BackendPool":                            {},
+		"frontdoor/HealthProbe":                            {},
+		"frontdoor/LoadBalancing":                          {},
+		"frontdoor/RoutingRule":                            {},
+		"loadbalancer/LoadBalancerFrontendIpConfiguration": {},
+		"mssql/RecoverableDatabase":                        {},
+		"network/PrivateDnsZoneConfig":                     {},
+		"network/PrivateDnsZoneGroup":                      {},
+		"network/VirtualNetworkGatewayIpConfiguration":     {},
+		"network/VpnSiteLink":                              {},
+		"sentinel/SentinelAlertRuleTemplate":               {},
+		"storage/StorageContainerResourceManager":          {},
+		"storage/StorageShareResourceManager":              {},
+	}
+
+	for _, registration := range resourceid.Registrations() {
+		key := fmt.Sprintf("%s/%s", registration.ServicePackageName, registration.TypeName)
+		t.Logf("[DEBUG] Testing %q..", key)
+
+		if _, ok := withoutResources[key]; ok {
+			if len(registration.ResourceTypes) > 0 {
+				t.Fatalf("the Registration for %s defines Resource Types - it should be removed from the list of Resource ID Types without Resources", key)
+			}
+			continue
+		}
+
+		if len(registration.ResourceTypes) == 0 {
+			t.Fatalf("the Registration for %s doesn't define any Resource Types - these should be specified using `-resource-types` within `resourceids.go`", key)
+		}
+	}
+}
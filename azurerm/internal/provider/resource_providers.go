@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func validateResourceProviderRegistrationCacheTTL(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration (e.g. `0`, `30m` or `24h`): %+v", k, err))
+		return warnings, errors
+	}
+
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	}
+
+	return warnings, errors
+}
+
+// resourceProvidersToRegister returns the Resource Providers which should be automatically registered,
+// taking into account the legacy `skip_provider_registration` field
+func resourceProvidersToRegister(d *schema.ResourceData) (map[string]struct{}, error) {
+	if d.Get("skip_provider_registration").(bool) {
+		return map[string]struct{}{}, nil
+	}
+
+	additional := *utils.ExpandStringSlice(d.Get("resource_providers_to_register").([]interface{}))
+	return resourceproviders.ForRegistration(d.Get("resource_provider_registrations").(string), additional)
+}
+
+// registerResourceProviders registers the specified Resource Providers, using the on-disk cache of
+// registered Resource Providers when useCache is true
+func registerResourceProviders(ctx context.Context, d *schema.ResourceData, client *clients.Client, requiredResourceProviders map[string]struct{}, useCache bool) error {
+	var cache *resourceproviders.RegistrationCache
+	if useCache {
+		// this has been validated at plan time
+		ttl, _ := time.ParseDuration(d.Get("resource_provider_registration_cache_ttl").(string))
+		cache = resourceproviders.NewRegistrationCache(client.Account.SubscriptionId, ttl)
+	}
+
+	return resourceproviders.Register(ctx, *client.Resource.ProvidersClient, requiredResourceProviders, cache)
+}
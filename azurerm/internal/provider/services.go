@@ -100,6 +100,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		eventhub.Registration{},
 		loadbalancer.Registration{},
 		resource.Registration{},
+		securitycenter.Registration{},
 	}
 }
 
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func schemaIgnoreTags() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"key_prefixes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandProviderTags(defaultTags map[string]interface{}, ignoreTags []interface{}) tags.ProviderConfig {
+	output := tags.ProviderConfig{
+		DefaultTags: tags.ToTypedObject(tags.Expand(defaultTags)),
+	}
+
+	if len(ignoreTags) == 0 || ignoreTags[0] == nil {
+		return output
+	}
+
+	raw := ignoreTags[0].(map[string]interface{})
+	if v, ok := raw["keys"]; ok {
+		output.IgnoreKeys = *utils.ExpandStringSlice(v.(*schema.Set).List())
+	}
+	if v, ok := raw["key_prefixes"]; ok {
+		output.IgnoreKeyPrefixes = *utils.ExpandStringSlice(v.(*schema.Set).List())
+	}
+
+	return output
+}
+
+// applyProviderTags adds a computed `tags_all` field to each Resource which supports Tags, and plans the
+// new value for this during CustomizeDiff - the `default_tags` and `ignore_tags` defined in the Provider
+// block are applied when the Tags are expanded/flattened using the `Tags` configuration from the Client
+func applyProviderTags(resources map[string]*schema.Resource) {
+	for _, resource := range resources {
+		if !supportsProviderTags(resource) {
+			continue
+		}
+
+		// changes to the Default Tags require the Resource to be recreated where Tags can't be updated in-place
+		forceNew := resource.Update == nil || resource.Schema["tags"].ForceNew
+
+		resource.Schema["tags_all"] = tags.SchemaAll()
+		resource.CustomizeDiff = customizeDiffWithProviderTags(resource.CustomizeDiff, forceNew)
+	}
+}
+
+func supportsProviderTags(resource *schema.Resource) bool {
+	v, ok := resource.Schema["tags"]
+	if !ok || v.Type != schema.TypeMap || !v.Optional {
+		return false
+	}
+
+	_, exists := resource.Schema["tags_all"]
+	return !exists
+}
+
+func customizeDiffWithProviderTags(f schema.CustomizeDiffFunc, forceNew bool) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		if f != nil {
+			if err := f(d, meta); err != nil {
+				return err
+			}
+		}
+
+		return meta.(*clients.Client).Tags.PlanAll(d, forceNew)
+	}
+}
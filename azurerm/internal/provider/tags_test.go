@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+)
+
+func TestApplyProviderTags(t *testing.T) {
+	// the tags which exist in Azure
+	var azure map[string]*string
+
+	resource := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			azure = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
+			d.SetId("example")
+			return meta.(*clients.Client).Tags.FlattenAndSet(d, azure)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return meta.(*clients.Client).Tags.FlattenAndSet(d, azure)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"tags": tags.ForceNewSchema(),
+		},
+	}
+	applyProviderTags(map[string]*schema.Resource{
+		"azurerm_example": resource,
+	})
+	if _, ok := resource.Schema["tags_all"]; !ok {
+		t.Fatalf("expected `tags_all` to be added to the Resource but it wasn't")
+	}
+
+	client := &clients.Client{
+		Tags: tags.ProviderConfig{
+			DefaultTags: map[string]string{
+				"cost-center": "1234",
+			},
+			IgnoreKeys: []string{"created-by"},
+		},
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tags": map[string]interface{}{
+			"env": "test",
+		},
+	})
+	diff, err := resource.Diff(nil, config, client)
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	state, err := resource.Apply(nil, diff, client)
+	if err != nil {
+		t.Fatalf("creating: %+v", err)
+	}
+
+	expectedAll := map[string]interface{}{
+		"cost-center": "1234",
+		"env":         "test",
+	}
+	if actual := tags.Flatten(azure); !reflect.DeepEqual(actual, expectedAll) {
+		t.Fatalf("expected the Default Tags to be sent to Azure, got %+v", actual)
+	}
+
+	// tags added outside of Terraform which are ignored shouldn't show up
+	createdBy := "policy"
+	azure["created-by"] = &createdBy
+	state, err = resource.RefreshWithoutUpgrade(state, client)
+	if err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+
+	d := resource.Data(state)
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, map[string]interface{}{"env": "test"}) {
+		t.Fatalf("expected `tags` to only contain the Tags defined on the Resource, got %+v", actual)
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedAll) {
+		t.Fatalf("expected `tags_all` to contain the Default Tags, got %+v", actual)
+	}
+
+	diff, err = resource.Diff(state, config, client)
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Fatalf("expected no changes when the Default Tags are unchanged, got %+v", diff)
+	}
+
+	// changes to the Default Tags can't be applied in-place, since the Tags are ForceNew
+	client.Tags = tags.ProviderConfig{
+		DefaultTags: map[string]string{
+			"cost-center": "5678",
+		},
+	}
+	diff, err = resource.Diff(state, config, client)
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatalf("expected a change to the Default Tags to require recreation, got %+v", diff)
+	}
+}
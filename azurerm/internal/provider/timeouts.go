@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+// applyTimeoutDefaultOverrides wraps the Create/Read/Update/Delete functions for each Resource so that the
+// `timeouts` blocks defined within the Provider's `features` block are available to the `timeouts.For*`
+// functions. These are looked up from the configured Client for each operation, rather than overriding
+// each Resource's Timeouts when the Provider is configured - which isn't safe across Provider instances.
+func applyTimeoutDefaultOverrides(resources map[string]*schema.Resource) {
+	for resourceType, resource := range resources {
+		if resource.Timeouts == nil {
+			continue
+		}
+
+		defaults := *resource.Timeouts
+		if resource.Create != nil {
+			resource.Create = withTimeoutDefaultOverrides(resourceType, defaults, resource.Create)
+		}
+		if resource.Read != nil {
+			resource.Read = withTimeoutDefaultOverrides(resourceType, defaults, resource.Read)
+		}
+		if resource.Update != nil {
+			resource.Update = withTimeoutDefaultOverrides(resourceType, defaults, resource.Update)
+		}
+		if resource.Delete != nil {
+			resource.Delete = withTimeoutDefaultOverrides(resourceType, defaults, resource.Delete)
+		}
+	}
+}
+
+func withTimeoutDefaultOverrides(resourceType string, defaults schema.ResourceTimeout, f func(d *schema.ResourceData, meta interface{}) error) func(d *schema.ResourceData, meta interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client, ok := meta.(*clients.Client)
+		if !ok || len(client.Features.Timeouts) == 0 {
+			return f(d, meta)
+		}
+
+		// the Client is shared across all operations, so the overrides are set on a copy of it
+		withOverrides := *client
+		withOverrides.StopContext = timeouts.WithDefaultOverrides(client.StopContext, resourceType, &defaults, client.Features.Timeouts)
+		return f(d, &withOverrides)
+	}
+}
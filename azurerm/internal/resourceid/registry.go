@@ -0,0 +1,121 @@
+package resourceid
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Parser parses a Resource ID, returning an error if the Resource ID isn't valid for this Resource ID Type
+type Parser func(input string) (Formatter, error)
+
+// Registration describes a Resource ID Type, along with the Terraform Resources which use it. These are
+// generated alongside the Resource ID Parsers (by `tools/generator-resource-id`) and registered when
+// the Service Package containing the Parser is loaded.
+type Registration struct {
+	// ServicePackageName is the name of the Service Package containing the Parser, e.g. `network`
+	ServicePackageName string
+
+	// TypeName is the name of this Resource ID Type, e.g. `Subnet`
+	TypeName string
+
+	// ExampleID is an example of this Resource ID, which is used to determine the Resource Provider
+	// which this Resource ID belongs to - since the Parsers don't check this
+	ExampleID string
+
+	// ResourceTypes are the Terraform Resources which use this Resource ID, e.g. `azurerm_subnet`
+	ResourceTypes []string
+
+	// Parser parses a Resource ID of this Type
+	Parser Parser
+}
+
+var (
+	registrations     = make([]Registration, 0)
+	registrationsLock = &sync.RWMutex{}
+)
+
+// Register registers the Resource ID Type so that it can be matched against an arbitrary Resource ID
+func Register(registration Registration) {
+	registrationsLock.Lock()
+	defer registrationsLock.Unlock()
+
+	registrations = append(registrations, registration)
+}
+
+// Registrations returns all of the Registrations, ordered by the Service Package and Type Name
+func Registrations() []Registration {
+	registrationsLock.RLock()
+	defer registrationsLock.RUnlock()
+
+	output := make([]Registration, len(registrations))
+	copy(output, registrations)
+	sortRegistrations(output)
+	return output
+}
+
+// Match returns the Registrations for each Resource ID Type which the specified Resource ID is valid for,
+// ordered by the Service Package and Type Name
+func Match(input string) []Registration {
+	registrationsLock.RLock()
+	defer registrationsLock.RUnlock()
+
+	namespace := resourceProviderNamespace(input)
+
+	matches := make([]Registration, 0)
+	for _, registration := range registrations {
+		if resourceProviderNamespace(registration.ExampleID) != namespace {
+			continue
+		}
+
+		if _, err := registration.Parser(input); err != nil {
+			continue
+		}
+
+		matches = append(matches, registration)
+	}
+
+	sortRegistrations(matches)
+	return matches
+}
+
+func sortRegistrations(input []Registration) {
+	sort.Slice(input, func(i, j int) bool {
+		if input[i].ServicePackageName != input[j].ServicePackageName {
+			return input[i].ServicePackageName < input[j].ServicePackageName
+		}
+		return input[i].TypeName < input[j].TypeName
+	})
+}
+
+// ResourceTypesForID returns the (sorted) Terraform Resources which use the specified Resource ID
+func ResourceTypesForID(input string) []string {
+	unique := make(map[string]struct{})
+	for _, match := range Match(input) {
+		for _, resourceType := range match.ResourceTypes {
+			unique[resourceType] = struct{}{}
+		}
+	}
+
+	resourceTypes := make([]string, 0)
+	for resourceType := range unique {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	return resourceTypes
+}
+
+var resourceProviderNamespaceRegex = regexp.MustCompile(`(?i)/providers/([^/]+)`)
+
+// resourceProviderNamespace returns the (lower-cased) Resource Provider Namespace for the Resource ID, for
+// example `microsoft.network` - which is the last, since an Extension Resource can exist within another Resource
+func resourceProviderNamespace(input string) string {
+	matches := resourceProviderNamespaceRegex.FindAllStringSubmatch(input, -1)
+	if len(matches) == 0 {
+		return ""
+	}
+
+	return strings.ToLower(matches[len(matches)-1][1])
+}
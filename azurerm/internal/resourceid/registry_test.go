@@ -0,0 +1,111 @@
+package resourceid
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testId struct {
+	id string
+}
+
+func (id testId) ID() string {
+	return id.id
+}
+
+func testParserForSegments(segments ...string) Parser {
+	return func(input string) (Formatter, error) {
+		split := strings.Split(strings.TrimPrefix(input, "/"), "/")
+		if len(split) != len(segments)*2 {
+			return nil, fmt.Errorf("expected %d segments but got %d", len(segments)*2, len(split))
+		}
+		for i, segment := range segments {
+			if split[i*2] != segment {
+				return nil, fmt.Errorf("expected the segment %q but got %q", segment, split[i*2])
+			}
+		}
+		return testId{id: input}, nil
+	}
+}
+
+func TestMatch(t *testing.T) {
+	existing := registrations
+	defer func() {
+		registrations = existing
+	}()
+	registrations = make([]Registration, 0)
+
+	Register(Registration{
+		ServicePackageName: "network",
+		TypeName:           "VirtualNetwork",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1",
+		ResourceTypes:      []string{"azurerm_virtual_network"},
+		Parser:             testParserForSegments("subscriptions", "resourceGroups", "providers", "virtualNetworks"),
+	})
+	Register(Registration{
+		ServicePackageName: "network",
+		TypeName:           "Subnet",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		ResourceTypes:      []string{"azurerm_subnet"},
+		Parser:             testParserForSegments("subscriptions", "resourceGroups", "providers", "virtualNetworks", "subnets"),
+	})
+	Register(Registration{
+		ServicePackageName: "compute",
+		TypeName:           "VirtualMachine",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1",
+		ResourceTypes:      []string{"azurerm_linux_virtual_machine", "azurerm_windows_virtual_machine"},
+		Parser:             testParserForSegments("subscriptions", "resourceGroups", "providers", "virtualMachines"),
+	})
+
+	testData := []struct {
+		Input         string
+		Expected      []string
+		ResourceTypes []string
+	}{
+		{
+			Input:         "",
+			Expected:      []string{},
+			ResourceTypes: []string{},
+		},
+		{
+			Input:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected:      []string{"network/Subnet"},
+			ResourceTypes: []string{"azurerm_subnet"},
+		},
+		{
+			// the Resource Provider is matched case-insensitively
+			Input:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.network/virtualNetworks/network1",
+			Expected:      []string{"network/VirtualNetwork"},
+			ResourceTypes: []string{"azurerm_virtual_network"},
+		},
+		{
+			// a different Resource Provider with the same segments
+			Input:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ClassicNetwork/virtualNetworks/network1",
+			Expected:      []string{},
+			ResourceTypes: []string{},
+		},
+		{
+			Input:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/machine1",
+			Expected:      []string{"compute/VirtualMachine"},
+			ResourceTypes: []string{"azurerm_linux_virtual_machine", "azurerm_windows_virtual_machine"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual := make([]string, 0)
+		for _, match := range Match(v.Input) {
+			actual = append(actual, fmt.Sprintf("%s/%s", match.ServicePackageName, match.TypeName))
+		}
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+
+		if actual := ResourceTypesForID(v.Input); !reflect.DeepEqual(actual, v.ResourceTypes) {
+			t.Fatalf("Expected the Resource Types %+v but got %+v", v.ResourceTypes, actual)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package resourceid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ScopeType is the type of Scope within which a Resource exists
+type ScopeType string
+
+const (
+	ScopeTypeTenant          ScopeType = "Tenant"
+	ScopeTypeManagementGroup ScopeType = "ManagementGroup"
+	ScopeTypeSubscription    ScopeType = "Subscription"
+	ScopeTypeResourceGroup   ScopeType = "ResourceGroup"
+	ScopeTypeResource        ScopeType = "Resource"
+)
+
+// Scope is the Scope within which a Resource exists - for example an Extension Resource (such as a
+// Management Lock or Role Assignment) can exist within a Management Group, Subscription, Resource Group
+// or another Resource
+type Scope struct {
+	// Type is the type of this Scope
+	Type ScopeType
+
+	// ManagementGroupName is the name of the Management Group, when the Type is ManagementGroup
+	ManagementGroupName string
+
+	// SubscriptionId is the ID of the Subscription, when the Type is Subscription, ResourceGroup or Resource
+	SubscriptionId string
+
+	// ResourceGroup is the name of the Resource Group, when the Type is ResourceGroup or Resource (if the
+	// Resource exists within a Resource Group)
+	ResourceGroup string
+
+	// ResourceId is the ID of the Resource, when the Type is Resource
+	ResourceId string
+
+	// id is the ID of this Scope as it was parsed, which is returned from ID() so that the casing used
+	// within the original Resource ID is retained
+	id string
+}
+
+func NewTenantScope() Scope {
+	return Scope{
+		Type: ScopeTypeTenant,
+	}
+}
+
+func NewManagementGroupScope(managementGroupName string) Scope {
+	return Scope{
+		Type:                ScopeTypeManagementGroup,
+		ManagementGroupName: managementGroupName,
+	}
+}
+
+func NewSubscriptionScope(subscriptionId string) Scope {
+	return Scope{
+		Type:           ScopeTypeSubscription,
+		SubscriptionId: subscriptionId,
+	}
+}
+
+func NewResourceGroupScope(subscriptionId, resourceGroup string) Scope {
+	return Scope{
+		Type:           ScopeTypeResourceGroup,
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+	}
+}
+
+// ID returns the ID of this Scope - which is empty for the Tenant
+func (s Scope) ID() string {
+	if s.id != "" {
+		return s.id
+	}
+
+	switch s.Type {
+	case ScopeTypeManagementGroup:
+		return fmt.Sprintf("/providers/Microsoft.Management/managementGroups/%s", s.ManagementGroupName)
+	case ScopeTypeSubscription:
+		return fmt.Sprintf("/subscriptions/%s", s.SubscriptionId)
+	case ScopeTypeResourceGroup:
+		return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", s.SubscriptionId, s.ResourceGroup)
+	case ScopeTypeResource:
+		return s.ResourceId
+	}
+
+	return ""
+}
+
+func (s Scope) String() string {
+	switch s.Type {
+	case ScopeTypeManagementGroup:
+		return fmt.Sprintf("Management Group %q", s.ManagementGroupName)
+	case ScopeTypeSubscription:
+		return fmt.Sprintf("Subscription %q", s.SubscriptionId)
+	case ScopeTypeResourceGroup:
+		return fmt.Sprintf("Resource Group %q (Subscription %q)", s.ResourceGroup, s.SubscriptionId)
+	case ScopeTypeResource:
+		return fmt.Sprintf("Resource %q", s.ResourceId)
+	}
+
+	return "Tenant"
+}
+
+// ParseScope parses the ID of a Scope, which is either empty (for the Tenant) or the ID of a Management Group,
+// Subscription, Resource Group or Resource
+func ParseScope(input string) (*Scope, error) {
+	if input == "" || input == "/" {
+		scope := NewTenantScope()
+		return &scope, nil
+	}
+
+	if !strings.HasPrefix(input, "/") {
+		return nil, fmt.Errorf("expected the Scope %q to start with a `/`", input)
+	}
+
+	id := strings.TrimSuffix(input, "/")
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	if len(segments)%2 != 0 {
+		return nil, fmt.Errorf("the number of segments in the Scope %q is not divisible by 2", input)
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("the Scope %q contained an empty segment", input)
+		}
+	}
+
+	if segments[0] == "providers" {
+		if len(segments) != 4 || !strings.EqualFold(segments[1], "Microsoft.Management") || !strings.EqualFold(segments[2], "managementGroups") {
+			return nil, fmt.Errorf("expected the Scope %q to be a Management Group in the format `/providers/Microsoft.Management/managementGroups/{name}`", input)
+		}
+
+		scope := NewManagementGroupScope(segments[3])
+		scope.id = id
+		return &scope, nil
+	}
+
+	if segments[0] != "subscriptions" {
+		return nil, fmt.Errorf("expected the Scope %q to be a Management Group, Subscription, Resource Group or Resource", input)
+	}
+
+	if len(segments) == 2 {
+		scope := NewSubscriptionScope(segments[1])
+		scope.id = id
+		return &scope, nil
+	}
+
+	// Some Azure APIs return the `resourceGroups` segment in lower-case
+	hasResourceGroup := strings.EqualFold(segments[2], "resourceGroups")
+	if hasResourceGroup && len(segments) == 4 {
+		scope := NewResourceGroupScope(segments[1], segments[3])
+		scope.id = id
+		return &scope, nil
+	}
+
+	providersIndex := 2
+	resourceGroup := ""
+	if hasResourceGroup {
+		providersIndex = 4
+		resourceGroup = segments[3]
+	}
+	if segments[providersIndex] != "providers" || len(segments) < providersIndex+4 {
+		return nil, fmt.Errorf("expected the Scope %q to be a Resource in the format `{scope}/providers/{namespace}/{type}/{name}`", input)
+	}
+
+	return &Scope{
+		Type:           ScopeTypeResource,
+		SubscriptionId: segments[1],
+		ResourceGroup:  resourceGroup,
+		ResourceId:     id,
+		id:             id,
+	}, nil
+}
@@ -0,0 +1,212 @@
+package resourceid
+
+import (
+	"testing"
+)
+
+func TestParseScope(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *Scope
+	}{
+		{
+			// tenant
+			Input:    "",
+			Expected: &Scope{Type: ScopeTypeTenant},
+		},
+		{
+			// missing leading slash
+			Input: "subscriptions/12345678-1234-9876-4563-123456789012",
+			Error: true,
+		},
+		{
+			// management group
+			Input:    "/providers/Microsoft.Management/managementGroups/group1",
+			Expected: &Scope{Type: ScopeTypeManagementGroup, ManagementGroupName: "group1"},
+		},
+		{
+			// lower-cased management group
+			Input:    "/providers/Microsoft.Management/managementgroups/group1",
+			Expected: &Scope{Type: ScopeTypeManagementGroup, ManagementGroupName: "group1"},
+		},
+		{
+			// other tenant-level resource
+			Input: "/providers/Microsoft.Billing/billingAccounts/account1",
+			Error: true,
+		},
+		{
+			// subscription
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Expected: &Scope{Type: ScopeTypeSubscription, SubscriptionId: "12345678-1234-9876-4563-123456789012"},
+		},
+		{
+			// missing value for subscription
+			Input: "/subscriptions/",
+			Error: true,
+		},
+		{
+			// resource group
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+			Expected: &Scope{Type: ScopeTypeResourceGroup, SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"},
+		},
+		{
+			// lower-cased resource group
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1",
+			Expected: &Scope{Type: ScopeTypeResourceGroup, SubscriptionId: "12345678-1234-9876-4563-123456789012", ResourceGroup: "resGroup1"},
+		},
+		{
+			// resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: &Scope{
+				Type:           ScopeTypeResource,
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ResourceId:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1",
+			},
+		},
+		{
+			// subscription-level resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/pricings/pricing1",
+			Expected: &Scope{
+				Type:           ScopeTypeResource,
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceId:     "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/pricings/pricing1",
+			},
+		},
+		{
+			// resource missing the providers segment
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/virtualNetworks/network1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseScope(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		// the ID of the parsed Scope should retain the casing used within the Input
+		expected := *v.Expected
+		expected.id = v.Input
+		if *actual != expected {
+			t.Fatalf("Expected %+v but got %+v", expected, *actual)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to be %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
+
+func TestParseScopedResourceID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Scope    Scope
+		Provider string
+		Path     map[string]string
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing the name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks",
+			Error: true,
+		},
+		{
+			// missing the name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/",
+			Error: true,
+		},
+		{
+			// tenant
+			Input:    "/providers/Microsoft.Management/managementGroups/group1",
+			Scope:    NewTenantScope(),
+			Provider: "Microsoft.Management",
+			Path:     map[string]string{"managementGroups": "group1"},
+		},
+		{
+			// management group
+			Input:    "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Scope:    NewManagementGroupScope("group1"),
+			Provider: "Microsoft.Authorization",
+			Path:     map[string]string{"policyAssignments": "assignment1"},
+		},
+		{
+			// resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+			Scope: Scope{
+				Type:           ScopeTypeResource,
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ResourceId:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1",
+			},
+			Provider: "Microsoft.Authorization",
+			Path:     map[string]string{"locks": "lock1"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseScopedResourceID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope.Type != v.Scope.Type || actual.Scope.ID() != v.Scope.ID() {
+			t.Fatalf("Expected the Scope %+v but got %+v", v.Scope, actual.Scope)
+		}
+		if actual.Provider != v.Provider {
+			t.Fatalf("Expected the Provider %q but got %q", v.Provider, actual.Provider)
+		}
+		for key, value := range v.Path {
+			if actual.Path[key] != value {
+				t.Fatalf("Expected the segment %q to be %q but got %q", key, value, actual.Path[key])
+			}
+		}
+	}
+}
+
+func TestScopedResourceIDPopSegmentInsensitively(t *testing.T) {
+	id, err := ParseScopedResourceID("/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/LOCKS/lock1")
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	if _, err := id.PopSegment("locks"); err == nil {
+		t.Fatalf("Expected an error popping the segment case-sensitively but didn't get one")
+	}
+
+	value, err := id.PopSegmentInsensitively("locks")
+	if err != nil {
+		t.Fatalf("popping the segment: %+v", err)
+	}
+	if value != "lock1" {
+		t.Fatalf("Expected %q but got %q", "lock1", value)
+	}
+
+	if err := id.ValidateNoEmptySegments("input"); err != nil {
+		t.Fatalf("Expected no remaining segments but got: %+v", err)
+	}
+}
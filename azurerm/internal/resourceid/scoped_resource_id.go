@@ -0,0 +1,91 @@
+package resourceid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ScopedResourceID represents a parsed Resource ID which exists within a Scope - for example an Extension
+// Resource (such as a Management Lock) or a Resource which exists within a Tenant or Management Group - with
+// the Scope and the Provider as top-level fields, and the other key-value pairs available via a map in the
+// Path field.
+type ScopedResourceID struct {
+	Scope    Scope
+	Provider string
+	Path     map[string]string
+}
+
+// ParseScopedResourceID parses a Resource ID in the format `{scope}/providers/{namespace}/{type}/{name}[/{type}/{name}..]`
+// where the Scope is determined using the last `providers` segment within the Resource ID
+func ParseScopedResourceID(input string) (*ScopedResourceID, error) {
+	index := strings.LastIndex(input, "/providers/")
+	if index == -1 {
+		return nil, fmt.Errorf("ID was missing the `providers` element")
+	}
+
+	scope, err := ParseScope(input[0:index])
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Scope for %q: %+v", input, err)
+	}
+
+	components := strings.Split(strings.TrimSuffix(input[index+len("/providers/"):], "/"), "/")
+	if len(components) < 3 || len(components)%2 != 1 {
+		return nil, fmt.Errorf("expected `{namespace}/{type}/{name}` after the last `providers` element in %q", input)
+	}
+
+	provider := components[0]
+	if provider == "" {
+		return nil, fmt.Errorf("ID was missing the value for the `providers` element")
+	}
+
+	path := make(map[string]string)
+	for i := 1; i < len(components); i += 2 {
+		key := components[i]
+		value := components[i+1]
+
+		if key == "" || value == "" {
+			return nil, fmt.Errorf("Key/Value cannot be empty strings. Key: '%s', Value: '%s'", key, value)
+		}
+		path[key] = value
+	}
+
+	return &ScopedResourceID{
+		Scope:    *scope,
+		Provider: provider,
+		Path:     path,
+	}, nil
+}
+
+// PopSegment retrieves a segment from the Path and returns it
+// if found it removes it from the Path then return the value
+// if not found, this returns an error
+func (id *ScopedResourceID) PopSegment(name string) (string, error) {
+	val, ok := id.Path[name]
+	if !ok {
+		return "", fmt.Errorf("ID was missing the `%s` element", name)
+	}
+
+	delete(id.Path, name)
+	return val, nil
+}
+
+// PopSegmentInsensitively retrieves a segment from the Path (where the name of the segment is
+// matched case-insensitively) and returns it, removing it from the Path
+func (id *ScopedResourceID) PopSegmentInsensitively(name string) (string, error) {
+	for key := range id.Path {
+		if strings.EqualFold(key, name) {
+			return id.PopSegment(key)
+		}
+	}
+
+	return "", fmt.Errorf("ID was missing the `%s` element", name)
+}
+
+// ValidateNoEmptySegments validates that all of the segments within the Path have been popped
+func (id *ScopedResourceID) ValidateNoEmptySegments(sourceId string) error {
+	if len(id.Path) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("ID contained more segments than required: %q, %v", sourceId, id.Path)
+}
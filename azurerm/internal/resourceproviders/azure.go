@@ -3,26 +3,68 @@ package resourceproviders
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 )
 
-func availableResourceProviders(ctx context.Context, client *resources.ProvidersClient) (*[]string, error) {
-	providerNames := make([]string, 0)
+// AvailableResourceProviders returns the namespaces of the Resource Providers available within the Subscription
+func AvailableResourceProviders(ctx context.Context, client *resources.ProvidersClient) (*[]string, error) {
+	providers, err := listResourceProviders(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	providerNames := resourceProviderNames(providers)
+	return &providerNames, nil
+}
+
+func resourceProviderNames(providers []resources.Provider) []string {
+	output := make([]string, 0)
+	for _, provider := range providers {
+		if provider.Namespace != nil {
+			output = append(output, *provider.Namespace)
+		}
+	}
+
+	return output
+}
+
+func listResourceProviders(ctx context.Context, client *resources.ProvidersClient) ([]resources.Provider, error) {
+	output := make([]resources.Provider, 0)
 	providers, err := client.ListComplete(ctx, nil, "")
 	if err != nil {
 		return nil, fmt.Errorf("listing Resource Providers: %+v", err)
 	}
 	for providers.NotDone() {
-		provider := providers.Value()
-		if provider.Namespace != nil {
-			providerNames = append(providerNames, *provider.Namespace)
-		}
+		output = append(output, providers.Value())
 
 		if err := providers.NextWithContext(ctx); err != nil {
 			return nil, err
 		}
 	}
 
-	return &providerNames, nil
+	return output, nil
+}
+
+// resourceTypeLocations returns a map of the (lower-cased) Resource Type (e.g. `microsoft.compute/virtualmachines`)
+// to the Locations in which it's available, for each Resource Type which specifies the Locations it's available in
+func resourceTypeLocations(providers []resources.Provider) map[string][]string {
+	output := make(map[string][]string)
+	for _, provider := range providers {
+		if provider.Namespace == nil || provider.ResourceTypes == nil {
+			continue
+		}
+
+		for _, resourceType := range *provider.ResourceTypes {
+			if resourceType.ResourceType == nil || resourceType.Locations == nil || len(*resourceType.Locations) == 0 {
+				continue
+			}
+
+			key := strings.ToLower(fmt.Sprintf("%s/%s", *provider.Namespace, *resourceType.ResourceType))
+			output[key] = *resourceType.Locations
+		}
+	}
+
+	return output
 }
@@ -3,21 +3,61 @@ package resourceproviders
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/snapshot"
 )
 
 // cachedResourceProviders can be (validly) nil - as such this shouldn't be relied on
 var cachedResourceProviders *[]string
 
-// CacheSupportedProviders attempts to retrieve the supported Resource Providers from the Resource Manager API
-// and caches them, for used in enhanced validation
-func CacheSupportedProviders(ctx context.Context, client *resources.ProvidersClient) {
-	providers, err := availableResourceProviders(ctx, client)
+// cachedResourceTypeLocations is a map of the (lower-cased) Resource Type to the Locations in which it's
+// available - this can be (validly) nil or incomplete, as such this shouldn't be relied on
+var cachedResourceTypeLocations map[string][]string
+
+// CacheSupportedProviders attempts to retrieve the supported Resource Providers (and the Locations in which each
+// Resource Type is available) from the Resource Manager API and caches them, for used in enhanced validation - falling back to the snapshot bundled with the
+// Provider when these can't be retrieved (or when Enhanced Validation is running offline)
+func CacheSupportedProviders(ctx context.Context, client *resources.ProvidersClient, env *azure.Environment) {
+	if features.EnhancedValidationOffline() {
+		cacheSupportedProvidersFromSnapshot(env.Name)
+		return
+	}
+
+	providers, err := listResourceProviders(ctx, client)
+	if err != nil {
+		log.Printf("[DEBUG] error retrieving providers: %s. Falling back to the bundled snapshot", err)
+		cacheSupportedProvidersFromSnapshot(env.Name)
+		return
+	}
+
+	providerNames := resourceProviderNames(providers)
+	cachedResourceProviders = &providerNames
+	cachedResourceTypeLocations = resourceTypeLocations(providers)
+}
+
+// LocationsForResourceType returns the Locations in which the specified Resource Type (for example
+// `Microsoft.ContainerService/managedClusters`) is available, and whether these are known
+func LocationsForResourceType(resourceType string) ([]string, bool) {
+	if cachedResourceTypeLocations == nil {
+		return nil, false
+	}
+
+	locations, ok := cachedResourceTypeLocations[strings.ToLower(resourceType)]
+	return locations, ok
+}
+
+func cacheSupportedProvidersFromSnapshot(environmentName string) {
+	s, err := snapshot.ForEnvironment(environmentName)
 	if err != nil {
-		log.Printf("[DEBUG] error retrieving providers: %s. Enhanced validation will be unavailable", err)
+		log.Printf("[DEBUG] error loading the bundled snapshot of providers: %s. Enhanced validation will be unavailable", err)
 		return
 	}
 
-	cachedResourceProviders = providers
+	log.Printf("[DEBUG] Using the bundled snapshot of providers for %q (generated on %s)", s.Environment, s.GeneratedOn)
+	providers := s.ResourceProviders
+	cachedResourceProviders = &providers
 }
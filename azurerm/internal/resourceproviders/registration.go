@@ -2,24 +2,206 @@ package resourceproviders
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/hashicorp/go-azure-helpers/resourceproviders"
+	"github.com/hashicorp/go-multierror"
 )
 
+const (
+	// RegistrationsAll registers all of the Resource Providers supported by the AzureRM Provider
+	RegistrationsAll = "all"
+
+	// RegistrationsCore registers only the Resource Providers used by the majority of configurations
+	RegistrationsCore = "core"
+
+	// RegistrationsNone registers no Resource Providers (other than any specified explicitly)
+	RegistrationsNone = "none"
+)
+
+// registrationPollInterval is the interval at which the Registration State of a Resource Provider
+// is polled whilst waiting for it to be Registered
+var registrationPollInterval = 10 * time.Second
+
+// registrationTimeout is the maximum duration to wait for a single Resource Provider to be Registered
+var registrationTimeout = 30 * time.Minute
+
+// maxConcurrentRegistrations is the maximum number of Resource Providers which are registered in parallel
+const maxConcurrentRegistrations = 10
+
+// PossibleRegistrations returns the sets of Resource Providers which can be registered
+func PossibleRegistrations() []string {
+	return []string{
+		RegistrationsAll,
+		RegistrationsCore,
+		RegistrationsNone,
+	}
+}
+
+// ForRegistration returns the Resource Providers which should be registered for the specified set
+// of Resource Provider Registrations, in addition to any additional Resource Providers
+func ForRegistration(registrations string, additional []string) (map[string]struct{}, error) {
+	output := make(map[string]struct{})
+
+	switch strings.ToLower(registrations) {
+	case RegistrationsAll:
+		output = Required()
+	case RegistrationsCore:
+		output = Core()
+	case RegistrationsNone:
+	default:
+		return nil, fmt.Errorf("unsupported Resource Provider Registrations %q - expected one of %s", registrations, strings.Join(PossibleRegistrations(), ", "))
+	}
+
+	for _, v := range additional {
+		if v != "" {
+			output[v] = struct{}{}
+		}
+	}
+
+	return output, nil
+}
+
+// Register ensures that each of the required Resource Providers is registered within the Subscription.
+//
+// When a RegistrationCache is specified and each of the required Resource Providers is known to be
+// registered, only a single Resource Provider is retrieved from the API (to check the credentials can
+// access the Subscription) - otherwise the Resource Providers are listed, any which aren't registered
+// are registered and the cache is updated.
+func Register(ctx context.Context, client resources.ProvidersClient, requiredRPs map[string]struct{}, cache *RegistrationCache) error {
+	if len(requiredRPs) == 0 {
+		log.Printf("[DEBUG] No Resource Providers require Registration")
+		return nil
+	}
+
+	if cache.containsAll(requiredRPs) {
+		log.Printf("[DEBUG] All required Resource Providers are registered (cached)")
+
+		// retrieving a single Resource Provider is far cheaper than listing them, but still surfaces
+		// invalid credentials when the Provider is configured, rather than when the first resource is
+		if _, err := client.Get(ctx, firstResourceProvider(requiredRPs), ""); err != nil {
+			return fmt.Errorf(invalidCredentialsErrorFmt, err)
+		}
+
+		return nil
+	}
+
+	// List all the available providers and their registration state to avoid unnecessary
+	// requests. This also lets us check if the provider credentials are correct.
+	providerList, err := client.List(ctx, nil, "")
+	if err != nil {
+		return fmt.Errorf(invalidCredentialsErrorFmt, err)
+	}
+	availableRPs := providerList.Values()
+
+	if err := EnsureRegistered(ctx, client, availableRPs, requiredRPs); err != nil {
+		return err
+	}
+
+	registered := make([]string, 0)
+	for _, provider := range availableRPs {
+		if provider.Namespace != nil && provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "Registered") {
+			registered = append(registered, *provider.Namespace)
+		}
+	}
+	for provider := range requiredRPs {
+		registered = append(registered, provider)
+	}
+	if err := cache.update(registered); err != nil {
+		// the cache is an optimisation, so failing to write this shouldn't block the Provider
+		log.Printf("[DEBUG] Unable to cache the registered Resource Providers: %+v", err)
+	}
+
+	return nil
+}
+
+const invalidCredentialsErrorFmt = "Unable to retrieve provider registration status, it is possible that this is due to invalid " +
+	"credentials or the service principal does not have permission to use the Resource Manager API, Azure " +
+	"error: %s"
+
+func firstResourceProvider(input map[string]struct{}) string {
+	names := make([]string, 0, len(input))
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names[0]
+}
+
+// EnsureRegistered registers each of the required Resource Providers which isn't registered (with up to
+// `maxConcurrentRegistrations` in parallel) and then waits for each of them to become Registered
 func EnsureRegistered(ctx context.Context, client resources.ProvidersClient, availableRPs []resources.Provider, requiredRPs map[string]struct{}) error {
 	log.Printf("[DEBUG] Determining which Resource Providers require Registration")
 	providersToRegister := resourceproviders.DetermineResourceProvidersRequiringRegistration(availableRPs, requiredRPs)
-
-	if len(providersToRegister) > 0 {
-		log.Printf("[DEBUG] Registering %d Resource Providers", len(providersToRegister))
-		if err := resourceproviders.RegisterForSubscription(ctx, client, providersToRegister); err != nil {
-			return err
-		}
-	} else {
+	if len(providersToRegister) == 0 {
 		log.Printf("[DEBUG] All required Resource Providers are registered")
+		return nil
 	}
 
-	return nil
+	names := make([]string, 0)
+	for name := range providersToRegister {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	log.Printf("[DEBUG] Registering %d Resource Providers: %s", len(names), strings.Join(names, ", "))
+
+	var errors *multierror.Error
+	lock := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	workers := make(chan struct{}, maxConcurrentRegistrations)
+	for _, name := range names {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(name string) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+
+			if err := registerResourceProvider(ctx, client, name); err != nil {
+				lock.Lock()
+				errors = multierror.Append(errors, err)
+				lock.Unlock()
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	return errors.ErrorOrNil()
+}
+
+func registerResourceProvider(ctx context.Context, client resources.ProvidersClient, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, registrationTimeout)
+	defer cancel()
+
+	log.Printf("[DEBUG] Registering Resource Provider %q..", name)
+	if _, err := client.Register(ctx, name); err != nil {
+		return fmt.Errorf("registering Resource Provider %q: %+v", name, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Resource Provider %q to be Registered..", name)
+	for {
+		provider, err := client.Get(ctx, name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving Resource Provider %q: %+v", name, err)
+		}
+
+		if provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "Registered") {
+			log.Printf("[DEBUG] Registered Resource Provider %q", name)
+			return nil
+		}
+
+		select {
+		case <-time.After(registrationPollInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for Resource Provider %q to be Registered: %+v", name, ctx.Err())
+		}
+	}
 }
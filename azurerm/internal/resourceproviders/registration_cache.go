@@ -0,0 +1,142 @@
+package resourceproviders
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultRegistrationCacheTTL is the duration for which the registered Resource Providers are cached
+const DefaultRegistrationCacheTTL = 24 * time.Hour
+
+// RegistrationCache caches (on disk) which Resource Providers are Registered within a Subscription, so
+// that subsequent runs can skip listing the Resource Providers until the cache expires.
+//
+// A nil RegistrationCache is valid, and caches nothing.
+type RegistrationCache struct {
+	path string
+	ttl  time.Duration
+}
+
+type registrationCacheFile struct {
+	ExpiresOn  time.Time `json:"expiresOn"`
+	Registered []string  `json:"registered"`
+}
+
+// NewRegistrationCache returns a RegistrationCache for the specified Subscription within the users cache
+// directory - or nil if the cache is disabled (the TTL is 0) or the cache directory can't be determined
+func NewRegistrationCache(subscriptionId string, ttl time.Duration) *RegistrationCache {
+	if ttl <= 0 || subscriptionId == "" {
+		return nil
+	}
+
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		log.Printf("[DEBUG] Unable to determine the cache directory, Resource Provider Registrations won't be cached: %+v", err)
+		return nil
+	}
+
+	return newRegistrationCacheInDirectory(filepath.Join(dir, "terraform-provider-azurerm"), subscriptionId, ttl)
+}
+
+func newRegistrationCacheInDirectory(directory, subscriptionId string, ttl time.Duration) *RegistrationCache {
+	return &RegistrationCache{
+		path: filepath.Join(directory, fmt.Sprintf("resource-providers-%s.json", strings.ToLower(subscriptionId))),
+		ttl:  ttl,
+	}
+}
+
+// containsAll returns whether each of the specified Resource Providers is known to be Registered
+func (c *RegistrationCache) containsAll(resourceProviders map[string]struct{}) bool {
+	registered := c.registered()
+	if registered == nil {
+		return false
+	}
+
+	for name := range resourceProviders {
+		if _, ok := registered[strings.ToLower(name)]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// registered returns the (lower-cased) names of the Registered Resource Providers, or nil if these
+// aren't cached or the cache has expired
+func (c *RegistrationCache) registered() map[string]struct{} {
+	if c == nil {
+		return nil
+	}
+
+	contents, err := ioutil.ReadFile(c.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[DEBUG] Unable to read the Resource Provider Registration cache %q: %+v", c.path, err)
+		}
+		return nil
+	}
+
+	var file registrationCacheFile
+	if err := json.Unmarshal(contents, &file); err != nil {
+		log.Printf("[DEBUG] Unable to parse the Resource Provider Registration cache %q: %+v", c.path, err)
+		return nil
+	}
+
+	if time.Now().After(file.ExpiresOn) {
+		log.Printf("[DEBUG] The Resource Provider Registration cache %q expired at %s", c.path, file.ExpiresOn)
+		return nil
+	}
+
+	output := make(map[string]struct{})
+	for _, v := range file.Registered {
+		output[strings.ToLower(v)] = struct{}{}
+	}
+	return output
+}
+
+// update replaces the cached Resource Providers with those specified
+func (c *RegistrationCache) update(registered []string) error {
+	if c == nil {
+		return nil
+	}
+
+	file := registrationCacheFile{
+		ExpiresOn:  time.Now().Add(c.ttl),
+		Registered: registered,
+	}
+	contents, err := json.Marshal(file)
+	if err != nil {
+		return fmt.Errorf("serializing: %+v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return fmt.Errorf("creating directory %q: %+v", filepath.Dir(c.path), err)
+	}
+
+	// write to a temporary file and then rename it, so concurrent runs never read a partial file
+	temp, err := ioutil.TempFile(filepath.Dir(c.path), filepath.Base(c.path)+".*")
+	if err != nil {
+		return fmt.Errorf("creating temporary file: %+v", err)
+	}
+	defer os.Remove(temp.Name())
+
+	if _, err := temp.Write(contents); err != nil {
+		temp.Close()
+		return fmt.Errorf("writing %q: %+v", temp.Name(), err)
+	}
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("closing %q: %+v", temp.Name(), err)
+	}
+
+	if err := os.Rename(temp.Name(), c.path); err != nil {
+		return fmt.Errorf("renaming %q to %q: %+v", temp.Name(), c.path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package resourceproviders
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestRegistrationCache(t *testing.T) {
+	cache := newRegistrationCacheInDirectory(t.TempDir(), "00000000-0000-0000-0000-000000000000", time.Hour)
+
+	if cache.containsAll(map[string]struct{}{"Microsoft.Compute": {}}) {
+		t.Fatalf("expected an empty cache to contain nothing")
+	}
+
+	if err := cache.update([]string{"Microsoft.Compute", "microsoft.insights"}); err != nil {
+		t.Fatalf("updating the cache: %+v", err)
+	}
+
+	if !cache.containsAll(map[string]struct{}{"microsoft.compute": {}, "Microsoft.Insights": {}}) {
+		t.Fatalf("expected the cache to contain the Resource Providers (case-insensitively)")
+	}
+	if cache.containsAll(map[string]struct{}{"Microsoft.Compute": {}, "Microsoft.Network": {}}) {
+		t.Fatalf("expected the cache not to contain Microsoft.Network")
+	}
+}
+
+func TestRegistrationCacheExpired(t *testing.T) {
+	cache := newRegistrationCacheInDirectory(t.TempDir(), "00000000-0000-0000-0000-000000000000", -time.Minute)
+
+	if err := cache.update([]string{"Microsoft.Compute"}); err != nil {
+		t.Fatalf("updating the cache: %+v", err)
+	}
+
+	if cache.containsAll(map[string]struct{}{"Microsoft.Compute": {}}) {
+		t.Fatalf("expected an expired cache to contain nothing")
+	}
+}
+
+func TestRegistrationCacheInvalid(t *testing.T) {
+	cache := newRegistrationCacheInDirectory(t.TempDir(), "00000000-0000-0000-0000-000000000000", time.Hour)
+	if err := ioutil.WriteFile(cache.path, []byte("{"), 0600); err != nil {
+		t.Fatalf("writing the cache: %+v", err)
+	}
+
+	if cache.containsAll(map[string]struct{}{"Microsoft.Compute": {}}) {
+		t.Fatalf("expected an invalid cache to contain nothing")
+	}
+}
+
+func TestRegistrationCacheDisabled(t *testing.T) {
+	if cache := NewRegistrationCache("00000000-0000-0000-0000-000000000000", 0); cache != nil {
+		t.Fatalf("expected the cache to be disabled when the TTL is 0")
+	}
+
+	var cache *RegistrationCache
+	if err := cache.update([]string{"Microsoft.Compute"}); err != nil {
+		t.Fatalf("expected updating a disabled cache to be a no-op: %+v", err)
+	}
+	if cache.containsAll(map[string]struct{}{}) {
+		t.Fatalf("expected a disabled cache to contain nothing")
+	}
+}
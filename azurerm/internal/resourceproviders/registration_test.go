@@ -0,0 +1,254 @@
+package resourceproviders
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+)
+
+// fakeProvidersServer is a minimal Resource Providers API, where Resource Providers take a number of
+// polls to become Registered after they're registered
+type fakeProvidersServer struct {
+	lock        sync.Mutex
+	states      map[string]string
+	pollsNeeded map[string]int
+	lists       int
+	gets        int
+	registers   int
+}
+
+func (s *fakeProvidersServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// /subscriptions/{id}/providers[/{namespace}[/register]]
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(segments) == 3:
+		s.lists++
+		values := make([]interface{}, 0)
+		for name, state := range s.states {
+			values = append(values, s.body(name, state))
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"value": values})
+
+	case len(segments) == 4:
+		s.gets++
+		name := segments[3]
+		if s.states[name] == "Registering" {
+			s.pollsNeeded[name]--
+			if s.pollsNeeded[name] <= 0 {
+				s.states[name] = "Registered"
+			}
+		}
+		json.NewEncoder(w).Encode(s.body(name, s.states[name]))
+
+	case len(segments) == 5 && r.Method == http.MethodPost:
+		s.registers++
+		name := segments[3]
+		s.states[name] = "Registering"
+		json.NewEncoder(w).Encode(s.body(name, s.states[name]))
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *fakeProvidersServer) body(name, state string) map[string]interface{} {
+	return map[string]interface{}{
+		"namespace":         name,
+		"registrationState": state,
+	}
+}
+
+func newFakeProvidersClient(t *testing.T, states map[string]string) (*fakeProvidersServer, resources.ProvidersClient) {
+	fake := &fakeProvidersServer{
+		states:      states,
+		pollsNeeded: map[string]int{},
+	}
+	for name := range states {
+		fake.pollsNeeded[name] = 2
+	}
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	return fake, resources.NewProvidersClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+}
+
+func TestForRegistration(t *testing.T) {
+	testData := []struct {
+		Registrations string
+		Additional    []string
+		Expected      []string
+		Error         bool
+	}{
+		{
+			Registrations: RegistrationsNone,
+			Expected:      []string{},
+		},
+		{
+			Registrations: RegistrationsNone,
+			Additional:    []string{"Microsoft.Foo", ""},
+			Expected:      []string{"Microsoft.Foo"},
+		},
+		{
+			Registrations: RegistrationsCore,
+			Additional:    []string{"Microsoft.Foo"},
+			Expected:      []string{"Microsoft.Compute", "Microsoft.Foo"},
+		},
+		{
+			Registrations: RegistrationsAll,
+			Expected:      []string{"Microsoft.Compute", "Microsoft.ApiManagement"},
+		},
+		{
+			Registrations: "some",
+			Error:         true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %v..", v.Registrations, v.Additional)
+
+		actual, err := ForRegistration(v.Registrations, v.Additional)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		for _, name := range v.Expected {
+			if _, ok := actual[name]; !ok {
+				t.Fatalf("expected %q to be registered but it wasn't: %v", name, actual)
+			}
+		}
+		if len(v.Expected) == 0 && len(actual) != 0 {
+			t.Fatalf("expected nothing to be registered but got %v", actual)
+		}
+	}
+
+	for name := range Core() {
+		if _, ok := Required()[name]; !ok {
+			t.Fatalf("expected the core Resource Provider %q to be Required", name)
+		}
+	}
+}
+
+func TestEnsureRegisteredWaitsForRegistration(t *testing.T) {
+	registrationPollInterval = time.Millisecond
+	defer func() {
+		registrationPollInterval = 10 * time.Second
+	}()
+
+	fake, client := newFakeProvidersClient(t, map[string]string{
+		"Microsoft.Compute": "NotRegistered",
+		"Microsoft.Network": "NotRegistered",
+		"Microsoft.Storage": "Registered",
+	})
+
+	ctx := context.TODO()
+	list, err := client.List(ctx, nil, "")
+	if err != nil {
+		t.Fatalf("listing: %+v", err)
+	}
+
+	required := map[string]struct{}{
+		"Microsoft.Compute": {},
+		"Microsoft.Network": {},
+		"Microsoft.Storage": {},
+	}
+	if err := EnsureRegistered(ctx, client, list.Values(), required); err != nil {
+		t.Fatalf("registering: %+v", err)
+	}
+
+	if fake.registers != 2 {
+		t.Fatalf("expected 2 Resource Providers to be registered but got %d", fake.registers)
+	}
+	for name := range required {
+		if state := fake.states[name]; state != "Registered" {
+			t.Fatalf("expected %q to be Registered but got %q", name, state)
+		}
+	}
+}
+
+func TestRegisterUsesCache(t *testing.T) {
+	fake, client := newFakeProvidersClient(t, map[string]string{
+		"Microsoft.Compute": "Registered",
+		"Microsoft.Network": "Registered",
+	})
+	cache := newRegistrationCacheInDirectory(t.TempDir(), "00000000-0000-0000-0000-000000000000", time.Hour)
+
+	ctx := context.TODO()
+	required := map[string]struct{}{
+		"Microsoft.Compute": {},
+	}
+	for i := 0; i < 2; i++ {
+		if err := Register(ctx, client, required, cache); err != nil {
+			t.Fatalf("registering: %+v", err)
+		}
+	}
+	if fake.lists != 1 {
+		t.Fatalf("expected the Resource Providers to be listed once but got %d", fake.lists)
+	}
+	// the credentials are still checked when the Resource Providers are cached
+	if fake.gets != 1 {
+		t.Fatalf("expected a single Resource Provider to be retrieved but got %d", fake.gets)
+	}
+
+	// a Resource Provider which isn't cached requires the Resource Providers to be listed again
+	required["Microsoft.Storage"] = struct{}{}
+	registrationPollInterval = time.Millisecond
+	defer func() {
+		registrationPollInterval = 10 * time.Second
+	}()
+	fake.states["Microsoft.Storage"] = "NotRegistered"
+	fake.pollsNeeded["Microsoft.Storage"] = 1
+	if err := Register(ctx, client, required, cache); err != nil {
+		t.Fatalf("registering: %+v", err)
+	}
+	if fake.lists != 2 {
+		t.Fatalf("expected the Resource Providers to be listed twice but got %d", fake.lists)
+	}
+	if !cache.containsAll(required) {
+		t.Fatalf("expected the newly registered Resource Provider to be cached")
+	}
+}
+
+func TestEnsureRegisteredTimesOut(t *testing.T) {
+	registrationPollInterval = time.Millisecond
+	registrationTimeout = 50 * time.Millisecond
+	defer func() {
+		registrationPollInterval = 10 * time.Second
+		registrationTimeout = 30 * time.Minute
+	}()
+
+	fake, client := newFakeProvidersClient(t, map[string]string{
+		"Microsoft.Compute": "NotRegistered",
+	})
+	// the Resource Provider never becomes Registered
+	fake.pollsNeeded["Microsoft.Compute"] = 1000000
+
+	ctx := context.TODO()
+	list, err := client.List(ctx, nil, "")
+	if err != nil {
+		t.Fatalf("listing: %+v", err)
+	}
+
+	required := map[string]struct{}{
+		"Microsoft.Compute": {},
+	}
+	if err := EnsureRegistered(ctx, client, list.Values(), required); err == nil {
+		t.Fatalf("expected an error when the Resource Provider isn't Registered in time but didn't get one")
+	}
+}
@@ -74,3 +74,20 @@ func Required() map[string]struct{} {
 		"Microsoft.Web":                     {},
 	}
 }
+
+// Core returns the Resource Providers which are used by the majority of configurations (for example
+// Resource Groups, Networking, Compute, Storage and Key Vault) - which are registered when the
+// `core` set of Resource Provider Registrations is used
+func Core() map[string]struct{} {
+	// NOTE: Resource Providers in this list are case sensitive
+	return map[string]struct{}{
+		"Microsoft.Authorization":   {},
+		"Microsoft.Compute":         {},
+		"Microsoft.KeyVault":        {},
+		"Microsoft.ManagedIdentity": {},
+		"Microsoft.Network":         {},
+		"Microsoft.Resources":       {},
+		"Microsoft.Storage":         {},
+		"microsoft.insights":        {},
+	}
+}
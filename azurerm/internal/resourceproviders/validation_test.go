@@ -3,7 +3,9 @@ package resourceproviders
 import (
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestEnhancedValidationDisabled(t *testing.T) {
@@ -84,3 +86,39 @@ func TestEnhancedValidationEnabled(t *testing.T) {
 		}
 	}
 }
+
+func TestLocationsForResourceType(t *testing.T) {
+	providers := []resources.Provider{
+		{
+			Namespace: utils.String("Microsoft.ContainerService"),
+			ResourceTypes: &[]resources.ProviderResourceType{
+				{
+					ResourceType: utils.String("managedClusters"),
+					Locations:    &[]string{"West Europe", "North Europe"},
+				},
+				{
+					// global Resource Types don't specify any Locations
+					ResourceType: utils.String("operations"),
+					Locations:    &[]string{},
+				},
+			},
+		},
+	}
+	cachedResourceTypeLocations = resourceTypeLocations(providers)
+	defer func() {
+		cachedResourceTypeLocations = nil
+	}()
+
+	locations, ok := LocationsForResourceType("microsoft.containerservice/MANAGEDCLUSTERS")
+	if !ok || len(locations) != 2 {
+		t.Fatalf("expected 2 Locations for the Managed Clusters Resource Type but got %v", locations)
+	}
+
+	if _, ok := LocationsForResourceType("Microsoft.ContainerService/operations"); ok {
+		t.Fatalf("expected the Locations for a Resource Type without Locations to be unknown")
+	}
+
+	if _, ok := LocationsForResourceType("Microsoft.Compute/virtualMachines"); ok {
+		t.Fatalf("expected the Locations for an unknown Resource Type to be unknown")
+	}
+}
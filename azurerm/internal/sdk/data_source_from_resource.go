@@ -0,0 +1,189 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+// DataSourceIDFunc returns the Resource ID for the Data Source, built from the lookup arguments
+// (which can be retrieved using `metadata.Decode` or `metadata.ResourceData`)
+type DataSourceIDFunc func(ctx context.Context, metadata ResourceMetaData) (resourceid.Formatter, error)
+
+var _ DataSource = resourceDataSource{}
+
+// resourceDataSource is a Data Source generated from a Resource, which reuses
+// the Schema and Read function from the Resource
+type resourceDataSource struct {
+	resource        Resource
+	lookupArguments []string
+	idFunc          DataSourceIDFunc
+}
+
+// DataSourceFromResource returns a Data Source for the specified Resource, using the lookupArguments
+// as the Required arguments for the Data Source and exposing all other fields as Computed.
+//
+// The idFunc is used to build the Resource ID from the lookup arguments, which is set prior to calling
+// the Resource's Read function - as such the Read function must be able to populate all fields from the ID.
+//
+// Example Usage:
+//
+//	func (r Registration) DataSources() []sdk.DataSource {
+//		return []sdk.DataSource{
+//			sdk.DataSourceFromResource(ExampleResource{}, []string{"name", "resource_group_name"}, exampleDataSourceID),
+//		}
+//	}
+func DataSourceFromResource(resource Resource, lookupArguments []string, idFunc DataSourceIDFunc) DataSource {
+	return resourceDataSource{
+		resource:        resource,
+		lookupArguments: lookupArguments,
+		idFunc:          idFunc,
+	}
+}
+
+// Arguments returns the lookup arguments from the Resource, which are Required for the Data Source
+func (ds resourceDataSource) Arguments() map[string]*schema.Schema {
+	out := make(map[string]*schema.Schema)
+	resourceArguments, _, _ := ds.resourceSchema()
+	for _, k := range ds.lookupArguments {
+		v, ok := resourceArguments[k]
+		if !ok {
+			continue
+		}
+
+		out[k] = &schema.Schema{
+			Type:         v.Type,
+			Required:     true,
+			Elem:         v.Elem,
+			ValidateFunc: v.ValidateFunc,
+			Description:  v.Description,
+		}
+	}
+	return out
+}
+
+// Attributes returns all of the Resource's fields other than the lookup arguments as Computed
+func (ds resourceDataSource) Attributes() map[string]*schema.Schema {
+	lookupArguments := make(map[string]struct{})
+	for _, k := range ds.lookupArguments {
+		lookupArguments[k] = struct{}{}
+	}
+
+	out := make(map[string]*schema.Schema)
+	resourceArguments, resourceAttributes, _ := ds.resourceSchema()
+	for _, fields := range []map[string]*schema.Schema{resourceArguments, resourceAttributes} {
+		for k, v := range fields {
+			if _, ok := lookupArguments[k]; ok {
+				continue
+			}
+
+			out[k] = computedSchema(v)
+		}
+	}
+	return out
+}
+
+// ModelObject returns the Model Object used by the Resource
+func (ds resourceDataSource) ModelObject() interface{} {
+	return ds.resource.ModelObject()
+}
+
+// ResourceType returns the same type name as the Resource (e.g. `azurerm_example`)
+func (ds resourceDataSource) ResourceType() string {
+	return ds.resource.ResourceType()
+}
+
+// Read sets the Resource ID from the lookup arguments and then calls the Resource's Read function
+func (ds resourceDataSource) Read() ResourceFunc {
+	read := ds.resource.Read()
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			id, err := ds.idFunc(ctx, metadata)
+			if err != nil {
+				return fmt.Errorf("building Resource ID: %+v", err)
+			}
+
+			if err := metadata.SetID(id); err != nil {
+				return err
+			}
+			if err := read.Func(ctx, metadata); err != nil {
+				return err
+			}
+
+			// the Resource's Read function marks the Resource as gone when it doesn't exist
+			if metadata.ResourceData.Id() == "" {
+				return fmt.Errorf("%s was not found", id.ID())
+			}
+
+			return nil
+		},
+		Timeout: read.Timeout,
+	}
+}
+
+// validate ensures that each of the lookup arguments exists as an Argument within the Resource
+func (ds resourceDataSource) validate() error {
+	if len(ds.lookupArguments) == 0 {
+		return fmt.Errorf("at least one lookup argument must be specified")
+	}
+
+	if ds.idFunc == nil {
+		return fmt.Errorf("an ID Func must be specified")
+	}
+
+	resourceArguments, _, err := ds.resourceSchema()
+	if err != nil {
+		return err
+	}
+
+	for _, k := range ds.lookupArguments {
+		if _, ok := resourceArguments[k]; !ok {
+			return fmt.Errorf("the lookup argument %q is not an Argument of the Resource %q", k, ds.resource.ResourceType())
+		}
+	}
+
+	return nil
+}
+
+// resourceSchema returns the Arguments and Attributes for the Resource, including
+// any fields generated from the Model when the Resource uses a Generated Schema
+func (ds resourceDataSource) resourceSchema() (map[string]*schema.Schema, map[string]*schema.Schema, error) {
+	arguments := ds.resource.Arguments()
+	attributes := ds.resource.Attributes()
+	if v, ok := ds.resource.(ResourceWithGeneratedSchema); ok {
+		return generateSchemaFromModel(v.ModelObject(), v.ValidateFuncs(), arguments, attributes)
+	}
+
+	return arguments, attributes, nil
+}
+
+// computedSchema returns a copy of the specified Schema which is Computed-only, removing
+// any fields which are only applicable to user-configurable fields
+func computedSchema(input *schema.Schema) *schema.Schema {
+	out := &schema.Schema{
+		Type:        input.Type,
+		Computed:    true,
+		Sensitive:   input.Sensitive,
+		Description: input.Description,
+	}
+
+	switch elem := input.Elem.(type) {
+	case *schema.Resource:
+		nested := make(map[string]*schema.Schema)
+		for k, v := range elem.Schema {
+			nested[k] = computedSchema(v)
+		}
+		out.Elem = &schema.Resource{
+			Schema: nested,
+		}
+
+	case *schema.Schema:
+		out.Elem = &schema.Schema{
+			Type: elem.Type,
+		}
+	}
+
+	return out
+}
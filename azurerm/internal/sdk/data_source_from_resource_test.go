@@ -0,0 +1,195 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type dataSourceTestID struct {
+	Name string
+}
+
+func (id dataSourceTestID) ID() string {
+	return fmt.Sprintf("/examples/%s", id.Name)
+}
+
+type dataSourceTestResource struct {
+	customizeDiffResource
+}
+
+func (r dataSourceTestResource) Arguments() map[string]*schema.Schema {
+	arguments := r.customizeDiffResource.Arguments()
+	arguments["rule"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+	return arguments
+}
+
+func (r dataSourceTestResource) Read() ResourceFunc {
+	return ResourceFunc{
+		Func: func(_ context.Context, metadata ResourceMetaData) error {
+			id := metadata.ResourceData.Id()
+			if id == "/examples/missing" {
+				return metadata.MarkAsGone(dataSourceTestID{Name: "missing"})
+			}
+
+			return metadata.Encode(&customizeDiffModel{
+				Name:     metadata.ResourceData.Get("name").(string),
+				Sku:      "Standard",
+				Capacity: 2,
+				Endpoint: fmt.Sprintf("https://example.com%s", id),
+			})
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func dataSourceTestIDFunc(_ context.Context, metadata ResourceMetaData) (resourceid.Formatter, error) {
+	return dataSourceTestID{
+		Name: metadata.ResourceData.Get("name").(string),
+	}, nil
+}
+
+func TestDataSourceFromResourceSchema(t *testing.T) {
+	dataSource := DataSourceFromResource(dataSourceTestResource{}, []string{"name"}, dataSourceTestIDFunc)
+	if dataSource.ResourceType() != "validator_customize_diff" {
+		t.Fatalf("expected the Resource Type to be %q but got %q", "validator_customize_diff", dataSource.ResourceType())
+	}
+
+	wrapper := NewDataSourceWrapper(dataSource)
+	resource, err := wrapper.DataSource()
+	if err != nil {
+		t.Fatalf("building Data Source: %+v", err)
+	}
+
+	if err := resource.InternalValidate(nil, false); err != nil {
+		t.Fatalf("validating Data Source: %+v", err)
+	}
+
+	name := resource.Schema["name"]
+	if !name.Required || name.ForceNew || name.Computed {
+		t.Fatalf("expected `name` to be Required but got %+v", name)
+	}
+
+	for _, k := range []string{"sku", "capacity", "endpoint", "rule"} {
+		v, ok := resource.Schema[k]
+		if !ok {
+			t.Fatalf("expected %q to exist in the schema but it didn't", k)
+		}
+
+		if !v.Computed || v.Optional || v.Required || v.ForceNew || v.MaxItems != 0 {
+			t.Fatalf("expected %q to be Computed-only but got %+v", k, v)
+		}
+	}
+
+	nested := resource.Schema["rule"].Elem.(*schema.Resource).Schema["name"]
+	if !nested.Computed || nested.Required {
+		t.Fatalf("expected `rule.name` to be Computed-only but got %+v", nested)
+	}
+}
+
+func TestDataSourceFromResourceInvalid(t *testing.T) {
+	testData := []struct {
+		Name            string
+		LookupArguments []string
+		IDFunc          DataSourceIDFunc
+	}{
+		{
+			Name:            "No Lookup Arguments",
+			LookupArguments: []string{},
+			IDFunc:          dataSourceTestIDFunc,
+		},
+		{
+			Name:            "Lookup Argument isn't an Argument",
+			LookupArguments: []string{"endpoint"},
+			IDFunc:          dataSourceTestIDFunc,
+		},
+		{
+			Name:            "No ID Func",
+			LookupArguments: []string{"name"},
+			IDFunc:          nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		wrapper := NewDataSourceWrapper(DataSourceFromResource(dataSourceTestResource{}, v.LookupArguments, v.IDFunc))
+		if _, err := wrapper.DataSource(); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestDataSourceFromResourceRead(t *testing.T) {
+	testData := []struct {
+		Name        string
+		ExpectError bool
+	}{
+		{
+			Name:        "example",
+			ExpectError: false,
+		},
+		{
+			Name:        "missing",
+			ExpectError: true,
+		},
+	}
+
+	dataSource := DataSourceFromResource(dataSourceTestResource{}, []string{"name"}, dataSourceTestIDFunc)
+	wrapper := NewDataSourceWrapper(dataSource)
+	resource, err := wrapper.DataSource()
+	if err != nil {
+		t.Fatalf("building Data Source: %+v", err)
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+			"name": v.Name,
+		})
+		metadata := ResourceMetaData{
+			Logger:                   NullLogger{},
+			ResourceData:             d,
+			serializationDebugLogger: NullLogger{},
+		}
+		err := dataSource.Read().Func(context.TODO(), metadata)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		expectedId := fmt.Sprintf("/examples/%s", v.Name)
+		if d.Id() != expectedId {
+			t.Fatalf("expected the ID to be %q but got %q", expectedId, d.Id())
+		}
+		if sku := d.Get("sku").(string); sku != "Standard" {
+			t.Fatalf("expected `sku` to be %q but got %q", "Standard", sku)
+		}
+		if endpoint := d.Get("endpoint").(string); endpoint != "https://example.com"+expectedId {
+			t.Fatalf("expected `endpoint` to be %q but got %q", "https://example.com"+expectedId, endpoint)
+		}
+	}
+}
@@ -2,6 +2,13 @@ package sdk
 
 // Logger is an interface for switching out the Logger implementation
 type Logger interface {
+	// Debug prints out a message prefixed with `[DEBUG]` verbatim
+	Debug(message string)
+
+	// Debugf prints out a message prefixed with `[DEBUG]` formatted
+	// with the specified arguments
+	Debugf(format string, args ...interface{})
+
 	// Info prints out a message prefixed with `[INFO]` verbatim
 	Info(message string)
 
@@ -15,4 +22,18 @@ type Logger interface {
 	// Warnf prints out a message prefixed with `[WARN]` formatted
 	// with the specified arguments
 	Warnf(format string, args ...interface{})
+
+	// Error prints out a message prefixed with `[ERROR]` verbatim
+	Error(message string)
+
+	// Errorf prints out a message prefixed with `[ERROR]` formatted
+	// with the specified arguments
+	Errorf(format string, args ...interface{})
+
+	// WithFields returns a Logger which appends the specified key/value pairs
+	// to each message, in addition to any fields already defined on this Logger
+	//
+	// Values implementing fmt.Stringer are evaluated each time a message is logged,
+	// and fields with an empty value are omitted
+	WithFields(fields map[string]interface{}) Logger
 }
@@ -3,16 +3,30 @@ package sdk
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 )
 
 // ConsoleLogger provides a Logger implementation which writes the log messages
 // to StdOut - in Terraform's perspective that's proxied via the Plugin SDK
 type ConsoleLogger struct {
+	fields map[string]interface{}
+}
+
+// Debug prints out a message prefixed with `[DEBUG]` verbatim
+func (l ConsoleLogger) Debug(message string) {
+	l.print("DEBUG", message)
+}
+
+// Debugf prints out a message prefixed with `[DEBUG]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
 }
 
 // Info prints out a message prefixed with `[INFO]` verbatim
-func (ConsoleLogger) Info(message string) {
-	log.Print(fmt.Sprintf("[INFO] %s", message))
+func (l ConsoleLogger) Info(message string) {
+	l.print("INFO", message)
 }
 
 // Infof prints out a message prefixed with `[INFO]` formatted
@@ -23,7 +37,7 @@ func (l ConsoleLogger) Infof(format string, args ...interface{}) {
 
 // Warn prints out a message prefixed with `[WARN]` formatted verbatim
 func (l ConsoleLogger) Warn(message string) {
-	log.Print(fmt.Sprintf("[WARN] %s", message))
+	l.print("WARN", message)
 }
 
 // Warnf prints out a message prefixed with `[WARN]` formatted
@@ -31,3 +45,62 @@ func (l ConsoleLogger) Warn(message string) {
 func (l ConsoleLogger) Warnf(format string, args ...interface{}) {
 	l.Warn(fmt.Sprintf(format, args...))
 }
+
+// Error prints out a message prefixed with `[ERROR]` verbatim
+func (l ConsoleLogger) Error(message string) {
+	l.print("ERROR", message)
+}
+
+// Errorf prints out a message prefixed with `[ERROR]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Errorf(format string, args ...interface{}) {
+	l.Error(fmt.Sprintf(format, args...))
+}
+
+// WithFields returns a Logger which appends the specified key/value pairs
+// to each message, in addition to any fields already defined on this Logger
+func (l ConsoleLogger) WithFields(fields map[string]interface{}) Logger {
+	combined := make(map[string]interface{}, len(l.fields)+len(fields))
+	for k, v := range l.fields {
+		combined[k] = v
+	}
+	for k, v := range fields {
+		combined[k] = v
+	}
+
+	return ConsoleLogger{
+		fields: combined,
+	}
+}
+
+func (l ConsoleLogger) print(level string, message string) {
+	log.Print(formatLogMessage(level, message, l.fields))
+}
+
+// formatLogMessage returns the message prefixed with the level and suffixed with the fields
+// as `key=value` pairs (sorted by key) - for example:
+//
+//	[INFO] creating Example.. operation=create resource_type=azurerm_example
+func formatLogMessage(level string, message string, fields map[string]interface{}) string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := fmt.Sprintf("[%s] %s", level, message)
+	for _, k := range keys {
+		value := fmt.Sprintf("%v", fields[k])
+		if value == "" {
+			continue
+		}
+
+		if strings.ContainsAny(value, " \t\n\"") {
+			value = fmt.Sprintf("%q", value)
+		}
+
+		out += fmt.Sprintf(" %s=%s", k, value)
+	}
+
+	return out
+}
@@ -0,0 +1,120 @@
+package sdk
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+)
+
+func TestFormatLogMessage(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Level    string
+		Message  string
+		Fields   map[string]interface{}
+		Expected string
+	}{
+		{
+			Name:     "No Fields",
+			Level:    "INFO",
+			Message:  "hello",
+			Expected: "[INFO] hello",
+		},
+		{
+			Name:    "Sorted Fields",
+			Level:   "DEBUG",
+			Message: "hello",
+			Fields: map[string]interface{}{
+				"resource_type": "azurerm_example",
+				"operation":     "create",
+			},
+			Expected: "[DEBUG] hello operation=create resource_type=azurerm_example",
+		},
+		{
+			Name:    "Empty Fields are Omitted",
+			Level:   "WARN",
+			Message: "hello",
+			Fields: map[string]interface{}{
+				"id":        "",
+				"operation": "read",
+			},
+			Expected: "[WARN] hello operation=read",
+		},
+		{
+			Name:    "Values with Spaces are Quoted",
+			Level:   "ERROR",
+			Message: "hello",
+			Fields: map[string]interface{}{
+				"name": "hello world",
+			},
+			Expected: `[ERROR] hello name="hello world"`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := formatLogMessage(v.Level, v.Message, v.Fields)
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestConsoleLoggerWithFields(t *testing.T) {
+	var buf bytes.Buffer
+	originalOutput := log.Writer()
+	log.SetOutput(&buf)
+	defer log.SetOutput(originalOutput)
+
+	parent := ConsoleLogger{}.WithFields(map[string]interface{}{
+		"resource_type": "azurerm_example",
+	})
+	child := parent.WithFields(map[string]interface{}{
+		"operation": "create",
+	})
+
+	parent.Info("parent")
+	child.Errorf("child %d", 1)
+
+	output := buf.String()
+	if !strings.Contains(output, "[INFO] parent resource_type=azurerm_example\n") {
+		t.Fatalf("expected the parent logger to only contain it's own fields but got %q", output)
+	}
+	if !strings.Contains(output, "[ERROR] child 1 operation=create resource_type=azurerm_example\n") {
+		t.Fatalf("expected the child logger to contain both sets of fields but got %q", output)
+	}
+}
+
+func TestLoggerForOperation(t *testing.T) {
+	var buf bytes.Buffer
+	originalOutput := log.Writer()
+	log.SetOutput(&buf)
+	defer log.SetOutput(originalOutput)
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	client := &clients.Client{
+		CorrelationRequestID: "00000000-0000-0000-0000-000000000000",
+	}
+	logger := loggerForOperation(ConsoleLogger{}, client, "azurerm_example", "create", resourceDataID{d})
+
+	logger.Debug("before")
+	d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")
+	logger.Debug("after")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines but got %d: %q", len(lines), buf.String())
+	}
+
+	if !strings.HasSuffix(lines[0], "[DEBUG] before correlation_request_id=00000000-0000-0000-0000-000000000000 operation=create resource_type=azurerm_example") {
+		t.Fatalf("unexpected output before the ID was set: %q", lines[0])
+	}
+	if !strings.HasSuffix(lines[1], "[DEBUG] after correlation_request_id=00000000-0000-0000-0000-000000000000 id=/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example operation=create resource_type=azurerm_example") {
+		t.Fatalf("unexpected output after the ID was set: %q", lines[1])
+	}
+}
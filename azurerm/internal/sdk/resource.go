@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -62,10 +63,6 @@ type Resource interface {
 	IDValidationFunc() schema.SchemaValidateFunc
 }
 
-// TODO: ResourceWithCustomizeDiff
-// TODO: ResourceWithStateMigration
-// TODO: a generic state migration for updating ID's
-
 type ResourceWithCustomImporter interface {
 	Resource
 
@@ -87,6 +84,68 @@ type ResourceWithUpdate interface {
 	Update() ResourceFunc
 }
 
+// ResourceWithCustomizeDiff is an optional interface
+//
+// Resources implementing this interface can validate and modify the plan prior
+// to it being applied - for example to validate combinations of fields which
+// can't be expressed in the Schema, or to mark a field as ForceNew conditionally.
+type ResourceWithCustomizeDiff interface {
+	Resource
+
+	// CustomizeDiff returns a ResourceFunc which is called during `terraform plan`
+	// NOTE: the ResourceMetaData passed into this function contains a ResourceDiff
+	// rather than ResourceData - which can be used to call `ForceNew` or `SetNewComputed`
+	CustomizeDiff() ResourceFunc
+}
+
+// ResourceWithStateMigration is an optional interface
+//
+// Resources implementing this interface declare the current Schema Version
+// alongside the State Upgraders required to migrate older versions of the
+// Terraform State to the current Schema Version
+type ResourceWithStateMigration interface {
+	Resource
+
+	// StateUpgraders returns the current Schema Version and the State Upgraders for this Resource
+	StateUpgraders() StateUpgradeData
+}
+
+type StateUpgradeData struct {
+	// SchemaVersion is the current version of the Schema for this Resource
+	SchemaVersion int
+
+	// Upgraders is a map of the Schema Version to the StateUpgrade which migrates
+	// from that version to the next - there must be an Upgrader for every version
+	// from 0 up until (but not including) the current SchemaVersion
+	Upgraders map[int]StateUpgrade
+}
+
+// StateUpgrade is a single State Upgrade, migrating from one Schema Version to the next
+type StateUpgrade interface {
+	// Schema is the Schema used by this Resource at this Schema Version
+	Schema() map[string]*schema.Schema
+
+	// UpgradeFunc returns the function used to migrate the Raw State to the next Schema Version
+	UpgradeFunc() schema.StateUpgradeFunc
+}
+
+// ResourceWithGeneratedSchema is an optional interface
+//
+// Resources implementing this interface have their Schema generated from the `tfschema`
+// struct tags defined on the ModelObject (e.g. `tfschema:"name,required,forcenew"`) - meaning
+// that the Schema and Model can't drift. Fields returned from Arguments and Attributes take
+// precedence over the generated Schema (e.g. to use `location.Schema()`) but must exist in the Model.
+//
+// Nested blocks are generated from a slice of structs, as such a single nested block should be
+// defined as a slice with `maxitems:1` - a single nested struct field isn't supported.
+type ResourceWithGeneratedSchema interface {
+	Resource
+
+	// ValidateFuncs returns a map of the path to each field (e.g. `name` or `block.name`)
+	// to the ValidateFunc which should be used for that field in the generated Schema
+	ValidateFuncs() map[string]schema.SchemaValidateFunc
+}
+
 // ResourceWithDeprecation is an optional interface
 //
 // Resources implementing this interface will be marked as Deprecated
@@ -118,7 +177,8 @@ type ResourceMetaData struct {
 	// Client is a reference to the Azure Providers Client - providing a typed reference to this object
 	Client *clients.Client
 
-	// Logger provides a logger for debug purposes
+	// Logger provides a logger for debug purposes, each message is tagged with the Resource Type,
+	// Resource ID, Operation and Correlation Request ID for this Resource
 	Logger Logger
 
 	// ResourceData is a reference to the ResourceData object from Terraform's Plugin SDK
@@ -126,13 +186,21 @@ type ResourceMetaData struct {
 	// for example, to determine if a field has changes
 	ResourceData *schema.ResourceData
 
+	// ResourceDiff is a reference to the ResourceDiff object from Terraform's Plugin SDK
+	// This is only populated during CustomizeDiff, at which point ResourceData is nil
+	ResourceDiff *schema.ResourceDiff
+
 	// serializationDebugLogger is used for testing purposes
 	serializationDebugLogger Logger
 }
 
 // MarkAsGone marks this resource as removed in the Remote API, so this is no longer available
 func (rmd ResourceMetaData) MarkAsGone(idFormatter resourceid.Formatter) error {
-	rmd.Logger.Infof("[DEBUG] %s was not found - removing from state", idFormatter)
+	if rmd.ResourceData == nil {
+		return fmt.Errorf("%s can't be marked as gone from within CustomizeDiff", idFormatter)
+	}
+
+	rmd.Logger.Debugf("%s was not found - removing from state", idFormatter)
 	rmd.ResourceData.SetId("")
 	return nil
 }
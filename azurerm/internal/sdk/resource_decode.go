@@ -3,6 +3,7 @@ package sdk
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -18,7 +19,13 @@ import (
 // }
 // var person Person
 // if err := metadata.Decode(&person); err != nil { .. }
+//
+// NOTE: when called from within CustomizeDiff this decodes the proposed values from the ResourceDiff
 func (rmd ResourceMetaData) Decode(input interface{}) error {
+	if rmd.ResourceDiff != nil {
+		return decodeReflectedType(input, rmd.ResourceDiff, rmd.serializationDebugLogger)
+	}
+
 	return decodeReflectedType(input, rmd.ResourceData, rmd.serializationDebugLogger)
 }
 
@@ -39,7 +46,8 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 		field := objType.Field(i)
 		debugLogger.Infof("Field", field)
 
-		if val, exists := field.Tag.Lookup("tfschema"); exists {
+		if tag, exists := field.Tag.Lookup("tfschema"); exists {
+			val := tfschemaFieldName(tag)
 			tfschemaValue, valExists := stateRetriever.GetOkExists(val)
 			if !valExists {
 				continue
@@ -48,7 +56,7 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 			debugLogger.Infof("TFSchemaValue: ", tfschemaValue)
 			debugLogger.Infof("Input Type: ", reflect.ValueOf(input).Elem().Field(i).Type())
 
-			fieldName := reflect.ValueOf(input).Elem().Field(i).String()
+			fieldName := val
 			if err := setValue(input, tfschemaValue, i, fieldName, debugLogger); err != nil {
 				return err
 			}
@@ -58,7 +66,7 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 }
 
 func setValue(input, tfschemaValue interface{}, index int, fieldName string, debugLogger Logger) (errOut error) {
-	debugLogger.Infof("setting list value for %q..", fieldName)
+	debugLogger.Infof("setting value for %q..", fieldName)
 	defer func() {
 		if r := recover(); r != nil {
 			debugLogger.Warnf("error setting value for %q: %+v", fieldName, r)
@@ -71,134 +79,173 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 		}
 	}()
 
+	field := reflect.ValueOf(input).Elem().Field(index)
+	return setFieldValue(field, tfschemaValue, fieldName, debugLogger)
+}
+
+func setFieldValue(field reflect.Value, tfschemaValue interface{}, fieldName string, debugLogger Logger) error {
+	if tfschemaValue == nil {
+		return nil
+	}
+
+	// pointers allow distinguishing between a field which hasn't been set and a field
+	// which has been set to the zero value, as such we only allocate when there's a value
+	if field.Kind() == reflect.Ptr {
+		debugLogger.Infof("[POINTER] Decode %+v", tfschemaValue)
+		value := reflect.New(field.Type().Elem())
+		if err := setFieldValue(value.Elem(), tfschemaValue, fieldName, debugLogger); err != nil {
+			return err
+		}
+
+		field.Set(value)
+		return nil
+	}
+
+	if field.Type() == reflect.TypeOf(time.Time{}) {
+		v, ok := tfschemaValue.(string)
+		if !ok {
+			return fmt.Errorf("expected a string for the time %q but got %+v", fieldName, tfschemaValue)
+		}
+
+		debugLogger.Infof("[TIME] Decode %+v", v)
+		if v == "" {
+			return nil
+		}
+
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("parsing %q as an RFC3339 time for %q: %+v", v, fieldName, err)
+		}
+
+		field.Set(reflect.ValueOf(t))
+		return nil
+	}
+
 	if v, ok := tfschemaValue.(string); ok {
 		debugLogger.Infof("[String] Decode %+v", v)
-		debugLogger.Infof("Input %+v", reflect.ValueOf(input))
-		debugLogger.Infof("Input Elem %+v", reflect.ValueOf(input).Elem())
-		reflect.ValueOf(input).Elem().Field(index).SetString(v)
+		field.SetString(v)
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(int); ok {
 		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(int64(v))
+		field.SetInt(int64(v))
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(int32); ok {
 		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(int64(v))
+		field.SetInt(int64(v))
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(int64); ok {
 		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(v)
+		field.SetInt(v)
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(float64); ok {
 		debugLogger.Infof("[Float] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetFloat(v)
+		field.SetFloat(v)
 		return nil
 	}
 
 	// Doesn't work for empty bools?
 	if v, ok := tfschemaValue.(bool); ok {
 		debugLogger.Infof("[BOOL] Decode %+v", v)
-
-		reflect.ValueOf(input).Elem().Field(index).SetBool(v)
+		field.SetBool(v)
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(*schema.Set); ok {
-		return setListValue(input, index, fieldName, v.List(), debugLogger)
+		return setListValue(field, fieldName, v.List(), debugLogger)
 	}
 
 	if mapConfig, ok := tfschemaValue.(map[string]interface{}); ok {
-		mapOutput := reflect.MakeMap(reflect.ValueOf(input).Elem().Field(index).Type())
+		// a nested block is exposed by the Plugin SDK as a map
+		if field.Kind() == reflect.Struct {
+			return setStructValue(field, mapConfig, fieldName, debugLogger)
+		}
+
+		mapOutput := reflect.MakeMap(field.Type())
+		valueType := field.Type().Elem()
 		for key, val := range mapConfig {
-			mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
+			mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val).Convert(valueType))
 		}
 
-		reflect.ValueOf(input).Elem().Field(index).Set(mapOutput)
+		field.Set(mapOutput)
 		return nil
 	}
 
 	if v, ok := tfschemaValue.([]interface{}); ok {
-		return setListValue(input, index, fieldName, v, debugLogger)
+		return setListValue(field, fieldName, v, debugLogger)
 	}
 
 	return nil
 }
 
-func setListValue(input interface{}, index int, fieldName string, v []interface{}, debugLogger Logger) error {
-	switch fieldType := reflect.ValueOf(input).Elem().Field(index).Type(); fieldType {
-	case reflect.TypeOf([]string{}):
-		stringSlice := reflect.MakeSlice(reflect.TypeOf([]string{}), len(v), len(v))
-		for i, stringVal := range v {
-			stringSlice.Index(i).SetString(stringVal.(string))
+func setListValue(field reflect.Value, fieldName string, v []interface{}, debugLogger Logger) error {
+	fieldType := field.Type()
+	debugLogger.Infof("List Type", fieldType)
+
+	// a single nested block is exposed by the Plugin SDK as a list containing (at most) one item
+	if fieldType.Kind() == reflect.Struct {
+		if len(v) == 0 {
+			return nil
 		}
-		reflect.ValueOf(input).Elem().Field(index).Set(stringSlice)
+		nested, ok := v[0].(map[string]interface{})
+		if !ok || nested == nil {
+			return nil
+		}
+		return setStructValue(field, nested, fieldName, debugLogger)
+	}
 
-	case reflect.TypeOf([]int{}):
-		iSlice := reflect.MakeSlice(reflect.TypeOf([]int{}), len(v), len(v))
-		for i, iVal := range v {
-			iSlice.Index(i).SetInt(int64(iVal.(int)))
+	elemType := fieldType.Elem()
+	isNestedBlock := elemType.Kind() == reflect.Struct || (elemType.Kind() == reflect.Ptr && elemType.Elem().Kind() == reflect.Struct)
+	if elemType == reflect.TypeOf(time.Time{}) || elemType == reflect.TypeOf(&time.Time{}) {
+		isNestedBlock = false
+	}
+
+	valueToSet := reflect.MakeSlice(fieldType, 0, len(v))
+	for _, item := range v {
+		if item == nil {
+			continue
 		}
-		reflect.ValueOf(input).Elem().Field(index).Set(iSlice)
 
-	case reflect.TypeOf([]float64{}):
-		fSlice := reflect.MakeSlice(reflect.TypeOf([]float64{}), len(v), len(v))
-		for i, fVal := range v {
-			fSlice.Index(i).SetFloat(fVal.(float64))
+		// nested blocks which contain no values are returned as an empty/nil map - which we skip
+		if isNestedBlock {
+			if nested, ok := item.(map[string]interface{}); !ok || nested == nil {
+				continue
+			}
 		}
-		reflect.ValueOf(input).Elem().Field(index).Set(fSlice)
 
-	case reflect.TypeOf([]bool{}):
-		bSlice := reflect.MakeSlice(reflect.TypeOf([]bool{}), len(v), len(v))
-		for i, bVal := range v {
-			bSlice.Index(i).SetBool(bVal.(bool))
+		elem := reflect.New(elemType).Elem()
+		debugLogger.Infof("element ", elem)
+		if err := setFieldValue(elem, item, fieldName, debugLogger); err != nil {
+			return err
 		}
-		reflect.ValueOf(input).Elem().Field(index).Set(bSlice)
-
-	default:
-		valueToSet := reflect.MakeSlice(reflect.ValueOf(input).Elem().Field(index).Type(), 0, 0)
-		debugLogger.Infof("List Type", valueToSet.Type())
-
-		for _, mapVal := range v {
-			if test, ok := mapVal.(map[string]interface{}); ok && test != nil {
-				elem := reflect.New(fieldType.Elem())
-				debugLogger.Infof("element ", elem)
-				for j := 0; j < elem.Type().Elem().NumField(); j++ {
-					nestedField := elem.Type().Elem().Field(j)
-					debugLogger.Infof("nestedField ", nestedField)
-
-					if val, exists := nestedField.Tag.Lookup("tfschema"); exists {
-						nestedTFSchemaValue := test[val]
-						if err := setValue(elem.Interface(), nestedTFSchemaValue, j, fieldName, debugLogger); err != nil {
-							return err
-						}
-					}
-				}
-
-				if !elem.CanSet() {
-					elem = elem.Elem()
-				}
-
-				if valueToSet.Kind() == reflect.Ptr {
-					valueToSet.Elem().Set(reflect.Append(valueToSet.Elem(), elem))
-				} else {
-					valueToSet = reflect.Append(valueToSet, elem)
-				}
-
-				debugLogger.Infof("value to set type after changes", valueToSet.Type())
+
+		valueToSet = reflect.Append(valueToSet, elem)
+	}
+
+	field.Set(valueToSet)
+	return nil
+}
+
+func setStructValue(field reflect.Value, input map[string]interface{}, fieldName string, debugLogger Logger) error {
+	for j := 0; j < field.NumField(); j++ {
+		nestedField := field.Type().Field(j)
+		debugLogger.Infof("nestedField ", nestedField)
+
+		if tag, exists := nestedField.Tag.Lookup("tfschema"); exists {
+			val := tfschemaFieldName(tag)
+			nestedTFSchemaValue := input[val]
+			nestedFieldName := fmt.Sprintf("%s.%s", fieldName, val)
+			if err := setFieldValue(field.Field(j), nestedTFSchemaValue, nestedFieldName, debugLogger); err != nil {
+				return err
 			}
 		}
-
-		valueToSet = reflect.Indirect(valueToSet)
-		fieldToSet := reflect.ValueOf(input).Elem().Field(index)
-		fieldToSet.Set(reflect.Indirect(valueToSet))
 	}
 
 	return nil
@@ -3,6 +3,9 @@ package sdk
 import (
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 type decodeTestData struct {
@@ -740,3 +743,229 @@ func (td testDataGetter) GetOkExists(key string) (interface{}, bool) {
 	val, ok := td.values[key]
 	return val, ok
 }
+
+func TestDecode_TopLevelPointers(t *testing.T) {
+	type SimpleType struct {
+		String  *string  `tfschema:"string"`
+		Number  *int     `tfschema:"number"`
+		Price   *float64 `tfschema:"price"`
+		Enabled *bool    `tfschema:"enabled"`
+		Unset   *string  `tfschema:"unset"`
+	}
+	emptyString := ""
+	zero := 0
+	price := 129.99
+	disabled := false
+	decodeTestData{
+		State: map[string]interface{}{
+			"string":  "",
+			"number":  0,
+			"price":   float64(129.99),
+			"enabled": false,
+		},
+		Input: &SimpleType{},
+		Expected: &SimpleType{
+			String:  &emptyString,
+			Number:  &zero,
+			Price:   &price,
+			Enabled: &disabled,
+			Unset:   nil,
+		},
+		ExpectError: false,
+	}.test(t)
+}
+
+func TestDecode_TopLevelTimesAndEnums(t *testing.T) {
+	type Sku string
+	type Tier int
+	type SimpleType struct {
+		Created    time.Time      `tfschema:"created"`
+		Updated    *time.Time     `tfschema:"updated"`
+		Deleted    time.Time      `tfschema:"deleted"`
+		Sku        Sku            `tfschema:"sku"`
+		Tier       Tier           `tfschema:"tier"`
+		Skus       []Sku          `tfschema:"skus"`
+		SkuMapping map[string]Sku `tfschema:"sku_mapping"`
+	}
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	updated := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	decodeTestData{
+		State: map[string]interface{}{
+			"created": "2020-01-02T03:04:05Z",
+			"updated": "2021-02-03T04:05:06Z",
+			"deleted": "",
+			"sku":     "Premium",
+			"tier":    2,
+			"skus": []interface{}{
+				"Basic",
+				"Standard",
+			},
+			"sku_mapping": map[string]interface{}{
+				"first": "Basic",
+			},
+		},
+		Input: &SimpleType{},
+		Expected: &SimpleType{
+			Created: created,
+			Updated: &updated,
+			Sku:     Sku("Premium"),
+			Tier:    Tier(2),
+			Skus: []Sku{
+				"Basic",
+				"Standard",
+			},
+			SkuMapping: map[string]Sku{
+				"first": "Basic",
+			},
+		},
+		ExpectError: false,
+	}.test(t)
+}
+
+func TestDecode_TopLevelTimeInvalid(t *testing.T) {
+	type SimpleType struct {
+		Created time.Time `tfschema:"created"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"created": "yesterday",
+		},
+		Input:       &SimpleType{},
+		Expected:    &SimpleType{},
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestResourceDecode_NestedSet(t *testing.T) {
+	type Inner struct {
+		Value   string  `tfschema:"value"`
+		Enabled *bool   `tfschema:"enabled"`
+		Numbers []int64 `tfschema:"numbers"`
+	}
+	type Outer struct {
+		Name  string  `tfschema:"name"`
+		Inner []Inner `tfschema:"inner"`
+	}
+	type Type struct {
+		Outer []Outer `tfschema:"outer"`
+	}
+	innerResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"value": {
+				Type: schema.TypeString,
+			},
+			"enabled": {
+				Type: schema.TypeBool,
+			},
+			"numbers": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+	outerResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type: schema.TypeString,
+			},
+			"inner": {
+				Type: schema.TypeSet,
+				Elem: innerResource,
+			},
+		},
+	}
+	enabled := true
+	decodeTestData{
+		State: map[string]interface{}{
+			"outer": schema.NewSet(schema.HashResource(outerResource), []interface{}{
+				map[string]interface{}{
+					"name": "first",
+					"inner": schema.NewSet(schema.HashResource(innerResource), []interface{}{
+						map[string]interface{}{
+							"value":   "nested",
+							"enabled": true,
+							"numbers": []interface{}{1, 2},
+						},
+					}),
+				},
+			}),
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Outer: []Outer{
+				{
+					Name: "first",
+					Inner: []Inner{
+						{
+							Value:   "nested",
+							Enabled: &enabled,
+							Numbers: []int64{1, 2},
+						},
+					},
+				},
+			},
+		},
+		ExpectError: false,
+	}.test(t)
+}
+
+func TestResourceDecode_NestedPointers(t *testing.T) {
+	type Inner struct {
+		Value *string `tfschema:"value"`
+	}
+	type Type struct {
+		Inner []*Inner `tfschema:"inner"`
+	}
+	value := "hello"
+	decodeTestData{
+		State: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "hello",
+				},
+				nil,
+			},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Inner: []*Inner{
+				{
+					Value: &value,
+				},
+			},
+		},
+		ExpectError: false,
+	}.test(t)
+}
+
+func TestDecode_TagsWithSchemaOptions(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value,required"`
+	}
+	type SimpleType struct {
+		Name  string  `tfschema:"name,required,forcenew"`
+		Inner []Inner `tfschema:"inner,optional,maxitems:1"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"name": "example",
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "nested",
+				},
+			},
+		},
+		Input: &SimpleType{},
+		Expected: &SimpleType{
+			Name: "example",
+			Inner: []Inner{
+				{
+					Value: "nested",
+				},
+			},
+		},
+		ExpectError: false,
+	}.test(t)
+}
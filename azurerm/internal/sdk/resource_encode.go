@@ -3,12 +3,22 @@ package sdk
 import (
 	"fmt"
 	"reflect"
+	"time"
 )
 
 // Encode will encode the specified object into the Terraform State
 // NOTE: this requires that the object passed in is a pointer and
 // all fields contain `tfschema` struct tags
+//
+// Pointer fields which are nil are set to null - however since the Plugin SDK
+// stores these as the zero value, this will be returned when Decoding from the State
+//
+// NOTE: this isn't available from within CustomizeDiff, where only the ResourceDiff is populated
 func (rmd ResourceMetaData) Encode(input interface{}) error {
+	if rmd.ResourceData == nil {
+		return fmt.Errorf("the state can't be encoded from within CustomizeDiff")
+	}
+
 	if reflect.TypeOf(input).Kind() != reflect.Ptr {
 		return fmt.Errorf("need a pointer")
 	}
@@ -48,94 +58,168 @@ func recurse(objType reflect.Type, objVal reflect.Value, fieldName string, debug
 	for i := 0; i < objType.NumField(); i++ {
 		field := objType.Field(i)
 		fieldVal := objVal.Field(i)
-		if tfschemaTag, exists := field.Tag.Lookup("tfschema"); exists {
-			switch field.Type.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				iv := fieldVal.Int()
-				debugLogger.Infof("Setting %q to %d", tfschemaTag, iv)
-				output[tfschemaTag] = iv
-
-			case reflect.Float32, reflect.Float64:
-				fv := fieldVal.Float()
-				debugLogger.Infof("Setting %q to %f", tfschemaTag, fv)
-				output[tfschemaTag] = fv
-
-			case reflect.String:
-				sv := fieldVal.String()
-				debugLogger.Infof("Setting %q to %q", tfschemaTag, sv)
-				output[tfschemaTag] = sv
-
-			case reflect.Bool:
-				bv := fieldVal.Bool()
-				debugLogger.Infof("Setting %q to %t", tfschemaTag, bv)
-				output[tfschemaTag] = bv
-
-			case reflect.Map:
-				iter := fieldVal.MapRange()
-				attr := make(map[string]interface{})
-				for iter.Next() {
-					attr[iter.Key().String()] = iter.Value().Interface()
-				}
-				output[tfschemaTag] = attr
-
-			case reflect.Slice:
-				sv := fieldVal.Slice(0, fieldVal.Len())
-				attr := make([]interface{}, sv.Len())
-				switch sv.Type() {
-				case reflect.TypeOf([]string{}):
-					debugLogger.Infof("Setting %q to []string", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]string, 0)
-					}
-
-				case reflect.TypeOf([]int{}):
-					debugLogger.Infof("Setting %q to []int", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]int, 0)
-					}
-
-				case reflect.TypeOf([]float64{}):
-					debugLogger.Infof("Setting %q to []float64", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]float64, 0)
-					}
-
-				case reflect.TypeOf([]bool{}):
-					debugLogger.Infof("Setting %q to []bool", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]bool, 0)
-					}
-
-				default:
-					for i := 0; i < sv.Len(); i++ {
-						debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
-						debugLogger.Infof("[SLICE] Type %+v", sv.Type())
-						nestedType := sv.Index(i).Type()
-						nestedValue := sv.Index(i)
-
-						fieldName := field.Name
-						serialized, err := recurse(nestedType, nestedValue, fieldName, debugLogger)
-						if err != nil {
-							return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), exists)
-						}
-						attr[i] = serialized
-					}
-					debugLogger.Infof("[SLICE] Setting %q to %+v", tfschemaTag, attr)
-					output[tfschemaTag] = attr
-				}
-			default:
-				return output, fmt.Errorf("unknown type %+v for key %q", field.Type.Kind(), tfschemaTag)
+		if tag, exists := field.Tag.Lookup("tfschema"); exists {
+			tfschemaTag := tfschemaFieldName(tag)
+			value, err := encodeValue(tfschemaTag, field.Name, fieldVal, debugLogger)
+			if err != nil {
+				return output, err
 			}
+			output[tfschemaTag] = value
 		}
 	}
 
 	return output, nil
 }
+
+func encodeValue(tfschemaTag string, fieldName string, fieldVal reflect.Value, debugLogger Logger) (interface{}, error) {
+	if fieldVal.Type() == reflect.TypeOf(time.Time{}) {
+		tv := fieldVal.Interface().(time.Time)
+		debugLogger.Infof("Setting %q to %s", tfschemaTag, tv)
+		if tv.IsZero() {
+			return "", nil
+		}
+		return tv.Format(time.RFC3339), nil
+	}
+
+	switch fieldVal.Kind() {
+	case reflect.Ptr:
+		// a nil pointer means this field hasn't been set - so we clear any existing value
+		if fieldVal.IsNil() {
+			debugLogger.Infof("Setting %q to nil", tfschemaTag)
+			return nil, nil
+		}
+		return encodeValue(tfschemaTag, fieldName, fieldVal.Elem(), debugLogger)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		iv := fieldVal.Int()
+		debugLogger.Infof("Setting %q to %d", tfschemaTag, iv)
+		return iv, nil
+
+	case reflect.Float32, reflect.Float64:
+		fv := fieldVal.Float()
+		debugLogger.Infof("Setting %q to %f", tfschemaTag, fv)
+		return fv, nil
+
+	case reflect.String:
+		sv := fieldVal.String()
+		debugLogger.Infof("Setting %q to %q", tfschemaTag, sv)
+		return sv, nil
+
+	case reflect.Bool:
+		bv := fieldVal.Bool()
+		debugLogger.Infof("Setting %q to %t", tfschemaTag, bv)
+		return bv, nil
+
+	case reflect.Struct:
+		// a single nested block is exposed by the Plugin SDK as a list containing one item
+		debugLogger.Infof("[STRUCT] Setting %q to a single nested block", tfschemaTag)
+		serialized, err := recurse(fieldVal.Type(), fieldVal, fieldName, debugLogger)
+		if err != nil {
+			return nil, fmt.Errorf("serializing nested object %q: %+v", fieldVal.Type(), err)
+		}
+		return []interface{}{serialized}, nil
+
+	case reflect.Map:
+		iter := fieldVal.MapRange()
+		attr := make(map[string]interface{})
+		for iter.Next() {
+			attr[iter.Key().String()] = primitiveValue(iter.Value()).Interface()
+		}
+		return attr, nil
+
+	case reflect.Slice:
+		sv := fieldVal.Slice(0, fieldVal.Len())
+		switch sv.Type() {
+		case reflect.TypeOf([]string{}):
+			debugLogger.Infof("Setting %q to []string", tfschemaTag)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]string, 0), nil
+
+		case reflect.TypeOf([]int{}):
+			debugLogger.Infof("Setting %q to []int", tfschemaTag)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]int, 0), nil
+
+		case reflect.TypeOf([]float64{}):
+			debugLogger.Infof("Setting %q to []float64", tfschemaTag)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]float64, 0), nil
+
+		case reflect.TypeOf([]bool{}):
+			debugLogger.Infof("Setting %q to []bool", tfschemaTag)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]bool, 0), nil
+		}
+
+		elemType := sv.Type().Elem()
+		if isPrimitiveType(elemType) {
+			// e.g. a list of a custom (enum) type, which the Plugin SDK expects as the underlying type
+			primitiveType := primitiveValue(reflect.New(elemType).Elem()).Type()
+			debugLogger.Infof("Setting %q to []%s", tfschemaTag, primitiveType)
+			attr := reflect.MakeSlice(reflect.SliceOf(primitiveType), 0, sv.Len())
+			for i := 0; i < sv.Len(); i++ {
+				attr = reflect.Append(attr, primitiveValue(sv.Index(i)))
+			}
+			return attr.Interface(), nil
+		}
+
+		attr := make([]interface{}, 0, sv.Len())
+		for i := 0; i < sv.Len(); i++ {
+			debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
+			debugLogger.Infof("[SLICE] Type %+v", sv.Type())
+			nestedValue := reflect.Indirect(sv.Index(i))
+			if !nestedValue.IsValid() {
+				// a nil pointer within a list of nested blocks
+				continue
+			}
+
+			serialized, err := recurse(nestedValue.Type(), nestedValue, fieldName, debugLogger)
+			if err != nil {
+				return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), err)
+			}
+			attr = append(attr, serialized)
+		}
+		debugLogger.Infof("[SLICE] Setting %q to %+v", tfschemaTag, attr)
+		return attr, nil
+	}
+
+	return nil, fmt.Errorf("unknown type %+v for key %q", fieldVal.Kind(), tfschemaTag)
+}
+
+// isPrimitiveType returns whether the specified type is a string, int, float or bool
+// (including custom types based on these, such as enums)
+func isPrimitiveType(input reflect.Type) bool {
+	switch input.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// primitiveValue converts custom types (such as enums) to the underlying type
+// supported by the Plugin SDK - returning the value as-is for other types
+func primitiveValue(input reflect.Value) reflect.Value {
+	switch input.Kind() {
+	case reflect.String:
+		return input.Convert(reflect.TypeOf(""))
+	case reflect.Bool:
+		return input.Convert(reflect.TypeOf(true))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return input.Convert(reflect.TypeOf(0))
+	case reflect.Float32, reflect.Float64:
+		return input.Convert(reflect.TypeOf(float64(0)))
+	}
+
+	return input
+}
@@ -3,6 +3,7 @@ package sdk
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -668,3 +669,135 @@ func (testData encodeTestData) test(t *testing.T) {
 		t.Fatalf("Output mismatch:\n\n Expected: %+v\n\n Received: %+v\n\n", testData.Expected, output)
 	}
 }
+
+func TestResourceEncode_TopLevelPointers(t *testing.T) {
+	type SimpleType struct {
+		String  *string  `tfschema:"string"`
+		Number  *int     `tfschema:"number"`
+		Price   *float64 `tfschema:"price"`
+		Enabled *bool    `tfschema:"enabled"`
+		Unset   *string  `tfschema:"unset"`
+	}
+	emptyString := ""
+	zero := 0
+	price := 129.99
+	disabled := false
+	encodeTestData{
+		Input: &SimpleType{
+			String:  &emptyString,
+			Number:  &zero,
+			Price:   &price,
+			Enabled: &disabled,
+		},
+		Expected: map[string]interface{}{
+			"string":  "",
+			"number":  int64(0),
+			"price":   float64(129.99),
+			"enabled": false,
+			"unset":   nil,
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_TopLevelTimesAndEnums(t *testing.T) {
+	type Sku string
+	type Tier int
+	type SimpleType struct {
+		Created    time.Time      `tfschema:"created"`
+		Updated    *time.Time     `tfschema:"updated"`
+		Deleted    time.Time      `tfschema:"deleted"`
+		Sku        Sku            `tfschema:"sku"`
+		Tier       Tier           `tfschema:"tier"`
+		Skus       []Sku          `tfschema:"skus"`
+		SkuMapping map[string]Sku `tfschema:"sku_mapping"`
+	}
+	updated := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	encodeTestData{
+		Input: &SimpleType{
+			Created: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+			Updated: &updated,
+			Sku:     "Premium",
+			Tier:    2,
+			Skus: []Sku{
+				"Basic",
+				"Standard",
+			},
+			SkuMapping: map[string]Sku{
+				"first": "Basic",
+			},
+		},
+		Expected: map[string]interface{}{
+			"created": "2020-01-02T03:04:05Z",
+			"updated": "2021-02-03T04:05:06Z",
+			"deleted": "",
+			"sku":     "Premium",
+			"tier":    int64(2),
+			"skus": []string{
+				"Basic",
+				"Standard",
+			},
+			"sku_mapping": map[string]interface{}{
+				"first": "Basic",
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_NestedPointers(t *testing.T) {
+	type Inner struct {
+		Value *string `tfschema:"value"`
+	}
+	type Type struct {
+		Inner []*Inner `tfschema:"inner"`
+	}
+	value := "hello"
+	encodeTestData{
+		Input: &Type{
+			Inner: []*Inner{
+				{
+					Value: &value,
+				},
+				{},
+				nil,
+			},
+		},
+		Expected: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "hello",
+				},
+				map[string]interface{}{
+					"value": nil,
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_TagsWithSchemaOptions(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value,required"`
+	}
+	type SimpleType struct {
+		Name  string  `tfschema:"name,required,forcenew"`
+		Inner []Inner `tfschema:"inner,optional,maxitems:1"`
+	}
+	encodeTestData{
+		Input: &SimpleType{
+			Name: "example",
+			Inner: []Inner{
+				{
+					Value: "nested",
+				},
+			},
+		},
+		Expected: map[string]interface{}{
+			"name": "example",
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "nested",
+				},
+			},
+		},
+	}.test(t)
+}
@@ -1,8 +1,17 @@
 package sdk
 
-import "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
 
 // SetID uses the specified ID Formatter to set the Resource ID
-func (rmd ResourceMetaData) SetID(formatter resourceid.Formatter) {
+func (rmd ResourceMetaData) SetID(formatter resourceid.Formatter) error {
+	if rmd.ResourceData == nil {
+		return fmt.Errorf("the Resource ID can't be set from within CustomizeDiff")
+	}
+
 	rmd.ResourceData.SetId(formatter.ID())
+	return nil
 }
@@ -1,5 +1,21 @@
 package sdk
 
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+)
+
 // TODO: make these more granular for the tests
 
 type ExampleObj struct {
@@ -45,3 +61,569 @@ type InnerInner struct {
 	Name         string `tfschema:"name"`
 	ShouldBeFine bool   `tfschema:"should_be_fine"`
 }
+
+type customizeDiffModel struct {
+	Name     string `tfschema:"name"`
+	Sku      string `tfschema:"sku"`
+	Capacity int    `tfschema:"capacity"`
+	Endpoint string `tfschema:"endpoint"`
+}
+
+type customizeDiffResource struct {
+}
+
+func (r customizeDiffResource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"sku": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"capacity": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+	}
+}
+
+func (r customizeDiffResource) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"endpoint": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r customizeDiffResource) ModelObject() interface{} {
+	return customizeDiffModel{}
+}
+
+func (r customizeDiffResource) ResourceType() string {
+	return "validator_customize_diff"
+}
+
+func (r customizeDiffResource) Create() ResourceFunc {
+	return ResourceFunc{
+		Func: func(_ context.Context, _ ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r customizeDiffResource) Read() ResourceFunc {
+	return r.Create()
+}
+
+func (r customizeDiffResource) Delete() ResourceFunc {
+	return r.Create()
+}
+
+func (r customizeDiffResource) Update() ResourceFunc {
+	return r.Create()
+}
+
+func (r customizeDiffResource) IDValidationFunc() schema.SchemaValidateFunc {
+	return validation.StringIsNotEmpty
+}
+
+func (r customizeDiffResource) CustomizeDiff() ResourceFunc {
+	return ResourceFunc{
+		Func: func(_ context.Context, metadata ResourceMetaData) error {
+			var model customizeDiffModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			if model.Sku == "Basic" && model.Capacity > 1 {
+				return fmt.Errorf("`capacity` must be 1 when `sku` is `Basic`")
+			}
+
+			if metadata.ResourceDiff.HasChange("sku") {
+				old, new := metadata.ResourceDiff.GetChange("sku")
+				if old.(string) == "Premium" && new.(string) != "Premium" {
+					if err := metadata.ResourceDiff.ForceNew("sku"); err != nil {
+						return err
+					}
+				}
+
+				if metadata.ResourceDiff.Id() != "" {
+					if err := metadata.ResourceDiff.SetNewComputed("endpoint"); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func TestResourceWithCustomizeDiff_Wired(t *testing.T) {
+	wrapper := NewResourceWrapper(customizeDiffResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	if resource.CustomizeDiff == nil {
+		t.Fatalf("expected CustomizeDiff to be set but it wasn't")
+	}
+}
+
+func TestResourceWithCustomizeDiff_Validation(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Config      map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Name: "Basic with a single instance",
+			Config: map[string]interface{}{
+				"name":     "example",
+				"sku":      "Basic",
+				"capacity": 1,
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "Basic with multiple instances",
+			Config: map[string]interface{}{
+				"name":     "example",
+				"sku":      "Basic",
+				"capacity": 2,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Standard with multiple instances",
+			Config: map[string]interface{}{
+				"name":     "example",
+				"sku":      "Standard",
+				"capacity": 2,
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		resource := customizeDiffTestResource(t)
+		_, err := resource.Diff(nil, terraform.NewResourceConfigRaw(v.Config), customizeDiffTestClient())
+		if v.ExpectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+}
+
+func TestResourceWithCustomizeDiff_ForceNewAndSetNewComputed(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		ExistingSku       string
+		NewSku            string
+		ExpectRequiresNew bool
+		ExpectComputed    bool
+	}{
+		{
+			Name:              "No Changes",
+			ExistingSku:       "Standard",
+			NewSku:            "Standard",
+			ExpectRequiresNew: false,
+			ExpectComputed:    false,
+		},
+		{
+			Name:              "Upgrade",
+			ExistingSku:       "Standard",
+			NewSku:            "Premium",
+			ExpectRequiresNew: false,
+			ExpectComputed:    true,
+		},
+		{
+			Name:              "Downgrade",
+			ExistingSku:       "Premium",
+			NewSku:            "Standard",
+			ExpectRequiresNew: true,
+			ExpectComputed:    true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		resource := customizeDiffTestResource(t)
+		state := &terraform.InstanceState{
+			ID: "example",
+			Attributes: map[string]string{
+				"id":       "example",
+				"name":     "example",
+				"sku":      v.ExistingSku,
+				"capacity": "1",
+				"endpoint": "https://example.com",
+			},
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":     "example",
+			"sku":      v.NewSku,
+			"capacity": 1,
+		})
+		diff, err := resource.Diff(state, config, customizeDiffTestClient())
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		requiresNew := diff != nil && diff.RequiresNew()
+		if requiresNew != v.ExpectRequiresNew {
+			t.Fatalf("expected RequiresNew to be %t but got %t", v.ExpectRequiresNew, requiresNew)
+		}
+
+		computed := false
+		if diff != nil {
+			if attr, ok := diff.Attributes["endpoint"]; ok {
+				computed = attr.NewComputed
+			}
+		}
+		if computed != v.ExpectComputed {
+			t.Fatalf("expected `endpoint` to be NewComputed %t but got %t", v.ExpectComputed, computed)
+		}
+	}
+}
+
+func TestResourceWithCustomizeDiff_StateMethodsReturnErrors(t *testing.T) {
+	// ResourceData isn't available during CustomizeDiff, so these should error rather than panic
+	metadata := ResourceMetaData{
+		Logger:       ConsoleLogger{},
+		ResourceDiff: &schema.ResourceDiff{},
+	}
+	id := dataSourceTestID{Name: "example"}
+
+	if err := metadata.SetID(id); err == nil {
+		t.Fatalf("expected an error when calling SetID but didn't get one")
+	}
+	if err := metadata.MarkAsGone(id); err == nil {
+		t.Fatalf("expected an error when calling MarkAsGone but didn't get one")
+	}
+	if err := metadata.Encode(&struct{}{}); err == nil {
+		t.Fatalf("expected an error when calling Encode but didn't get one")
+	}
+}
+
+func customizeDiffTestResource(t *testing.T) *schema.Resource {
+	wrapper := NewResourceWrapper(customizeDiffResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+	return resource
+}
+
+func customizeDiffTestClient() *clients.Client {
+	return &clients.Client{
+		StopContext: context.TODO(),
+	}
+}
+
+type stateMigrationResource struct {
+	customizeDiffResource
+
+	upgradeData StateUpgradeData
+}
+
+func (r stateMigrationResource) StateUpgraders() StateUpgradeData {
+	return r.upgradeData
+}
+
+func TestResourceWithStateMigration(t *testing.T) {
+	upgrade := NewResourceIDStateUpgrade(customizeDiffResource{}.Arguments(), func(input string) (resourceid.Formatter, error) {
+		return nil, fmt.Errorf("not implemented")
+	})
+
+	testCases := []struct {
+		Name        string
+		Data        StateUpgradeData
+		ExpectError bool
+	}{
+		{
+			Name: "No Upgraders",
+			Data: StateUpgradeData{
+				SchemaVersion: 0,
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "Missing Upgraders",
+			Data: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]StateUpgrade{
+					0: upgrade,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Non-Sequential Upgraders",
+			Data: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]StateUpgrade{
+					0: upgrade,
+					2: upgrade,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Sequential Upgraders",
+			Data: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]StateUpgrade{
+					1: upgrade,
+					0: upgrade,
+				},
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		wrapper := NewResourceWrapper(stateMigrationResource{
+			upgradeData: v.Data,
+		})
+		resource, err := wrapper.Resource()
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if resource.SchemaVersion != v.Data.SchemaVersion {
+			t.Fatalf("expected SchemaVersion to be %d but got %d", v.Data.SchemaVersion, resource.SchemaVersion)
+		}
+		if len(resource.StateUpgraders) != v.Data.SchemaVersion {
+			t.Fatalf("expected %d State Upgraders but got %d", v.Data.SchemaVersion, len(resource.StateUpgraders))
+		}
+		for i, upgrader := range resource.StateUpgraders {
+			if upgrader.Version != i {
+				t.Fatalf("expected State Upgrader %d to be for Version %d but got %d", i, i, upgrader.Version)
+			}
+		}
+	}
+}
+
+type roundTripModel struct {
+	Name     string            `tfschema:"name"`
+	Location string            `tfschema:"location"`
+	Sku      roundTripSku      `tfschema:"sku"`
+	Capacity *int              `tfschema:"capacity"`
+	Rules    []roundTripRule   `tfschema:"rule"`
+	Settings roundTripSettings `tfschema:"settings"`
+	Tags     map[string]string `tfschema:"tags"`
+}
+
+type roundTripSku string
+
+type roundTripRule struct {
+	Name     string   `tfschema:"name"`
+	Priority int      `tfschema:"priority"`
+	Ports    []string `tfschema:"ports"`
+}
+
+type roundTripSettings struct {
+	Enabled bool   `tfschema:"enabled"`
+	Mode    string `tfschema:"mode"`
+}
+
+func roundTripSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"location": location.SchemaOptional(),
+		"sku": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"capacity": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"rule": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"priority": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+					"ports": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"settings": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+					"mode": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"tags": tags.Schema(),
+	}
+}
+
+func TestResourceDecodeEncodeRoundTrip(t *testing.T) {
+	zero := 0
+	capacity := 3
+	// NOTE: once encoded into the state, unset lists/sets/maps are returned as empty
+	testCases := []struct {
+		Name              string
+		Config            map[string]interface{}
+		Expected          roundTripModel
+		ExpectedRoundTrip *roundTripModel
+	}{
+		{
+			Name: "Basic",
+			Config: map[string]interface{}{
+				"name":     "example",
+				"sku":      "Basic",
+				"capacity": 0,
+			},
+			Expected: roundTripModel{
+				Name:     "example",
+				Sku:      "Basic",
+				Capacity: &zero,
+			},
+			ExpectedRoundTrip: &roundTripModel{
+				Name:     "example",
+				Sku:      "Basic",
+				Capacity: &zero,
+				Rules:    []roundTripRule{},
+				Tags:     map[string]string{},
+			},
+		},
+		{
+			Name: "Complete",
+			Config: map[string]interface{}{
+				"name":     "example",
+				"location": "West Europe",
+				"sku":      "Premium",
+				"capacity": 3,
+				"rule": []interface{}{
+					map[string]interface{}{
+						"name":     "first",
+						"priority": 100,
+						"ports": []interface{}{
+							"80",
+							"443",
+						},
+					},
+				},
+				"settings": []interface{}{
+					map[string]interface{}{
+						"enabled": true,
+						"mode":    "Automatic",
+					},
+				},
+				"tags": map[string]interface{}{
+					"environment": "production",
+				},
+			},
+			Expected: roundTripModel{
+				Name:     "example",
+				Location: "westeurope",
+				Sku:      "Premium",
+				Capacity: &capacity,
+				Rules: []roundTripRule{
+					{
+						Name:     "first",
+						Priority: 100,
+						Ports: []string{
+							"80",
+							"443",
+						},
+					},
+				},
+				Settings: roundTripSettings{
+					Enabled: true,
+					Mode:    "Automatic",
+				},
+				Tags: map[string]string{
+					"environment": "production",
+				},
+			},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		input := ResourceMetaData{
+			ResourceData:             schema.TestResourceDataRaw(t, roundTripSchema(), v.Config),
+			serializationDebugLogger: NullLogger{},
+		}
+		var decoded roundTripModel
+		if err := input.Decode(&decoded); err != nil {
+			t.Fatalf("decoding: %+v", err)
+		}
+		decoded.Location = location.Normalize(decoded.Location)
+		if !reflect.DeepEqual(decoded, v.Expected) {
+			t.Fatalf("\nExpected: %+v\n\n Received %+v\n\n", v.Expected, decoded)
+		}
+
+		output := ResourceMetaData{
+			ResourceData:             schema.TestResourceDataRaw(t, roundTripSchema(), map[string]interface{}{}),
+			serializationDebugLogger: NullLogger{},
+		}
+		if err := output.Encode(&decoded); err != nil {
+			t.Fatalf("encoding: %+v", err)
+		}
+
+		var roundTripped roundTripModel
+		if err := output.Decode(&roundTripped); err != nil {
+			t.Fatalf("decoding the encoded value: %+v", err)
+		}
+		expectedRoundTrip := decoded
+		if v.ExpectedRoundTrip != nil {
+			expectedRoundTrip = *v.ExpectedRoundTrip
+		}
+		if !reflect.DeepEqual(roundTripped, expectedRoundTrip) {
+			t.Fatalf("\nExpected: %+v\n\n Received %+v\n\n", expectedRoundTrip, roundTripped)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// tfschemaTag is the parsed representation of a `tfschema` struct tag, which contains
+// the name of the field in the Schema, optionally followed by a comma-separated list
+// of options used to generate the Schema for this field
+//
+// Example Usage:
+//
+//	type Example struct {
+//		Name    string   `tfschema:"name,required,forcenew"`
+//		Sku     string   `tfschema:"sku,optional,computed"`
+//		Zones   []string `tfschema:"zones,optional,set"`
+//		Rules   []Rule   `tfschema:"rule,optional,maxitems:1"`
+//		Output  string   `tfschema:"output,computed"`
+//		Secret  *string  `tfschema:"secret,optional,sensitive"`
+//	}
+//
+// Nested blocks must be defined as a slice of structs (e.g. `Rules` above) - a single nested
+// struct isn't supported, instead a slice with `maxitems:1` should be used for a single block.
+type tfschemaTag struct {
+	Name      string
+	Required  bool
+	Optional  bool
+	Computed  bool
+	ForceNew  bool
+	Sensitive bool
+	Set       bool
+	MaxItems  int
+	MinItems  int
+}
+
+// tfschemaFieldName returns the name of the field in the Schema from the `tfschema` struct tag
+func tfschemaFieldName(tag string) string {
+	return strings.Split(tag, ",")[0]
+}
+
+func parseTfschemaTag(input string) (*tfschemaTag, error) {
+	segments := strings.Split(input, ",")
+	tag := tfschemaTag{
+		Name: strings.TrimSpace(segments[0]),
+	}
+	if tag.Name == "" {
+		return nil, fmt.Errorf("the `tfschema` tag %q is missing a field name", input)
+	}
+
+	for _, option := range segments[1:] {
+		option = strings.TrimSpace(option)
+		switch {
+		case option == "required":
+			tag.Required = true
+		case option == "optional":
+			tag.Optional = true
+		case option == "computed":
+			tag.Computed = true
+		case option == "forcenew":
+			tag.ForceNew = true
+		case option == "sensitive":
+			tag.Sensitive = true
+		case option == "set":
+			tag.Set = true
+		case strings.HasPrefix(option, "maxitems:"):
+			v, err := strconv.Atoi(strings.TrimPrefix(option, "maxitems:"))
+			if err != nil {
+				return nil, fmt.Errorf("parsing `maxitems` for %q: %+v", tag.Name, err)
+			}
+			tag.MaxItems = v
+		case strings.HasPrefix(option, "minitems:"):
+			v, err := strconv.Atoi(strings.TrimPrefix(option, "minitems:"))
+			if err != nil {
+				return nil, fmt.Errorf("parsing `minitems` for %q: %+v", tag.Name, err)
+			}
+			tag.MinItems = v
+		default:
+			return nil, fmt.Errorf("unsupported option %q in the `tfschema` tag for %q", option, tag.Name)
+		}
+	}
+
+	if tag.Required && (tag.Optional || tag.Computed) {
+		return nil, fmt.Errorf("%q cannot be both Required and Optional/Computed", tag.Name)
+	}
+
+	if !tag.Required && !tag.Optional && !tag.Computed {
+		return nil, fmt.Errorf("%q must be one of `required`, `optional` or `computed`", tag.Name)
+	}
+
+	if tag.ForceNew && tag.Computed && !tag.Optional {
+		return nil, fmt.Errorf("%q is Computed-only and cannot be ForceNew", tag.Name)
+	}
+
+	return &tag, nil
+}
+
+// generateSchemaFromModel generates the Arguments and Attributes for the specified Model
+// using the options defined in the `tfschema` struct tags
+//
+// validateFuncs is a map of the path to the field (e.g. `name` or `rule.name`) to the ValidateFunc
+// which should be used for that field. The Arguments and Attributes returned from the Resource take
+// precedence over the generated schema for a given field - but must exist within the Model.
+func generateSchemaFromModel(model interface{}, validateFuncs map[string]schema.SchemaValidateFunc, arguments map[string]*schema.Schema, attributes map[string]*schema.Schema) (map[string]*schema.Schema, map[string]*schema.Schema, error) {
+	objType := reflect.TypeOf(model)
+	if objType == nil {
+		return nil, nil, fmt.Errorf("the Model Object cannot be nil")
+	}
+	if objType.Kind() == reflect.Ptr {
+		objType = objType.Elem()
+	}
+
+	usedValidateFuncs := make(map[string]struct{})
+	generated, err := generateSchemaForType("", objType, validateFuncs, usedValidateFuncs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unusedValidateFuncs := make([]string, 0)
+	for k := range validateFuncs {
+		if _, ok := usedValidateFuncs[k]; !ok {
+			unusedValidateFuncs = append(unusedValidateFuncs, k)
+		}
+	}
+	if len(unusedValidateFuncs) > 0 {
+		sort.Strings(unusedValidateFuncs)
+		return nil, nil, fmt.Errorf("ValidateFuncs were defined for fields which don't exist in the Model: %s", strings.Join(unusedValidateFuncs, ", "))
+	}
+
+	outArguments := make(map[string]*schema.Schema)
+	outAttributes := make(map[string]*schema.Schema)
+	for k, v := range generated {
+		if v.Computed && !v.Optional {
+			outAttributes[k] = v
+		} else {
+			outArguments[k] = v
+		}
+	}
+
+	// explicitly defined fields take precedence over the generated ones, for example
+	// to use `location.Schema()` - however these must still be defined in the Model
+	for k, v := range arguments {
+		if _, ok := generated[k]; !ok {
+			return nil, nil, fmt.Errorf("the Argument %q is not defined in the Model", k)
+		}
+		delete(outAttributes, k)
+		outArguments[k] = v
+	}
+	for k, v := range attributes {
+		if _, ok := generated[k]; !ok {
+			return nil, nil, fmt.Errorf("the Attribute %q is not defined in the Model", k)
+		}
+		delete(outArguments, k)
+		outAttributes[k] = v
+	}
+
+	return outArguments, outAttributes, nil
+}
+
+func generateSchemaForType(prefix string, objType reflect.Type, validateFuncs map[string]schema.SchemaValidateFunc, usedValidateFuncs map[string]struct{}) (map[string]*schema.Schema, error) {
+	if objType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct but got %s", objType.Kind())
+	}
+
+	out := make(map[string]*schema.Schema)
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		fieldPath := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
+
+		rawTag, exists := field.Tag.Lookup("tfschema")
+		if !exists {
+			return nil, fmt.Errorf("field %q is missing an `tfschema` label", fieldPath)
+		}
+
+		tag, err := parseTfschemaTag(rawTag)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %+v", fieldPath, err)
+		}
+
+		schemaPath := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, tag.Name), ".")
+		if _, exists := out[tag.Name]; exists {
+			return nil, fmt.Errorf("%q is defined multiple times", schemaPath)
+		}
+
+		fieldSchema, err := generateSchemaForField(schemaPath, field.Type, *tag, validateFuncs, usedValidateFuncs)
+		if err != nil {
+			return nil, err
+		}
+
+		out[tag.Name] = fieldSchema
+	}
+
+	return out, nil
+}
+
+func generateSchemaForField(schemaPath string, fieldType reflect.Type, tag tfschemaTag, validateFuncs map[string]schema.SchemaValidateFunc, usedValidateFuncs map[string]struct{}) (*schema.Schema, error) {
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	validateFunc, hasValidateFunc := validateFuncs[schemaPath]
+	if hasValidateFunc {
+		usedValidateFuncs[schemaPath] = struct{}{}
+	}
+
+	out := &schema.Schema{
+		Required:  tag.Required,
+		Optional:  tag.Optional,
+		Computed:  tag.Computed,
+		ForceNew:  tag.ForceNew,
+		Sensitive: tag.Sensitive,
+	}
+
+	if primitiveType, ok := schemaTypeForPrimitive(fieldType); ok {
+		if tag.Set || tag.MaxItems > 0 || tag.MinItems > 0 {
+			return nil, fmt.Errorf("%q: `set`, `maxitems` and `minitems` are only supported for lists", schemaPath)
+		}
+
+		out.Type = primitiveType
+		out.ValidateFunc = validateFunc
+		if out.ValidateFunc == nil && fieldType == reflect.TypeOf(time.Time{}) && !(tag.Computed && !tag.Optional) {
+			out.ValidateFunc = validation.IsRFC3339Time
+		}
+		return out, nil
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Struct:
+		return nil, fmt.Errorf("%q: nested blocks must be defined as a slice of structs - use `maxitems:1` for a single block", schemaPath)
+
+	case reflect.Map:
+		if fieldType.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("%q: only maps with a string key are supported", schemaPath)
+		}
+
+		elemType, ok := schemaTypeForPrimitive(fieldType.Elem())
+		if !ok {
+			return nil, fmt.Errorf("%q: only maps of strings, ints, floats and bools are supported", schemaPath)
+		}
+
+		out.Type = schema.TypeMap
+		out.ValidateFunc = validateFunc
+		out.Elem = &schema.Schema{
+			Type: elemType,
+		}
+		return out, nil
+
+	case reflect.Slice:
+		out.Type = schema.TypeList
+		if tag.Set {
+			out.Type = schema.TypeSet
+		}
+		out.MaxItems = tag.MaxItems
+		out.MinItems = tag.MinItems
+
+		elemType := fieldType.Elem()
+		if elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+
+		if primitiveType, ok := schemaTypeForPrimitive(elemType); ok {
+			out.Elem = &schema.Schema{
+				Type:         primitiveType,
+				ValidateFunc: validateFunc,
+			}
+			return out, nil
+		}
+
+		if hasValidateFunc {
+			return nil, fmt.Errorf("%q: ValidateFuncs aren't supported for nested blocks", schemaPath)
+		}
+
+		nested, err := generateSchemaForType(schemaPath, elemType, validateFuncs, usedValidateFuncs)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Elem = &schema.Resource{
+			Schema: nested,
+		}
+		return out, nil
+	}
+
+	return nil, fmt.Errorf("%q: unsupported type %s", schemaPath, fieldType)
+}
+
+func schemaTypeForPrimitive(input reflect.Type) (schema.ValueType, bool) {
+	if input == reflect.TypeOf(time.Time{}) {
+		return schema.TypeString, true
+	}
+
+	switch input.Kind() {
+	case reflect.String:
+		return schema.TypeString, true
+	case reflect.Bool:
+		return schema.TypeBool, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return schema.TypeInt, true
+	case reflect.Float32, reflect.Float64:
+		return schema.TypeFloat, true
+	}
+
+	return schema.TypeInvalid, false
+}
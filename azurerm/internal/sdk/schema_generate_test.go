@@ -0,0 +1,317 @@
+package sdk
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+)
+
+func TestParseTfschemaTag(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *tfschemaTag
+	}{
+		{
+			// name only isn't valid for generation
+			Input:    "name",
+			Expected: nil,
+		},
+		{
+			Input:    ",required",
+			Expected: nil,
+		},
+		{
+			Input: "name,required,forcenew",
+			Expected: &tfschemaTag{
+				Name:     "name",
+				Required: true,
+				ForceNew: true,
+			},
+		},
+		{
+			Input: "sku,optional,computed",
+			Expected: &tfschemaTag{
+				Name:     "sku",
+				Optional: true,
+				Computed: true,
+			},
+		},
+		{
+			Input: "rule, optional, set, maxitems:2, minitems:1",
+			Expected: &tfschemaTag{
+				Name:     "rule",
+				Optional: true,
+				Set:      true,
+				MaxItems: 2,
+				MinItems: 1,
+			},
+		},
+		{
+			Input:    "rule,optional,maxitems:two",
+			Expected: nil,
+		},
+		{
+			Input:    "name,required,optional",
+			Expected: nil,
+		},
+		{
+			Input:    "output,computed,forcenew",
+			Expected: nil,
+		},
+		{
+			Input:    "name,required,unknown",
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual, err := parseTfschemaTag(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Expected == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(*actual, *v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestGenerateSchemaFromModel(t *testing.T) {
+	type Sku string
+	type Rule struct {
+		Name     string `tfschema:"name,required"`
+		Priority int    `tfschema:"priority,optional"`
+	}
+	type Model struct {
+		Name      string            `tfschema:"name,required,forcenew"`
+		Location  string            `tfschema:"location,required,forcenew"`
+		Sku       Sku               `tfschema:"sku,optional,computed"`
+		Capacity  *int64            `tfschema:"capacity,optional"`
+		Ratio     float64           `tfschema:"ratio,optional"`
+		Enabled   bool              `tfschema:"enabled,optional"`
+		Expires   time.Time         `tfschema:"expires,optional"`
+		Zones     []string          `tfschema:"zones,optional,set"`
+		Rules     []Rule            `tfschema:"rule,optional,maxitems:2"`
+		Tags      map[string]string `tfschema:"tags,optional"`
+		Secret    *string           `tfschema:"secret,optional,sensitive"`
+		Endpoint  string            `tfschema:"endpoint,computed"`
+		CreatedOn time.Time         `tfschema:"created_on,computed"`
+	}
+
+	validateFuncs := map[string]schema.SchemaValidateFunc{
+		"name":      validation.StringIsNotEmpty,
+		"zones":     validation.StringInSlice([]string{"1", "2", "3"}, false),
+		"rule.name": validation.StringIsNotEmpty,
+	}
+	overrides := map[string]*schema.Schema{
+		"location": location.Schema(),
+	}
+	arguments, attributes, err := generateSchemaFromModel(Model{}, validateFuncs, overrides, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	expectedArguments := []string{"name", "location", "sku", "capacity", "ratio", "enabled", "expires", "zones", "rule", "tags", "secret"}
+	if len(arguments) != len(expectedArguments) {
+		t.Fatalf("expected %d arguments but got %d", len(expectedArguments), len(arguments))
+	}
+	for _, k := range expectedArguments {
+		if _, ok := arguments[k]; !ok {
+			t.Fatalf("expected %q to be an Argument but it wasn't", k)
+		}
+	}
+	expectedAttributes := []string{"endpoint", "created_on"}
+	if len(attributes) != len(expectedAttributes) {
+		t.Fatalf("expected %d attributes but got %d", len(expectedAttributes), len(attributes))
+	}
+	for _, k := range expectedAttributes {
+		if _, ok := attributes[k]; !ok {
+			t.Fatalf("expected %q to be an Attribute but it wasn't", k)
+		}
+	}
+
+	if v := arguments["name"]; v.Type != schema.TypeString || !v.Required || !v.ForceNew || v.ValidateFunc == nil {
+		t.Fatalf("unexpected schema for `name`: %+v", v)
+	}
+	if v := arguments["location"]; v.StateFunc == nil || v.DiffSuppressFunc == nil {
+		t.Fatalf("expected the override to be used for `location` but got: %+v", v)
+	}
+	if v := arguments["sku"]; v.Type != schema.TypeString || !v.Optional || !v.Computed {
+		t.Fatalf("unexpected schema for `sku`: %+v", v)
+	}
+	if v := arguments["capacity"]; v.Type != schema.TypeInt || !v.Optional {
+		t.Fatalf("unexpected schema for `capacity`: %+v", v)
+	}
+	if v := arguments["ratio"]; v.Type != schema.TypeFloat {
+		t.Fatalf("unexpected schema for `ratio`: %+v", v)
+	}
+	if v := arguments["enabled"]; v.Type != schema.TypeBool {
+		t.Fatalf("unexpected schema for `enabled`: %+v", v)
+	}
+	if v := arguments["expires"]; v.Type != schema.TypeString || v.ValidateFunc == nil {
+		t.Fatalf("unexpected schema for `expires`: %+v", v)
+	}
+	if v := arguments["zones"]; v.Type != schema.TypeSet || v.Elem.(*schema.Schema).Type != schema.TypeString || v.Elem.(*schema.Schema).ValidateFunc == nil {
+		t.Fatalf("unexpected schema for `zones`: %+v", v)
+	}
+	if v := arguments["tags"]; v.Type != schema.TypeMap || v.Elem.(*schema.Schema).Type != schema.TypeString {
+		t.Fatalf("unexpected schema for `tags`: %+v", v)
+	}
+	if v := arguments["secret"]; !v.Sensitive {
+		t.Fatalf("unexpected schema for `secret`: %+v", v)
+	}
+	if v := attributes["endpoint"]; v.Type != schema.TypeString || !v.Computed || v.Optional {
+		t.Fatalf("unexpected schema for `endpoint`: %+v", v)
+	}
+	if v := attributes["created_on"]; v.ValidateFunc != nil {
+		t.Fatalf("expected no ValidateFunc for the computed `created_on`: %+v", v)
+	}
+
+	rule := arguments["rule"]
+	if rule.Type != schema.TypeList || rule.MaxItems != 2 {
+		t.Fatalf("unexpected schema for `rule`: %+v", rule)
+	}
+	ruleSchema := rule.Elem.(*schema.Resource).Schema
+	if v := ruleSchema["name"]; v.Type != schema.TypeString || !v.Required || v.ValidateFunc == nil {
+		t.Fatalf("unexpected schema for `rule.name`: %+v", v)
+	}
+	if v := ruleSchema["priority"]; v.Type != schema.TypeInt || !v.Optional {
+		t.Fatalf("unexpected schema for `rule.priority`: %+v", v)
+	}
+
+	if err := (&schema.Resource{Schema: arguments}).InternalValidate(nil, true); err != nil {
+		t.Fatalf("generated Arguments failed validation: %+v", err)
+	}
+}
+
+func TestGenerateSchemaFromModelInvalid(t *testing.T) {
+	type Model struct {
+		Name string `tfschema:"name,required"`
+	}
+
+	testData := []struct {
+		Name          string
+		Model         interface{}
+		ValidateFuncs map[string]schema.SchemaValidateFunc
+		Arguments     map[string]*schema.Schema
+	}{
+		{
+			Name: "Missing Options",
+			Model: struct {
+				Name string `tfschema:"name"`
+			}{},
+		},
+		{
+			Name: "Missing Tag",
+			Model: struct {
+				Name string
+			}{},
+		},
+		{
+			Name: "Duplicate Fields",
+			Model: struct {
+				Name  string `tfschema:"name,required"`
+				Name2 string `tfschema:"name,optional"`
+			}{},
+		},
+		{
+			Name: "Single Nested Block",
+			Model: struct {
+				Nested Model `tfschema:"nested,optional"`
+			}{},
+		},
+		{
+			Name: "Single Nested Block Pointer",
+			Model: struct {
+				Nested *Model `tfschema:"nested,optional"`
+			}{},
+		},
+		{
+			Name: "Unsupported Type",
+			Model: struct {
+				Nested []chan string `tfschema:"nested,optional"`
+			}{},
+		},
+		{
+			Name:  "ValidateFunc for a field not in the Model",
+			Model: Model{},
+			ValidateFuncs: map[string]schema.SchemaValidateFunc{
+				"nope": validation.StringIsNotEmpty,
+			},
+		},
+		{
+			Name:  "Argument not in the Model",
+			Model: Model{},
+			Arguments: map[string]*schema.Schema{
+				"location": location.Schema(),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if _, _, err := generateSchemaFromModel(v.Model, v.ValidateFuncs, v.Arguments, nil); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+type generatedSchemaModel struct {
+	Name     string `tfschema:"name,required,forcenew"`
+	Sku      string `tfschema:"sku,optional"`
+	Endpoint string `tfschema:"endpoint,computed"`
+}
+
+type generatedSchemaResource struct {
+	customizeDiffResource
+}
+
+func (r generatedSchemaResource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{}
+}
+
+func (r generatedSchemaResource) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{}
+}
+
+func (r generatedSchemaResource) ModelObject() interface{} {
+	return generatedSchemaModel{}
+}
+
+func (r generatedSchemaResource) ValidateFuncs() map[string]schema.SchemaValidateFunc {
+	return map[string]schema.SchemaValidateFunc{
+		"sku": validation.StringInSlice([]string{"Basic", "Standard"}, false),
+	}
+}
+
+func TestResourceWithGeneratedSchema(t *testing.T) {
+	wrapper := NewResourceWrapper(generatedSchemaResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	if len(resource.Schema) != 3 {
+		t.Fatalf("expected 3 fields in the schema but got %d", len(resource.Schema))
+	}
+	if v := resource.Schema["sku"]; v.ValidateFunc == nil {
+		t.Fatalf("expected `sku` to have a ValidateFunc")
+	}
+	if v := resource.Schema["endpoint"]; !v.Computed {
+		t.Fatalf("expected `endpoint` to be Computed")
+	}
+}
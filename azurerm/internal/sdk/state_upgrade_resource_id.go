@@ -0,0 +1,72 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+// ResourceIDParseFunc parses the specified Resource ID into a Formatter which
+// can be used to return the canonical representation of this Resource ID
+//
+// Example Usage:
+//
+//	func(input string) (resourceid.Formatter, error) {
+//		 return parse.ResourceGroupID(input)
+//	}
+type ResourceIDParseFunc func(input string) (resourceid.Formatter, error)
+
+var _ StateUpgrade = ResourceIDStateUpgrade{}
+
+// ResourceIDStateUpgrade is a generic StateUpgrade which re-parses the existing Resource ID
+// stored in the `id` field of the Terraform State and replaces it with the canonical
+// representation of that Resource ID - for example normalizing `resourcegroups` to `resourceGroups`
+//
+// This allows Resource ID normalisation to be handled without writing a bespoke State Migration
+// for each Resource, using the ID Parsers available within each Service Package
+type ResourceIDStateUpgrade struct {
+	schema map[string]*schema.Schema
+	parser ResourceIDParseFunc
+}
+
+// NewResourceIDStateUpgrade returns a ResourceIDStateUpgrade using the Schema for this Schema Version
+// and the Resource ID Parser used to normalize the Resource ID
+func NewResourceIDStateUpgrade(schema map[string]*schema.Schema, parser ResourceIDParseFunc) ResourceIDStateUpgrade {
+	return ResourceIDStateUpgrade{
+		schema: schema,
+		parser: parser,
+	}
+}
+
+// Schema is the Schema used by this Resource at this Schema Version
+func (u ResourceIDStateUpgrade) Schema() map[string]*schema.Schema {
+	return u.schema
+}
+
+// UpgradeFunc returns the function used to migrate the Raw State to the next Schema Version
+func (u ResourceIDStateUpgrade) UpgradeFunc() schema.StateUpgradeFunc {
+	return func(rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+		oldIdRaw, ok := rawState["id"]
+		if !ok || oldIdRaw == nil {
+			return nil, fmt.Errorf("`id` was not found in the existing state")
+		}
+
+		oldId, ok := oldIdRaw.(string)
+		if !ok || oldId == "" {
+			return nil, fmt.Errorf("`id` was empty in the existing state")
+		}
+
+		id, err := u.parser(oldId)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", oldId, err)
+		}
+
+		newId := id.ID()
+		log.Printf("[DEBUG] Updating ID from %q to %q", oldId, newId)
+		rawState["id"] = newId
+
+		return rawState, nil
+	}
+}
@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+)
+
+func TestResourceIDStateUpgrade(t *testing.T) {
+	testData := []struct {
+		name        string
+		input       map[string]interface{}
+		expected    string
+		expectError bool
+	}{
+		{
+			name:        "missing id",
+			input:       map[string]interface{}{},
+			expectError: true,
+		},
+		{
+			name: "empty id",
+			input: map[string]interface{}{
+				"id": "",
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid id",
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012",
+			},
+			expectError: true,
+		},
+		{
+			name: "old id",
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1",
+			},
+			expected: "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+		},
+		{
+			name: "new id",
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+			},
+			expected: "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+		},
+	}
+
+	upgrade := NewResourceIDStateUpgrade(map[string]*schema.Schema{}, func(input string) (resourceid.Formatter, error) {
+		return parse.ResourceGroupID(input)
+	})
+	for _, test := range testData {
+		t.Logf("Testing %q..", test.name)
+		result, err := upgrade.UpgradeFunc()(test.input, nil)
+		if err != nil {
+			if test.expectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if test.expectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		actualId := result["id"].(string)
+		if test.expected != actualId {
+			t.Fatalf("expected %q but got %q!", test.expected, actualId)
+		}
+	}
+}
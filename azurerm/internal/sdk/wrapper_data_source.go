@@ -25,6 +25,13 @@ func NewDataSourceWrapper(dataSource DataSource) DataSourceWrapper {
 
 // DataSource returns the Terraform Plugin SDK type for this DataSource implementation
 func (rw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
+	// Data Sources generated from a Resource need to confirm the lookup arguments exist
+	if v, ok := rw.dataSource.(resourceDataSource); ok {
+		if err := v.validate(); err != nil {
+			return nil, fmt.Errorf("validating Data Source %q: %+v", rw.dataSource.ResourceType(), err)
+		}
+	}
+
 	resourceSchema, err := combineSchema(rw.dataSource.Arguments(), rw.dataSource.Attributes())
 	if err != nil {
 		return nil, fmt.Errorf("building Schema: %+v", err)
@@ -42,7 +49,7 @@ func (rw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 		Read: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.dataSource.ResourceType(), "read")
 			wrappedCtx, cancel := timeouts.ForRead(ctx, d)
 			defer cancel()
 			return rw.dataSource.Read().Func(wrappedCtx, metaData)
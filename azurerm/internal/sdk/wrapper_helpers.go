@@ -45,16 +45,97 @@ func combineSchema(arguments map[string]*schema.Schema, attributes map[string]*s
 	return &out, nil
 }
 
-func runArgs(d *schema.ResourceData, meta interface{}, logger Logger) (context.Context, ResourceMetaData) {
+// buildStateUpgraders converts the StateUpgradeData into the State Upgraders used by the Plugin SDK
+// ensuring that an Upgrader exists for each prior Schema Version, returned in order
+func buildStateUpgraders(input StateUpgradeData) ([]schema.StateUpgrader, error) {
+	if input.SchemaVersion < 0 {
+		return nil, fmt.Errorf("the SchemaVersion must be 0 or greater but got %d", input.SchemaVersion)
+	}
+
+	if len(input.Upgraders) != input.SchemaVersion {
+		return nil, fmt.Errorf("expected %d State Upgraders for Schema Version %d but got %d", input.SchemaVersion, input.SchemaVersion, len(input.Upgraders))
+	}
+
+	upgraders := make([]schema.StateUpgrader, 0)
+	for version := 0; version < input.SchemaVersion; version++ {
+		upgrader, ok := input.Upgraders[version]
+		if !ok || upgrader == nil {
+			return nil, fmt.Errorf("a State Upgrader for Schema Version %d was not defined", version)
+		}
+
+		upgradeSchema := &schema.Resource{
+			Schema: upgrader.Schema(),
+		}
+		upgraders = append(upgraders, schema.StateUpgrader{
+			Version: version,
+			Type:    upgradeSchema.CoreConfigSchema().ImpliedType(),
+			Upgrade: upgrader.UpgradeFunc(),
+		})
+	}
+
+	return upgraders, nil
+}
+
+func runArgs(d *schema.ResourceData, meta interface{}, logger Logger, resourceType string, operation string) (context.Context, ResourceMetaData) {
 	// NOTE: this is wrapped as a result of this function, so this is "fine" being unwrapped
 	stopContext := meta.(*clients.Client).StopContext
 	client := meta.(*clients.Client)
 	metaData := ResourceMetaData{
 		Client:                   client,
-		Logger:                   logger,
+		Logger:                   loggerForOperation(logger, client, resourceType, operation, resourceDataID{d}),
 		ResourceData:             d,
 		serializationDebugLogger: NullLogger{},
 	}
 
 	return stopContext, metaData
 }
+
+func runDiffArgs(d *schema.ResourceDiff, meta interface{}, logger Logger, resourceType string) (context.Context, ResourceMetaData) {
+	// NOTE: this is wrapped as a result of this function, so this is "fine" being unwrapped
+	stopContext := meta.(*clients.Client).StopContext
+	client := meta.(*clients.Client)
+	metaData := ResourceMetaData{
+		Client:                   client,
+		Logger:                   loggerForOperation(logger, client, resourceType, "customizediff", resourceDiffID{d}),
+		ResourceDiff:             d,
+		serializationDebugLogger: NullLogger{},
+	}
+
+	return stopContext, metaData
+}
+
+// loggerForOperation returns a Logger which tags each message with the Resource Type, Resource ID,
+// the operation being performed and the Correlation Request ID sent to Azure - allowing the lifecycle
+// of a single resource to be filtered out of the Terraform logs
+func loggerForOperation(logger Logger, client *clients.Client, resourceType string, operation string, id fmt.Stringer) Logger {
+	return logger.WithFields(map[string]interface{}{
+		"correlation_request_id": client.CorrelationRequestID,
+		"id":                     id,
+		"operation":              operation,
+		"resource_type":          resourceType,
+	})
+}
+
+// resourceDataID returns the current Resource ID each time a message is logged, since
+// this isn't available until part-way through a Create
+type resourceDataID struct {
+	d *schema.ResourceData
+}
+
+func (id resourceDataID) String() string {
+	if id.d == nil {
+		return ""
+	}
+	return id.d.Id()
+}
+
+type resourceDiffID struct {
+	d *schema.ResourceDiff
+}
+
+func (id resourceDiffID) String() string {
+	if id.d == nil {
+		return ""
+	}
+	return id.d.Id()
+}
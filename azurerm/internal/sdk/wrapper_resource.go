@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -26,7 +27,17 @@ func NewResourceWrapper(resource Resource) ResourceWrapper {
 
 // Resource returns the Terraform Plugin SDK type for this Resource implementation
 func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
-	resourceSchema, err := combineSchema(rw.resource.Arguments(), rw.resource.Attributes())
+	arguments := rw.resource.Arguments()
+	attributes := rw.resource.Attributes()
+	if v, ok := rw.resource.(ResourceWithGeneratedSchema); ok {
+		var err error
+		arguments, attributes, err = generateSchemaFromModel(v.ModelObject(), v.ValidateFuncs(), arguments, attributes)
+		if err != nil {
+			return nil, fmt.Errorf("generating Schema for %q: %+v", rw.resource.ResourceType(), err)
+		}
+	}
+
+	resourceSchema, err := combineSchema(arguments, attributes)
 	if err != nil {
 		return nil, fmt.Errorf("building Schema: %+v", err)
 	}
@@ -44,7 +55,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		Schema: *resourceSchema,
 
 		Create: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), "create")
 			wrappedCtx, cancel := timeouts.ForCreate(ctx, d)
 			defer cancel()
 			err := rw.resource.Create().Func(wrappedCtx, metaData)
@@ -59,13 +70,13 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 		// looks like these could be reused, easiest if they're not
 		Read: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), "read")
 			wrappedCtx, cancel := timeouts.ForRead(ctx, d)
 			defer cancel()
 			return rw.resource.Read().Func(wrappedCtx, metaData)
 		},
 		Delete: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), "delete")
 			wrappedCtx, cancel := timeouts.ForDelete(ctx, d)
 			defer cancel()
 			return rw.resource.Delete().Func(wrappedCtx, metaData)
@@ -95,7 +106,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			return nil
 		}, func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				ctx, metaData := runArgs(d, meta, rw.logger)
+				ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), "import")
 				wrappedCtx, cancel := timeouts.ForRead(ctx, d)
 				defer cancel()
 
@@ -115,7 +126,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
 		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), "update")
 			wrappedCtx, cancel := timeouts.ForUpdate(ctx, d)
 			defer cancel()
 
@@ -140,8 +151,25 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		resource.DeprecationMessage = message
 	}
 
-	// TODO: CustomizeDiff
-	// TODO: State Migrations
+	if v, ok := rw.resource.(ResourceWithCustomizeDiff); ok {
+		resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+			ctx, metaData := runDiffArgs(d, meta, rw.logger, rw.resource.ResourceType())
+			wrappedCtx, cancel := context.WithTimeout(ctx, v.CustomizeDiff().Timeout)
+			defer cancel()
+			return v.CustomizeDiff().Func(wrappedCtx, metaData)
+		}
+	}
+
+	if v, ok := rw.resource.(ResourceWithStateMigration); ok {
+		stateUpgradeData := v.StateUpgraders()
+		upgraders, err := buildStateUpgraders(stateUpgradeData)
+		if err != nil {
+			return nil, fmt.Errorf("building State Upgraders for %q: %+v", rw.resource.ResourceType(), err)
+		}
+
+		resource.SchemaVersion = stateUpgradeData.SchemaVersion
+		resource.StateUpgraders = upgraders
+	}
 
 	return &resource, nil
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 )
 
 // ValidateModelObject validates that the object contains the specified `tfschema` tags
@@ -39,10 +40,17 @@ func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal
 		if field.Type.Kind() == reflect.Slice {
 			sv := fieldVal.Slice(0, fieldVal.Len())
 			innerType := sv.Type().Elem()
-			innerVal := reflect.Indirect(reflect.New(innerType))
-			fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
-			if err := validateModelObjectRecursively(fieldName, innerType, innerVal); err != nil {
-				return err
+			if innerType.Kind() == reflect.Ptr {
+				innerType = innerType.Elem()
+			}
+
+			// only nested blocks need to be validated, lists of primitives (or times) don't contain fields
+			if innerType.Kind() == reflect.Struct && innerType != reflect.TypeOf(time.Time{}) {
+				innerVal := reflect.Indirect(reflect.New(innerType))
+				fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
+				if err := validateModelObjectRecursively(fieldName, innerType, innerVal); err != nil {
+					return err
+				}
 			}
 		}
 
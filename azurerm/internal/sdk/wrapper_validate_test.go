@@ -1,6 +1,9 @@
 package sdk
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestValidateTopLevelObjectValid(t *testing.T) {
 	type Person struct {
@@ -56,3 +59,19 @@ func TestValidateNestedObjectInvalid(t *testing.T) {
 		t.Fatalf("expected an error but didn't get one")
 	}
 }
+
+func TestValidateListsOfPrimitivesAndPointers(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+	}
+	type Person struct {
+		Names   []string  `tfschema:"names"`
+		Created time.Time `tfschema:"created"`
+		Age     *int      `tfschema:"age"`
+		Pets    []*Pet    `tfschema:"pets"`
+		Height  int
+	}
+	if err := ValidateModelObject(&Person{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
@@ -165,7 +165,7 @@ func resourceAnalysisServicesServerCreate(d *schema.ResourceData, meta interface
 		Location:         &location,
 		Sku:              &analysisservices.ResourceSku{Name: &sku},
 		ServerProperties: serverProperties,
-		Tags:             tags.Expand(t),
+		Tags:             meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, analysisServicesServer)
@@ -242,7 +242,7 @@ func resourceAnalysisServicesServerRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	return tags.FlattenAndSet(d, server.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, server.Tags)
 }
 
 func resourceAnalysisServicesServerUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -285,7 +285,7 @@ func resourceAnalysisServicesServerUpdate(d *schema.ResourceData, meta interface
 
 	analysisServicesServer := analysisservices.ServerUpdateParameters{
 		Sku:                     &analysisservices.ResourceSku{Name: &sku},
-		Tags:                    tags.Expand(t),
+		Tags:                    meta.(*clients.Client).Tags.Expand(t),
 		ServerMutableProperties: serverProperties,
 	}
 
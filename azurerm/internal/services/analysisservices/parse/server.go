@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerId struct {
@@ -67,3 +68,19 @@ func ServerID(input string) (*ServerId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "analysisservices",
+		TypeName:           "Server",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/Server1",
+		ResourceTypes:      []string{"azurerm_analysis_services_server"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ServerID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -624,7 +624,7 @@ func resourceApiManagementServiceCreateUpdate(d *schema.ResourceData, meta inter
 			CustomProperties: customProperties,
 			Certificates:     certificates,
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 		Sku:  sku,
 	}
 
@@ -877,7 +877,7 @@ func resourceApiManagementServiceRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceApiManagementServiceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiManagementId struct {
@@ -67,3 +68,19 @@ func ApiManagementID(input string) (*ApiManagementId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "apimanagement",
+		TypeName:           "ApiManagement",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1",
+		ResourceTypes:      []string{"azurerm_api_management"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ApiManagementID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
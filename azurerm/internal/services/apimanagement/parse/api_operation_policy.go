@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiOperationPolicyId struct {
@@ -85,3 +86,19 @@ func ApiOperationPolicyID(input string) (*ApiOperationPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "apimanagement",
+		TypeName:           "ApiOperationPolicy",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1/policies/policy1",
+		ResourceTypes:      []string{"azurerm_api_management_api_operation_policy"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ApiOperationPolicyID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
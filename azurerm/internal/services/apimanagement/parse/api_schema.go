@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiSchemaId struct {
@@ -79,3 +80,19 @@ func ApiSchemaID(input string) (*ApiSchemaId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "apimanagement",
+		TypeName:           "ApiSchema",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/schemas/schema1",
+		ResourceTypes:      []string{"azurerm_api_management_api_schema"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ApiSchemaID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
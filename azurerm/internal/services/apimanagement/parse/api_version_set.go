@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiVersionSetId struct {
@@ -73,3 +74,19 @@ func ApiVersionSetID(input string) (*ApiVersionSetId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "apimanagement",
+		TypeName:           "ApiVersionSet",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apiVersionSets/apiVersionSet1",
+		ResourceTypes:      []string{"azurerm_api_management_api_version_set"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ApiVersionSetID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
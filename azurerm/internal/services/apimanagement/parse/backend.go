@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BackendId struct {
@@ -73,3 +74,19 @@ func BackendID(input string) (*BackendId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "apimanagement",
+		TypeName:           "Backend",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/backends/backend1",
+		ResourceTypes:      []string{"azurerm_api_management_backend"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := BackendID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CustomDomainId struct {
@@ -73,3 +74,19 @@ func CustomDomainID(input string) (*CustomDomainId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "apimanagement",
+		TypeName:           "CustomDomain",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/customDomains/customdomain",
+		ResourceTypes:      []string{"azurerm_api_management_custom_domain"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := CustomDomainID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IdentityProviderId struct {
@@ -73,3 +74,19 @@ func IdentityProviderID(input string) (*IdentityProviderId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "apimanagement",
+		TypeName:           "IdentityProvider",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/identityProviders/identityProvider1",
+		ResourceTypes:      []string{"azurerm_api_management_identity_provider_aad", "azurerm_api_management_identity_provider_aadb2c", "azurerm_api_management_identity_provider_facebook", "azurerm_api_management_identity_provider_google", "azurerm_api_management_identity_provider_microsoft", "azurerm_api_management_identity_provider_twitter"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := IdentityProviderID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
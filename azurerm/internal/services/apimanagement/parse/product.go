@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProductId struct {
@@ -73,3 +74,19 @@ func ProductID(input string) (*ProductId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "apimanagement",
+		TypeName:           "Product",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1",
+		ResourceTypes:      []string{"azurerm_api_management_product"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ProductID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProductGroupId struct {
@@ -79,3 +80,19 @@ func ProductGroupID(input string) (*ProductGroupId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "apimanagement",
+		TypeName:           "ProductGroup",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/groups/group1",
+		ResourceTypes:      []string{"azurerm_api_management_product_group"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ProductGroupID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
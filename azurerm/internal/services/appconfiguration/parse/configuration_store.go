@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ConfigurationStoreId struct {
@@ -67,3 +68,19 @@ func ConfigurationStoreID(input string) (*ConfigurationStoreId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "appconfiguration",
+		TypeName:           "ConfigurationStore",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AppConfiguration/configurationStores/store1",
+		ResourceTypes:      []string{"azurerm_app_configuration"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ConfigurationStoreID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
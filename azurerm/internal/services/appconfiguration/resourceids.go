@@ -1,3 +1,3 @@
 package appconfiguration
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ConfigurationStore -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AppConfiguration/configurationStores/store1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ConfigurationStore -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AppConfiguration/configurationStores/store1 -resource-types=azurerm_app_configuration
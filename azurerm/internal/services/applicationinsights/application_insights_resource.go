@@ -177,7 +177,7 @@ func resourceApplicationInsightsCreateUpdate(d *schema.ResourceData, meta interf
 		Location:                               &location,
 		Kind:                                   &applicationType,
 		ApplicationInsightsComponentProperties: &applicationInsightsComponentProperties,
-		Tags:                                   tags.Expand(t),
+		Tags:                                   meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	_, err := client.CreateOrUpdate(ctx, resGroup, name, insightProperties)
@@ -270,7 +270,7 @@ func resourceApplicationInsightsRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("daily_data_cap_notifications_disabled", billingProps.StopSendNotificationWhenHitCap)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceApplicationInsightsDelete(d *schema.ResourceData, meta interface{}) error {
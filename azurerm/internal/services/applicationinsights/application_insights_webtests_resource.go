@@ -189,7 +189,7 @@ func resourceApplicationInsightsWebTestsCreateUpdate(d *schema.ResourceData, met
 				WebTest: &testConf,
 			},
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	resp, err := client.CreateOrUpdate(ctx, resGroup, name, webTest)
@@ -260,7 +260,7 @@ func resourceApplicationInsightsWebTestsRead(d *schema.ResourceData, meta interf
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceApplicationInsightsWebTestsDelete(d *schema.ResourceData, meta interface{}) error {
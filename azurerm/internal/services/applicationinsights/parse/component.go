@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ComponentId struct {
@@ -67,3 +68,19 @@ func ComponentID(input string) (*ComponentId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "applicationinsights",
+		TypeName:           "Component",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/microsoft.insights/components/component1",
+		ResourceTypes:      []string{"azurerm_application_insights"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ComponentID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SmartDetectionRuleId struct {
@@ -73,3 +74,19 @@ func SmartDetectionRuleID(input string) (*SmartDetectionRuleId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "applicationinsights",
+		TypeName:           "SmartDetectionRule",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/microsoft.insights/components/component1/SmartDetectionRule/rule1",
+		ResourceTypes:      []string{"azurerm_application_insights_smart_detection_rule"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SmartDetectionRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
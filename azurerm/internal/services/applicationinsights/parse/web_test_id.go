@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type WebTestId struct {
@@ -67,3 +68,19 @@ func WebTestID(input string) (*WebTestId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "applicationinsights",
+		TypeName:           "WebTest",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/microsoft.insights/webtests/test1",
+		ResourceTypes:      []string{"azurerm_application_insights_web_test"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := WebTestID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProviderId struct {
@@ -67,3 +68,19 @@ func ProviderID(input string) (*ProviderId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "attestation",
+		TypeName:           "Provider",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Attestation/attestationProviders/provider1",
+		ResourceTypes:      []string{"azurerm_attestation_provider"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ProviderID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -1,3 +1,3 @@
 package attestation
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Provider -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Attestation/attestationProviders/provider1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Provider -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Attestation/attestationProviders/provider1 -resource-types=azurerm_attestation_provider
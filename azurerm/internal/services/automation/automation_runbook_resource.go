@@ -184,7 +184,7 @@ func resourceAutomationRunbookCreateUpdate(d *schema.ResourceData, meta interfac
 		},
 
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	contentLink := expandContentLink(d.Get("publish_content_link").([]interface{}))
@@ -341,7 +341,7 @@ func resourceAutomationRunbookRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if t := resp.Tags; t != nil {
-		return tags.FlattenAndSet(d, t)
+		return meta.(*clients.Client).Tags.FlattenAndSet(d, t)
 	}
 
 	return nil
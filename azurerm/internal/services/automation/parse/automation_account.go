@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AutomationAccountId struct {
@@ -67,3 +68,19 @@ func AutomationAccountID(input string) (*AutomationAccountId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "automation",
+		TypeName:           "AutomationAccount",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1",
+		ResourceTypes:      []string{"azurerm_automation_account"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := AutomationAccountID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ConnectionId struct {
@@ -73,3 +74,19 @@ func ConnectionID(input string) (*ConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "automation",
+		TypeName:           "Connection",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/connections/connection1",
+		ResourceTypes:      []string{"azurerm_automation_connection", "azurerm_automation_connection_certificate", "azurerm_automation_connection_classic_certificate", "azurerm_automation_connection_service_principal"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ConnectionID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
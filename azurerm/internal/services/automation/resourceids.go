@@ -1,4 +1,4 @@
 package automation
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Connection -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/connections/connection1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AutomationAccount -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Connection -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/connections/connection1 -resource-types=azurerm_automation_connection,azurerm_automation_connection_certificate,azurerm_automation_connection_classic_certificate,azurerm_automation_connection_service_principal
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AutomationAccount -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1 -resource-types=azurerm_automation_account
@@ -99,7 +99,7 @@ func resourceArmStackHCIClusterCreate(d *schema.ResourceData, meta interface{})
 		ClusterProperties: &azurestackhci.ClusterProperties{
 			AadClientID: utils.String(d.Get("client_id").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("tenant_id"); ok {
@@ -147,7 +147,7 @@ func resourceArmStackHCIClusterRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("tenant_id", props.AadTenantID)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceArmStackHCIClusterUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -162,8 +162,8 @@ func resourceArmStackHCIClusterUpdate(d *schema.ResourceData, meta interface{})
 
 	cluster := azurestackhci.ClusterUpdate{}
 
-	if d.HasChange("tags") {
-		cluster.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		cluster.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, cluster); err != nil {
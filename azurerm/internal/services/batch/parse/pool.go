@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PoolId struct {
@@ -73,3 +74,19 @@ func PoolID(input string) (*PoolId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "batch",
+		TypeName:           "Pool",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Batch/batchAccounts/account1/pools/pool1",
+		ResourceTypes:      []string{"azurerm_batch_pool"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := PoolID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
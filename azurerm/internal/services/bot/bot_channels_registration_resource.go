@@ -169,7 +169,7 @@ func resourceBotChannelsRegistrationCreate(d *schema.ResourceData, meta interfac
 			Name: botservice.SkuName(d.Get("sku").(string)),
 		},
 		Kind: botservice.KindBot,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.Create(ctx, resourceId.ResourceGroup, resourceId.Name, bot); err != nil {
@@ -217,7 +217,7 @@ func resourceBotChannelsRegistrationRead(d *schema.ResourceData, meta interface{
 		d.Set("developer_app_insights_application_id", props.DeveloperAppInsightsApplicationID)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceBotChannelsRegistrationUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -250,7 +250,7 @@ func resourceBotChannelsRegistrationUpdate(d *schema.ResourceData, meta interfac
 			Name: botservice.SkuName(d.Get("sku").(string)),
 		},
 		Kind: botservice.KindBot,
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, bot); err != nil {
@@ -154,7 +154,7 @@ func resourceArmBotConnectionCreate(d *schema.ResourceData, meta interface{}) er
 		},
 		Kind:     botservice.KindBot,
 		Location: utils.String(d.Get("location").(string)),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.Create(ctx, resourceId.ResourceGroup, resourceId.BotServiceName, resourceId.ConnectionName, connection); err != nil {
@@ -199,7 +199,7 @@ func resourceArmBotConnectionRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceArmBotConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -222,7 +222,7 @@ func resourceArmBotConnectionUpdate(d *schema.ResourceData, meta interface{}) er
 		},
 		Kind:     botservice.KindBot,
 		Location: utils.String(d.Get("location").(string)),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.BotServiceName, id.ConnectionName, connection); err != nil {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BotChannelId struct {
@@ -73,3 +74,19 @@ func BotChannelID(input string) (*BotChannelId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "bot",
+		TypeName:           "BotChannel",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/channels/Discovery1",
+		ResourceTypes:      []string{"azurerm_bot_channel_directline", "azurerm_bot_channel_email", "azurerm_bot_channel_ms_teams", "azurerm_bot_channel_slack"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := BotChannelID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
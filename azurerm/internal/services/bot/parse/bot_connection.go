@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BotConnectionId struct {
@@ -73,3 +74,19 @@ func BotConnectionID(input string) (*BotConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "bot",
+		TypeName:           "BotConnection",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/connections/connection1",
+		ResourceTypes:      []string{"azurerm_bot_connection"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := BotConnectionID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
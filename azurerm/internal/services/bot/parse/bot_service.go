@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BotServiceId struct {
@@ -67,3 +68,19 @@ func BotServiceID(input string) (*BotServiceId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "bot",
+		TypeName:           "BotService",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1",
+		ResourceTypes:      []string{"azurerm_bot_channels_registration", "azurerm_bot_web_app"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := BotServiceID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -255,7 +255,7 @@ func resourceCdnEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 			QueryStringCachingBehavior: cdn.QueryStringCachingBehavior(cachingBehaviour),
 			OriginHostHeader:           utils.String(originHostHeader),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if _, ok := d.GetOk("content_types_to_compress"); ok {
@@ -359,7 +359,7 @@ func resourceCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 			QueryStringCachingBehavior: cdn.QueryStringCachingBehavior(cachingBehaviour),
 			OriginHostHeader:           utils.String(hostHeader),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if _, ok := d.GetOk("content_types_to_compress"); ok {
@@ -496,7 +496,7 @@ func resourceCdnEndpointRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceCdnEndpointDelete(d *schema.ResourceData, meta interface{}) error {
@@ -109,7 +109,7 @@ func resourceCdnProfileCreate(d *schema.ResourceData, meta interface{}) error {
 
 	cdnProfile := cdn.Profile{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		Sku: &cdn.Sku{
 			Name: cdn.SkuName(sku),
 		},
@@ -147,7 +147,7 @@ func resourceCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	if !d.HasChange("tags") {
+	if !tags.HasChange(d) {
 		return nil
 	}
 
@@ -161,7 +161,7 @@ func resourceCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error {
 	newTags := d.Get("tags").(map[string]interface{})
 
 	props := cdn.ProfileUpdateParameters{
-		Tags: tags.Expand(newTags),
+		Tags: meta.(*clients.Client).Tags.Expand(newTags),
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.Name, props)
@@ -205,7 +205,7 @@ func resourceCdnProfileRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("sku", string(sku.Name))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceCdnProfileDelete(d *schema.ResourceData, meta interface{}) error {
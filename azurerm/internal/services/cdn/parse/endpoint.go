@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EndpointId struct {
@@ -73,3 +74,19 @@ func EndpointID(input string) (*EndpointId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "cdn",
+		TypeName:           "Endpoint",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1/endpoints/endpoint1",
+		ResourceTypes:      []string{"azurerm_cdn_endpoint"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := EndpointID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
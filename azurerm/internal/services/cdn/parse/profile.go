@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProfileId struct {
@@ -67,3 +68,19 @@ func ProfileID(input string) (*ProfileId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "cdn",
+		TypeName:           "Profile",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1",
+		ResourceTypes:      []string{"azurerm_cdn_profile"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ProfileID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -1,4 +1,4 @@
 package cdn
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Endpoint -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1/endpoints/endpoint1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Profile -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Endpoint -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1/endpoints/endpoint1 -resource-types=azurerm_cdn_endpoint
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Profile -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1 -resource-types=azurerm_cdn_profile
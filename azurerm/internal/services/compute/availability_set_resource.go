@@ -128,7 +128,7 @@ func resourceAvailabilitySetCreateUpdate(d *schema.ResourceData, meta interface{
 			PlatformFaultDomainCount:  utils.Int32(int32(faultDomainCount)),
 			PlatformUpdateDomainCount: utils.Int32(int32(updateDomainCount)),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if v, ok := d.GetOk("proximity_placement_group_id"); ok {
@@ -191,7 +191,7 @@ func resourceAvailabilitySetRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceAvailabilitySetDelete(d *schema.ResourceData, meta interface{}) error {
@@ -161,7 +161,7 @@ func resourceDedicatedHostCreate(d *schema.ResourceData, meta interface{}) error
 		Sku: &compute.Sku{
 			Name: utils.String(d.Get("sku_name").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroupName, hostGroupName, name, parameters)
@@ -235,7 +235,7 @@ func resourceDedicatedHostRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("platform_fault_domain", platformFaultDomain)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceDedicatedHostUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -253,7 +253,7 @@ func resourceDedicatedHostUpdate(d *schema.ResourceData, meta interface{}) error
 			AutoReplaceOnFailure: utils.Bool(d.Get("auto_replace_on_failure").(bool)),
 			LicenseType:          compute.DedicatedHostLicenseTypes(d.Get("license_type").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.HostGroupName, id.HostName, parameters)
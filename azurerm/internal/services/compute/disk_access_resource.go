@@ -80,7 +80,7 @@ func resourceDiskAccessCreateUpdate(d *schema.ResourceData, meta interface{}) er
 	createDiskAccess := compute.DiskAccess{
 		Name:     &name,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, createDiskAccess)
@@ -132,7 +132,7 @@ func resourceDiskAccessRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceDiskAccessDelete(d *schema.ResourceData, meta interface{}) error {
@@ -140,7 +140,7 @@ func resourceDiskEncryptionSetCreate(d *schema.ResourceData, meta interface{}) e
 			},
 		},
 		Identity: expandDiskEncryptionSetIdentity(identityRaw),
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
@@ -201,7 +201,7 @@ func resourceDiskEncryptionSetRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceDiskEncryptionSetUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -217,8 +217,8 @@ func resourceDiskEncryptionSetUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	update := compute.DiskEncryptionSetUpdate{}
-	if d.HasChange("tags") {
-		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		update.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	if d.HasChange("key_vault_key_id") {
@@ -48,6 +48,8 @@ func resourceLinuxVirtualMachine() *schema.Resource {
 			Delete: schema.DefaultTimeout(45 * time.Minute),
 		},
 
+		CustomizeDiff: virtualMachineSizeCustomizeDiff(compute.Linux),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -58,7 +60,7 @@ func resourceLinuxVirtualMachine() *schema.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
-			"location": azure.SchemaLocation(),
+			"location": azure.SchemaLocationForResourceType("Microsoft.Compute/virtualMachines"),
 
 			// Required
 			"admin_username": {
@@ -407,7 +409,7 @@ func resourceLinuxVirtualMachineCreate(d *schema.ResourceData, meta interface{})
 			DiagnosticsProfile:     bootDiagnostics,
 			ExtensionsTimeBudget:   utils.String(d.Get("extensions_time_budget").(string)),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if v, ok := d.GetOk("license_type"); ok {
@@ -695,7 +697,7 @@ func resourceLinuxVirtualMachineRead(d *schema.ResourceData, meta interface{}) e
 	isWindows := false
 	setConnectionInformation(d, connectionInfo, isWindows)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -728,16 +730,7 @@ func resourceLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	shouldTurnBackOn := virtualMachineShouldBeStarted(instanceView)
-	hasEphemeralOSDisk := false
-	if props := existing.VirtualMachineProperties; props != nil {
-		if storage := props.StorageProfile; storage != nil {
-			if disk := storage.OsDisk; disk != nil {
-				if settings := disk.DiffDiskSettings; settings != nil {
-					hasEphemeralOSDisk = settings.Option == compute.Local
-				}
-			}
-		}
-	}
+	hasEphemeralOSDisk := virtualMachineHasEphemeralOSDisk(existing)
 
 	shouldUpdate := false
 	shouldShutDown := false
@@ -857,39 +850,33 @@ func resourceLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{})
 
 	if d.HasChange("size") {
 		shouldUpdate = true
-
-		// this is kind of superflurious since Azure can do this for us, but if we do this we can subsequently
-		// deallocate the VM to switch hosts if required
-		shouldShutDown = true
 		vmSize := d.Get("size").(string)
 
 		// Azure will auto-reboot this for us, providing this machine will fit on this host
 		// otherwise we need to shut down the VM to move it to another host to be able to use this size
-		availableOnThisHost := false
-		sizes, err := client.ListAvailableSizes(ctx, id.ResourceGroup, id.Name)
+		resize, err := determineVirtualMachineResize(ctx, meta.(*clients.Client).Compute, *id, existing, vmSize)
 		if err != nil {
-			return fmt.Errorf("retrieving available sizes for Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+			return fmt.Errorf("resizing Linux Virtual Machine %q (Resource Group %q) to %q: %+v", id.Name, id.ResourceGroup, vmSize, err)
 		}
 
-		if sizes.Value != nil {
-			for _, size := range *sizes.Value {
-				if size.Name == nil {
-					continue
-				}
-
-				if strings.EqualFold(*size.Name, vmSize) {
-					availableOnThisHost = true
-					break
-				}
-			}
-		}
-
-		if !availableOnThisHost {
+		if resize.RequiresDeallocation {
 			log.Printf("[DEBUG] Requested VM Size isn't available on the Host - must switch host to resize..")
 			// Code="OperationNotAllowed"
 			// Message="Unable to resize the VM [name] because the requested size Standard_F4s_v2 is not available in the current hardware cluster.
 			//         The available sizes in this cluster are: [list]. The requested size might be available in other clusters of this region.
 			//         Read more on VM resizing strategy at https://aka.ms/azure-resizevm."
+			if hasEphemeralOSDisk {
+				return fmt.Errorf("resizing Linux Virtual Machine %q (Resource Group %q) to %q: the size isn't available on the current hardware cluster and a Virtual Machine with an Ephemeral OS Disk can't be Deallocated to move to another hardware cluster", id.Name, id.ResourceGroup, vmSize)
+			}
+
+			if resize.ProximityPlacementGroupId != nil {
+				log.Printf("[DEBUG] Linux Virtual Machine %q (Resource Group %q) is within the Proximity Placement Group %q - the size %q must be available within the datacenter used by the Proximity Placement Group", id.Name, id.ResourceGroup, *resize.ProximityPlacementGroupId, vmSize)
+			}
+
+			// when Graceful Shutdown is enabled the VM is shut down prior to being deallocated, otherwise it's deallocated directly
+			if meta.(*clients.Client).Features.VirtualMachine.GracefulShutdown {
+				shouldShutDown = true
+			}
 			shouldDeallocate = true
 		}
 
@@ -910,11 +897,11 @@ func resourceLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{})
 		update.OsProfile.AllowExtensionOperations = utils.Bool(allowExtensionOperations)
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		shouldUpdate = true
 
 		tagsRaw := d.Get("tags").(map[string]interface{})
-		update.Tags = tags.Expand(tagsRaw)
+		update.Tags = meta.(*clients.Client).Tags.Expand(tagsRaw)
 	}
 
 	if d.HasChange("additional_capabilities") {
@@ -1075,8 +1062,8 @@ func resourceLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{})
 		log.Printf("[DEBUG] Updated Linux Virtual Machine %q (Resource Group %q).", id.Name, id.ResourceGroup)
 	}
 
-	// if we've shut it down and it was turned off, let's boot it back up
-	if shouldTurnBackOn && shouldShutDown {
+	// if we've shut it down (or deallocated it) and it was turned off, let's boot it back up
+	if shouldTurnBackOn && (shouldShutDown || shouldDeallocate) {
 		log.Printf("[DEBUG] Starting Linux Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
 		future, err := client.Start(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1116,6 +1103,12 @@ func resourceLinuxVirtualMachineDelete(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("retrieving Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
+	// the resources attached to the Virtual Machine have to be determined prior to it being deleted
+	attachedResources, err := determineVirtualMachineAttachedResourcesToDelete(ctx, meta, existing, true, false)
+	if err != nil {
+		return fmt.Errorf("determining the resources attached to Linux Virtual Machine %q (Resource Group %q) to delete: %+v", id.Name, id.ResourceGroup, err)
+	}
+
 	// If the VM was in a Failed state we can skip powering off, since that'll fail
 	if strings.EqualFold(*existing.ProvisioningState, "failed") {
 		log.Printf("[DEBUG] Powering Off Linux Virtual Machine was skipped because the VM was in %q state %q (Resource Group %q).", *existing.ProvisioningState, id.Name, id.ResourceGroup)
@@ -1223,5 +1216,9 @@ func resourceLinuxVirtualMachineDelete(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if err := deleteVirtualMachineAttachedResources(ctx, meta, id.Name, *attachedResources); err != nil {
+		return fmt.Errorf("deleting the resources attached to Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
 	return nil
 }
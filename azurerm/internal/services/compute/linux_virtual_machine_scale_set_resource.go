@@ -54,7 +54,7 @@ func resourceLinuxVirtualMachineScaleSet() *schema.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
-			"location": azure.SchemaLocation(),
+			"location": azure.SchemaLocationForResourceType("Microsoft.Compute/virtualMachineScaleSets"),
 
 			// Required
 			"admin_username": {
@@ -491,7 +491,7 @@ func resourceLinuxVirtualMachineScaleSetCreate(d *schema.ResourceData, meta inte
 		},
 		Identity: identity,
 		Plan:     plan,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
 			AdditionalCapabilities:                 additionalCapabilities,
 			AutomaticRepairsPolicy:                 automaticRepairsPolicy,
@@ -812,20 +812,20 @@ func resourceLinuxVirtualMachineScaleSetUpdate(d *schema.ResourceData, meta inte
 		updateProps.VirtualMachineProfile.ExtensionProfile.ExtensionsTimeBudget = utils.String(d.Get("extensions_time_budget").(string))
 	}
 
-	if d.HasChange("tags") {
-		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		update.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	update.VirtualMachineScaleSetUpdateProperties = &updateProps
 
 	metaData := virtualMachineScaleSetUpdateMetaData{
-		AutomaticOSUpgradeIsEnabled:  automaticOSUpgradeIsEnabled,
-		CanRollInstancesWhenRequired: meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired,
-		UpdateInstances:              updateInstances,
-		Client:                       meta.(*clients.Client).Compute,
-		Existing:                     existing,
-		ID:                           id,
-		OSType:                       compute.Linux,
+		AutomaticOSUpgradeIsEnabled: automaticOSUpgradeIsEnabled,
+		Features:                    meta.(*clients.Client).Features.VirtualMachineScaleSet,
+		UpdateInstances:             updateInstances,
+		Client:                      meta.(*clients.Client).Compute,
+		Existing:                    existing,
+		ID:                          id,
+		OSType:                      compute.Linux,
 	}
 
 	if err := metaData.performUpdate(ctx, update); err != nil {
@@ -1032,7 +1032,7 @@ func resourceLinuxVirtualMachineScaleSetRead(d *schema.ResourceData, meta interf
 		return fmt.Errorf("Error setting `zones`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceLinuxVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
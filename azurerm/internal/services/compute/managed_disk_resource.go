@@ -263,7 +263,7 @@ func resourceManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		Sku: &compute.DiskSku{
 			Name: skuName,
 		},
-		Tags:  tags.Expand(t),
+		Tags:  meta.(*clients.Client).Tags.Expand(t),
 		Zones: zones,
 	}
 
@@ -314,9 +314,9 @@ func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		DiskUpdateProperties: &compute.DiskUpdateProperties{},
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		t := d.Get("tags").(map[string]interface{})
-		diskUpdate.Tags = tags.Expand(t)
+		diskUpdate.Tags = meta.(*clients.Client).Tags.Expand(t)
 	}
 
 	if d.HasChange("storage_account_type") {
@@ -556,7 +556,7 @@ func resourceManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceManagedDiskDelete(d *schema.ResourceData, meta interface{}) error {
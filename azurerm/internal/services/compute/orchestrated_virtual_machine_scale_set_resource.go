@@ -112,7 +112,7 @@ func resourceOrchestratedVirtualMachineScaleSetCreateUpdate(d *schema.ResourceDa
 
 	props := compute.VirtualMachineScaleSet{
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
 			PlatformFaultDomainCount: utils.Int32(int32(d.Get("platform_fault_domain_count").(int))),
 			SinglePlacementGroup:     utils.Bool(d.Get("single_placement_group").(bool)),
@@ -188,7 +188,7 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *schema.ResourceData, meta
 		return fmt.Errorf("setting `zones`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceOrchestratedVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
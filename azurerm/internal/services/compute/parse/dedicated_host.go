@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DedicatedHostId struct {
@@ -73,3 +74,19 @@ func DedicatedHostID(input string) (*DedicatedHostId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "compute",
+		TypeName:           "DedicatedHost",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/hostGroups/hostGroup1/hosts/host1",
+		ResourceTypes:      []string{"azurerm_dedicated_host"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DedicatedHostID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DedicatedHostGroupId struct {
@@ -67,3 +68,19 @@ func DedicatedHostGroupID(input string) (*DedicatedHostGroupId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "compute",
+		TypeName:           "DedicatedHostGroup",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/hostGroups/hostGroup1",
+		ResourceTypes:      []string{"azurerm_dedicated_host_group"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DedicatedHostGroupID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DiskEncryptionSetId struct {
@@ -67,3 +68,19 @@ func DiskEncryptionSetID(input string) (*DiskEncryptionSetId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "compute",
+		TypeName:           "DiskEncryptionSet",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/diskEncryptionSets/set1",
+		ResourceTypes:      []string{"azurerm_disk_encryption_set"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DiskEncryptionSetID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HybridMachineId struct {
@@ -67,3 +68,18 @@ func HybridMachineID(input string) (*HybridMachineId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "compute",
+		TypeName:           "HybridMachine",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.HybridCompute/machines/machine1",
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := HybridMachineID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
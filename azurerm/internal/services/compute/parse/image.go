@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ImageId struct {
@@ -67,3 +68,19 @@ func ImageID(input string) (*ImageId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "compute",
+		TypeName:           "Image",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/images/image1",
+		ResourceTypes:      []string{"azurerm_image"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ImageID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
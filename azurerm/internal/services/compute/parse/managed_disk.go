@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ManagedDiskId struct {
@@ -67,3 +68,19 @@ func ManagedDiskID(input string) (*ManagedDiskId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "compute",
+		TypeName:           "ManagedDisk",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/disks/disk1",
+		ResourceTypes:      []string{"azurerm_managed_disk"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ManagedDiskID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
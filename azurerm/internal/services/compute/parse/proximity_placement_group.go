@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProximityPlacementGroupId struct {
@@ -67,3 +68,19 @@ func ProximityPlacementGroupID(input string) (*ProximityPlacementGroupId, error)
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "compute",
+		TypeName:           "ProximityPlacementGroup",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/proximityPlacementGroups/group1",
+		ResourceTypes:      []string{"azurerm_proximity_placement_group"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ProximityPlacementGroupID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SharedImageId struct {
@@ -73,3 +74,19 @@ func SharedImageID(input string) (*SharedImageId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "compute",
+		TypeName:           "SharedImage",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1",
+		ResourceTypes:      []string{"azurerm_shared_image"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SharedImageID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
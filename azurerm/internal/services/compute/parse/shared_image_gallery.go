@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SharedImageGalleryId struct {
@@ -67,3 +68,19 @@ func SharedImageGalleryID(input string) (*SharedImageGalleryId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "compute",
+		TypeName:           "SharedImageGallery",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1",
+		ResourceTypes:      []string{"azurerm_shared_image_gallery"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SharedImageGalleryID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SharedImageVersionId struct {
@@ -79,3 +80,19 @@ func SharedImageVersionID(input string) (*SharedImageVersionId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "compute",
+		TypeName:           "SharedImageVersion",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1/versions/version1",
+		ResourceTypes:      []string{"azurerm_shared_image_version"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SharedImageVersionID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualMachineId struct {
@@ -67,3 +68,19 @@ func VirtualMachineID(input string) (*VirtualMachineId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "compute",
+		TypeName:           "VirtualMachine",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1",
+		ResourceTypes:      []string{"azurerm_linux_virtual_machine", "azurerm_windows_virtual_machine"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := VirtualMachineID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
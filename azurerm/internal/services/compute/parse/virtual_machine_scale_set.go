@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualMachineScaleSetId struct {
@@ -67,3 +68,19 @@ func VirtualMachineScaleSetID(input string) (*VirtualMachineScaleSetId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "compute",
+		TypeName:           "VirtualMachineScaleSet",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1",
+		ResourceTypes:      []string{"azurerm_linux_virtual_machine_scale_set", "azurerm_orchestrated_virtual_machine_scale_set", "azurerm_windows_virtual_machine_scale_set"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := VirtualMachineScaleSetID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
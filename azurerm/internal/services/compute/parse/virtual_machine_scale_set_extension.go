@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualMachineScaleSetExtensionId struct {
@@ -73,3 +74,19 @@ func VirtualMachineScaleSetExtensionID(input string) (*VirtualMachineScaleSetExt
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "compute",
+		TypeName:           "VirtualMachineScaleSetExtension",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1/extensions/extension1",
+		ResourceTypes:      []string{"azurerm_virtual_machine_scale_set_extension"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := VirtualMachineScaleSetExtensionID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
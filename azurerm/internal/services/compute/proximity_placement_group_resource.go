@@ -77,7 +77,7 @@ func resourceProximityPlacementGroupCreateUpdate(d *schema.ResourceData, meta in
 	ppg := compute.ProximityPlacementGroup{
 		Name:     &name,
 		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	resp, err := client.CreateOrUpdate(ctx, resourceGroup, name, ppg)
@@ -117,7 +117,7 @@ func resourceProximityPlacementGroupRead(d *schema.ResourceData, meta interface{
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceProximityPlacementGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -197,7 +197,7 @@ func resourceSharedImageCreateUpdate(d *schema.ResourceData, meta interface{}) e
 			HyperVGeneration:    compute.HyperVGeneration(d.Get("hyper_v_generation").(string)),
 			PurchasePlan:        expandGalleryImagePurchasePlan(d.Get("purchase_plan").([]interface{})),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if d.Get("specialized").(bool) {
@@ -275,7 +275,7 @@ func resourceSharedImageRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceSharedImageDelete(d *schema.ResourceData, meta interface{}) error {
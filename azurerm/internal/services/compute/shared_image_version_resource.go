@@ -163,7 +163,7 @@ func resourceSharedImageVersionCreateUpdate(d *schema.ResourceData, meta interfa
 			},
 			StorageProfile: &compute.GalleryImageVersionStorageProfile{},
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("managed_image_id"); ok {
@@ -250,7 +250,7 @@ func resourceSharedImageVersionRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceSharedImageVersionDelete(d *schema.ResourceData, meta interface{}) error {
@@ -119,7 +119,7 @@ func resourceSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 				CreateOption: compute.DiskCreateOption(createOption),
 			},
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if v, ok := d.GetOk("source_uri"); ok {
@@ -212,7 +212,7 @@ func resourceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
@@ -93,7 +93,7 @@ func resourceSshPublicKeyCreate(d *schema.ResourceData, meta interface{}) error
 	params := compute.SSHPublicKeyResource{
 		Name:     utils.String(name),
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		SSHPublicKeyResourceProperties: &compute.SSHPublicKeyResourceProperties{
 			PublicKey: utils.String(public_key),
 		},
@@ -148,7 +148,7 @@ func resourceSshPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("public_key", props.PublicKey)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceSshPublicKeyUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -181,9 +181,9 @@ func resourceSshPublicKeyUpdate(d *schema.ResourceData, meta interface{}) error
 		SSHPublicKeyResourceProperties: &props,
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		tagsRaw := d.Get("tags").(map[string]interface{})
-		update.Tags = tags.Expand(tagsRaw)
+		update.Tags = meta.(*clients.Client).Tags.Expand(tagsRaw)
 	}
 
 	log.Printf("[DEBUG] Updating SSH Public Key %q (Resource Group %q)..", id.Name, id.ResourceGroup)
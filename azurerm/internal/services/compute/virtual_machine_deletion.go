@@ -0,0 +1,235 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	networkParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// networkInterfaceResourceName is the name used to lock Network Interfaces, which matches that used by the
+// `azurerm_network_interface` resource (and the associated resources) in the Network package
+var networkInterfaceResourceName = "azurerm_network_interface"
+
+// virtualMachineAttachedResources are the resources attached to a Virtual Machine which should be deleted once
+// the Virtual Machine has been deleted - these have to be determined prior to the Virtual Machine being deleted
+type virtualMachineAttachedResources struct {
+	// DataDiskIds are the Resource ID's of the Managed Data Disks attached to the Virtual Machine - those attached
+	// using the `azurerm_virtual_machine_data_disk_attachment` resource are detached prior to the Virtual Machine
+	// being deleted, and as such aren't included
+	DataDiskIds []string
+
+	// NetworkInterfaceIds are the Resource ID's of the Network Interfaces used by the Virtual Machine
+	NetworkInterfaceIds []string
+
+	// PublicIPAddressIds are the Resource ID's of the Public IP Addresses assigned to the Network Interfaces
+	PublicIPAddressIds []string
+}
+
+// determineVirtualMachineAttachedResourcesToDelete returns the resources attached to the Virtual Machine which should
+// be deleted alongside it, based on the Features block. Data Disks are omitted for the legacy `azurerm_virtual_machine`
+// resource, which manages their deletion using the `delete_data_disks_on_termination` field - whereas Network Interfaces
+// (and the Public IP Addresses assigned to them) are omitted for the `azurerm_linux_virtual_machine` and
+// `azurerm_windows_virtual_machine` resources, since these are always managed outside of the Virtual Machine
+func determineVirtualMachineAttachedResourcesToDelete(ctx context.Context, meta interface{}, virtualMachine compute.VirtualMachine, includeDataDisks, includeNetworkInterfaces bool) (*virtualMachineAttachedResources, error) {
+	vmFeatures := meta.(*clients.Client).Features.VirtualMachine
+
+	output := virtualMachineAttachedResources{
+		DataDiskIds:         make([]string, 0),
+		NetworkInterfaceIds: make([]string, 0),
+		PublicIPAddressIds:  make([]string, 0),
+	}
+
+	props := virtualMachine.VirtualMachineProperties
+	if props == nil {
+		return &output, nil
+	}
+
+	if includeDataDisks && vmFeatures.DeleteDataDisksOnDeletion && props.StorageProfile != nil && props.StorageProfile.DataDisks != nil {
+		for _, disk := range *props.StorageProfile.DataDisks {
+			// unmanaged disks (e.g. VHD's) are only supported by the legacy `azurerm_virtual_machine` resource
+			if disk.ManagedDisk == nil || disk.ManagedDisk.ID == nil {
+				continue
+			}
+
+			output.DataDiskIds = append(output.DataDiskIds, *disk.ManagedDisk.ID)
+		}
+	}
+
+	if !includeNetworkInterfaces || !vmFeatures.DeleteNetworkInterfacesOnDeletion {
+		if vmFeatures.DeletePublicIPAddressesOnDeletion {
+			log.Printf("[DEBUG] Skipping determining the Public IP Addresses to delete since these can only be deleted alongside the Network Interfaces")
+		}
+
+		return &output, nil
+	}
+
+	if props.NetworkProfile == nil || props.NetworkProfile.NetworkInterfaces == nil {
+		return &output, nil
+	}
+
+	for _, v := range *props.NetworkProfile.NetworkInterfaces {
+		if v.ID == nil {
+			continue
+		}
+
+		nicId, err := networkParse.NetworkInterfaceID(*v.ID)
+		if err != nil {
+			return nil, err
+		}
+		output.NetworkInterfaceIds = append(output.NetworkInterfaceIds, nicId.ID())
+
+		if !vmFeatures.DeletePublicIPAddressesOnDeletion {
+			continue
+		}
+
+		nicsClient := meta.(*clients.Client).Network.InterfacesClient
+		nic, err := nicsClient.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(nic.Response) {
+				continue
+			}
+
+			return nil, fmt.Errorf("retrieving %s: %+v", *nicId, err)
+		}
+
+		output.PublicIPAddressIds = append(output.PublicIPAddressIds, publicIPAddressIdsForNetworkInterface(nic.InterfacePropertiesFormat)...)
+	}
+
+	return &output, nil
+}
+
+// deleteVirtualMachineAttachedResources deletes the resources which were attached to the Virtual Machine, once it has
+// been deleted - when the Dry Run feature is enabled these are logged rather than deleted
+func deleteVirtualMachineAttachedResources(ctx context.Context, meta interface{}, virtualMachineName string, input virtualMachineAttachedResources) error {
+	vmFeatures := meta.(*clients.Client).Features.VirtualMachine
+	if vmFeatures.DeletionDryRun {
+		logVirtualMachineAttachedResourcesDryRun(virtualMachineName, input)
+		return nil
+	}
+
+	disksClient := meta.(*clients.Client).Compute.DisksClient
+	for _, v := range input.DataDiskIds {
+		id, err := parse.ManagedDiskID(v)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Deleting Data Disk %q (Resource Group %q) from Virtual Machine %q..", id.DiskName, id.ResourceGroup, virtualMachineName)
+		future, err := disksClient.Delete(ctx, id.ResourceGroup, id.DiskName)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return fmt.Errorf("deleting Data Disk %q (Resource Group %q) for Virtual Machine %q: %+v", id.DiskName, id.ResourceGroup, virtualMachineName, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, disksClient.Client); err != nil {
+			return fmt.Errorf("waiting for deletion of Data Disk %q (Resource Group %q) for Virtual Machine %q: %+v", id.DiskName, id.ResourceGroup, virtualMachineName, err)
+		}
+		log.Printf("[DEBUG] Deleted Data Disk %q (Resource Group %q) from Virtual Machine %q.", id.DiskName, id.ResourceGroup, virtualMachineName)
+	}
+
+	// the Network Interfaces have to be deleted prior to the Public IP Addresses assigned to them
+	for _, v := range input.NetworkInterfaceIds {
+		id, err := networkParse.NetworkInterfaceID(v)
+		if err != nil {
+			return err
+		}
+
+		if err := deleteVirtualMachineNetworkInterface(ctx, meta, *id, virtualMachineName); err != nil {
+			return err
+		}
+	}
+
+	pipsClient := meta.(*clients.Client).Network.PublicIPsClient
+	for _, v := range input.PublicIPAddressIds {
+		id, err := networkParse.PublicIpAddressID(v)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Deleting %s from Virtual Machine %q..", *id, virtualMachineName)
+		future, err := pipsClient.Delete(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+
+			return fmt.Errorf("deleting %s for Virtual Machine %q: %+v", *id, virtualMachineName, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, pipsClient.Client); err != nil {
+			return fmt.Errorf("waiting for deletion of %s for Virtual Machine %q: %+v", *id, virtualMachineName, err)
+		}
+		log.Printf("[DEBUG] Deleted %s from Virtual Machine %q.", *id, virtualMachineName)
+	}
+
+	return nil
+}
+
+// deleteVirtualMachineNetworkInterface deletes the Network Interface which was used by the Virtual Machine, locking it
+// since the Network Interface can also be modified by other resources (e.g. the Network Security Group association)
+func deleteVirtualMachineNetworkInterface(ctx context.Context, meta interface{}, id networkParse.NetworkInterfaceId, virtualMachineName string) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+
+	locks.ByName(id.Name, networkInterfaceResourceName)
+	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+
+	log.Printf("[DEBUG] Deleting %s from Virtual Machine %q..", id, virtualMachineName)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s for Virtual Machine %q: %+v", id, virtualMachineName, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s for Virtual Machine %q: %+v", id, virtualMachineName, err)
+	}
+	log.Printf("[DEBUG] Deleted %s from Virtual Machine %q.", id, virtualMachineName)
+
+	return nil
+}
+
+func logVirtualMachineAttachedResourcesDryRun(virtualMachineName string, input virtualMachineAttachedResources) {
+	log.Printf("[WARN] Dry Run: the resources attached to Virtual Machine %q will not be deleted, since `deletion_dry_run` is enabled in the Features block", virtualMachineName)
+
+	for _, v := range input.DataDiskIds {
+		log.Printf("[WARN] Dry Run: would delete the Data Disk %q attached to Virtual Machine %q", v, virtualMachineName)
+	}
+	for _, v := range input.NetworkInterfaceIds {
+		log.Printf("[WARN] Dry Run: would delete the Network Interface %q used by Virtual Machine %q", v, virtualMachineName)
+	}
+	for _, v := range input.PublicIPAddressIds {
+		log.Printf("[WARN] Dry Run: would delete the Public IP Address %q used by Virtual Machine %q", v, virtualMachineName)
+	}
+}
+
+// publicIPAddressIdsForNetworkInterface returns the Resource ID's of the Public IP Addresses assigned to
+// the IP Configurations within the Network Interface
+func publicIPAddressIdsForNetworkInterface(input *network.InterfacePropertiesFormat) []string {
+	output := make([]string, 0)
+	if input == nil || input.IPConfigurations == nil {
+		return output
+	}
+
+	for _, config := range *input.IPConfigurations {
+		props := config.InterfaceIPConfigurationPropertiesFormat
+		if props == nil || props.PublicIPAddress == nil || props.PublicIPAddress.ID == nil {
+			continue
+		}
+
+		output = append(output, *props.PublicIPAddress.ID)
+	}
+
+	return output
+}
@@ -0,0 +1,197 @@
+package compute
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestDetermineVirtualMachineAttachedResourcesToDelete(t *testing.T) {
+	virtualMachine := compute.VirtualMachine{
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			StorageProfile: &compute.StorageProfile{
+				DataDisks: &[]compute.DataDisk{
+					{
+						ManagedDisk: &compute.ManagedDiskParameters{
+							ID: utils.String("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/disks/disk1"),
+						},
+					},
+					{
+						// unmanaged disks are ignored
+						Vhd: &compute.VirtualHardDisk{
+							URI: utils.String("https://account1.blob.core.windows.net/vhds/disk2.vhd"),
+						},
+					},
+				},
+			},
+			NetworkProfile: &compute.NetworkProfile{
+				NetworkInterfaces: &[]compute.NetworkInterfaceReference{
+					{
+						ID: utils.String("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1"),
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name                     string
+		VirtualMachine           compute.VirtualMachine
+		Features                 features.VirtualMachineFeatures
+		IncludeDataDisks         bool
+		IncludeNetworkInterfaces bool
+		Expected                 virtualMachineAttachedResources
+	}{
+		{
+			Name:                     "No Properties",
+			VirtualMachine:           compute.VirtualMachine{},
+			Features:                 features.VirtualMachineFeatures{DeleteDataDisksOnDeletion: true, DeleteNetworkInterfacesOnDeletion: true},
+			IncludeDataDisks:         true,
+			IncludeNetworkInterfaces: true,
+			Expected: virtualMachineAttachedResources{
+				DataDiskIds:         []string{},
+				NetworkInterfaceIds: []string{},
+				PublicIPAddressIds:  []string{},
+			},
+		},
+		{
+			Name:                     "Features Disabled",
+			VirtualMachine:           virtualMachine,
+			Features:                 features.VirtualMachineFeatures{},
+			IncludeDataDisks:         true,
+			IncludeNetworkInterfaces: true,
+			Expected: virtualMachineAttachedResources{
+				DataDiskIds:         []string{},
+				NetworkInterfaceIds: []string{},
+				PublicIPAddressIds:  []string{},
+			},
+		},
+		{
+			Name:                     "Linux/Windows Virtual Machine",
+			VirtualMachine:           virtualMachine,
+			Features:                 features.VirtualMachineFeatures{DeleteDataDisksOnDeletion: true, DeleteNetworkInterfacesOnDeletion: true},
+			IncludeDataDisks:         true,
+			IncludeNetworkInterfaces: false,
+			Expected: virtualMachineAttachedResources{
+				DataDiskIds: []string{
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/disks/disk1",
+				},
+				NetworkInterfaceIds: []string{},
+				PublicIPAddressIds:  []string{},
+			},
+		},
+		{
+			Name:                     "Legacy Virtual Machine",
+			VirtualMachine:           virtualMachine,
+			Features:                 features.VirtualMachineFeatures{DeleteDataDisksOnDeletion: true, DeleteNetworkInterfacesOnDeletion: true},
+			IncludeDataDisks:         false,
+			IncludeNetworkInterfaces: true,
+			Expected: virtualMachineAttachedResources{
+				DataDiskIds: []string{},
+				NetworkInterfaceIds: []string{
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1",
+				},
+				PublicIPAddressIds: []string{},
+			},
+		},
+		{
+			Name:                     "Public IP Addresses without Network Interfaces",
+			VirtualMachine:           virtualMachine,
+			Features:                 features.VirtualMachineFeatures{DeletePublicIPAddressesOnDeletion: true},
+			IncludeDataDisks:         false,
+			IncludeNetworkInterfaces: true,
+			Expected: virtualMachineAttachedResources{
+				DataDiskIds:         []string{},
+				NetworkInterfaceIds: []string{},
+				PublicIPAddressIds:  []string{},
+			},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Test %q..", v.Name)
+
+		client := &clients.Client{
+			Features: features.UserFeatures{
+				VirtualMachine: v.Features,
+			},
+		}
+		actual, err := determineVirtualMachineAttachedResourcesToDelete(context.TODO(), client, v.VirtualMachine, v.IncludeDataDisks, v.IncludeNetworkInterfaces)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !reflect.DeepEqual(*actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, *actual)
+		}
+	}
+}
+
+func TestPublicIPAddressIdsForNetworkInterface(t *testing.T) {
+	buildIPConfiguration := func(publicIPAddressId *string) network.InterfaceIPConfiguration {
+		config := network.InterfaceIPConfiguration{
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{},
+		}
+		if publicIPAddressId != nil {
+			config.InterfaceIPConfigurationPropertiesFormat.PublicIPAddress = &network.PublicIPAddress{
+				ID: publicIPAddressId,
+			}
+		}
+		return config
+	}
+
+	testCases := []struct {
+		Name     string
+		Input    *network.InterfacePropertiesFormat
+		Expected []string
+	}{
+		{
+			Name:     "None",
+			Input:    nil,
+			Expected: []string{},
+		},
+		{
+			Name:     "No IP Configurations",
+			Input:    &network.InterfacePropertiesFormat{},
+			Expected: []string{},
+		},
+		{
+			Name: "No Public IP Addresses",
+			Input: &network.InterfacePropertiesFormat{
+				IPConfigurations: &[]network.InterfaceIPConfiguration{
+					buildIPConfiguration(nil),
+					{},
+				},
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "Multiple IP Configurations",
+			Input: &network.InterfacePropertiesFormat{
+				IPConfigurations: &[]network.InterfaceIPConfiguration{
+					buildIPConfiguration(utils.String("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1")),
+					buildIPConfiguration(nil),
+					buildIPConfiguration(utils.String("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip2")),
+				},
+			},
+			Expected: []string{
+				"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1",
+				"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip2",
+			},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Test %q..", v.Name)
+
+		actual := publicIPAddressIdsForNetworkInterface(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -143,7 +143,7 @@ func resourceVirtualMachineExtensionsCreateUpdate(d *schema.ResourceData, meta i
 			TypeHandlerVersion:      &typeHandlerVersion,
 			AutoUpgradeMinorVersion: &autoUpgradeMinor,
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if settingsString := d.Get("settings").(string); settingsString != "" {
@@ -234,7 +234,7 @@ func resourceVirtualMachineExtensionsRead(d *schema.ResourceData, meta interface
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVirtualMachineExtensionsDelete(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,140 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+)
+
+// virtualMachineResize details how a Virtual Machine can be resized to the requested size
+type virtualMachineResize struct {
+	// RequiresDeallocation specifies whether the Virtual Machine needs to be Deallocated to be resized, since the
+	// requested size isn't available on the hardware cluster the Virtual Machine is currently running on
+	RequiresDeallocation bool
+
+	// ProximityPlacementGroupId is the ID of the Proximity Placement Group the Virtual Machine is in, if any - in
+	// which case the requested size must also be available within the datacenter used by the Proximity Placement Group
+	ProximityPlacementGroupId *string
+}
+
+// determineVirtualMachineResize determines whether the Virtual Machine can be resized to the specified size - and
+// if so, whether the Virtual Machine needs to be Deallocated to move to a hardware cluster supporting this size
+func determineVirtualMachineResize(ctx context.Context, client *client.Client, id parse.VirtualMachineId, existing compute.VirtualMachine, size string) (*virtualMachineResize, error) {
+	log.Printf("[DEBUG] Retrieving the available sizes for Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	sizes, err := client.VMClient.ListAvailableSizes(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving available sizes for Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	output := virtualMachineResize{}
+	props := existing.VirtualMachineProperties
+	if props != nil && props.ProximityPlacementGroup != nil {
+		output.ProximityPlacementGroupId = props.ProximityPlacementGroup.ID
+	}
+
+	if virtualMachineSizeIsAvailable(sizes, size) {
+		return &output, nil
+	}
+
+	// the sizes available to a Virtual Machine within an Availability Set are limited to those available to the Availability
+	// Set - which are those available on the current hardware cluster when any Virtual Machine within it is running
+	if props != nil && props.AvailabilitySet != nil && props.AvailabilitySet.ID != nil {
+		availabilitySetId, err := parse.AvailabilitySetID(*props.AvailabilitySet.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Printf("[DEBUG] Retrieving the available sizes for %s..", *availabilitySetId)
+		availabilitySetSizes, err := client.AvailabilitySetsClient.ListAvailableSizes(ctx, availabilitySetId.ResourceGroup, availabilitySetId.Name)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving available sizes for %s: %+v", *availabilitySetId, err)
+		}
+
+		if !virtualMachineSizeIsAvailable(availabilitySetSizes, size) {
+			return nil, fmt.Errorf("the size %q isn't available within %s - to resize a Virtual Machine to a size which isn't available on the current hardware cluster, all of the Virtual Machines within the Availability Set must be deallocated", size, *availabilitySetId)
+		}
+	}
+
+	output.RequiresDeallocation = true
+	return &output, nil
+}
+
+// virtualMachineSizeIsAvailable returns whether the specified size is within the list of available sizes
+func virtualMachineSizeIsAvailable(input compute.VirtualMachineSizeListResult, size string) bool {
+	if input.Value == nil {
+		return false
+	}
+
+	for _, v := range *input.Value {
+		if v.Name != nil && strings.EqualFold(*v.Name, size) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// virtualMachineSizeCustomizeDiff checks whether the Virtual Machine can be resized to the requested size at plan time,
+// surfacing an error when the size can't be used - and a warning when the Virtual Machine needs to be Deallocated
+func virtualMachineSizeCustomizeDiff(osType compute.OperatingSystemTypes) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" || !d.HasChange("size") {
+			return nil
+		}
+
+		id, err := parse.VirtualMachineID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		client := meta.(*clients.Client).Compute
+		ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, 5*time.Minute)
+		defer cancel()
+
+		existing, err := client.VMClient.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return fmt.Errorf("retrieving %s Virtual Machine %q (Resource Group %q) to check the available sizes: %+v", osType, id.Name, id.ResourceGroup, err)
+		}
+
+		size := d.Get("size").(string)
+		resize, err := determineVirtualMachineResize(ctx, client, *id, existing, size)
+		if err != nil {
+			return fmt.Errorf("resizing %s Virtual Machine %q (Resource Group %q) to %q: %+v", osType, id.Name, id.ResourceGroup, size, err)
+		}
+
+		if resize.RequiresDeallocation {
+			if virtualMachineHasEphemeralOSDisk(existing) {
+				return fmt.Errorf("resizing %s Virtual Machine %q (Resource Group %q) to %q: the size isn't available on the current hardware cluster and a Virtual Machine with an Ephemeral OS Disk can't be Deallocated to move to another hardware cluster", osType, id.Name, id.ResourceGroup, size)
+			}
+
+			// NOTE: the Plugin SDK doesn't support returning Warnings from a CustomizeDiff, so this is logged
+			log.Printf("[WARN] The size %q isn't available on the current hardware cluster for %s Virtual Machine %q (Resource Group %q) - the Virtual Machine will be Deallocated to be resized, and then Started again if it was running", size, osType, id.Name, id.ResourceGroup)
+		}
+
+		return nil
+	}
+}
+
+// virtualMachineHasEphemeralOSDisk returns whether the OS Disk for the Virtual Machine is Ephemeral, in which case
+// the Virtual Machine can't be Deallocated
+func virtualMachineHasEphemeralOSDisk(input compute.VirtualMachine) bool {
+	if props := input.VirtualMachineProperties; props != nil {
+		if storage := props.StorageProfile; storage != nil {
+			if disk := storage.OsDisk; disk != nil {
+				if settings := disk.DiffDiskSettings; settings != nil {
+					return settings.Option == compute.Local
+				}
+			}
+		}
+	}
+
+	return false
+}
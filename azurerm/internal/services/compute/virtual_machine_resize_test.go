@@ -0,0 +1,257 @@
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestDetermineVirtualMachineResize(t *testing.T) {
+	availabilitySetId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/availabilitySets/set1"
+	proximityPlacementGroupId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/proximityPlacementGroups/group1"
+
+	testCases := []struct {
+		Name                  string
+		Existing              compute.VirtualMachine
+		HostSizes             []string
+		AvailabilitySetSizes  []string
+		Size                  string
+		ExpectError           bool
+		ExpectDeallocation    bool
+		ExpectAvailabilitySet bool
+	}{
+		{
+			Name:               "Available on the Host",
+			Existing:           compute.VirtualMachine{},
+			HostSizes:          []string{"Standard_F2", "Standard_F4"},
+			Size:               "Standard_F4",
+			ExpectDeallocation: false,
+		},
+		{
+			Name:               "Not Available on the Host",
+			Existing:           compute.VirtualMachine{},
+			HostSizes:          []string{"Standard_F2"},
+			Size:               "Standard_F4",
+			ExpectDeallocation: true,
+		},
+		{
+			Name: "Available on the Host within an Availability Set",
+			Existing: compute.VirtualMachine{
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					AvailabilitySet: &compute.SubResource{ID: utils.String(availabilitySetId)},
+				},
+			},
+			HostSizes:            []string{"Standard_F2", "Standard_F4"},
+			AvailabilitySetSizes: []string{"Standard_F2"},
+			Size:                 "Standard_F4",
+			ExpectDeallocation:   false,
+		},
+		{
+			Name: "Available within the Availability Set",
+			Existing: compute.VirtualMachine{
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					AvailabilitySet: &compute.SubResource{ID: utils.String(availabilitySetId)},
+				},
+			},
+			HostSizes:             []string{"Standard_F2"},
+			AvailabilitySetSizes:  []string{"Standard_F2", "Standard_F4"},
+			Size:                  "Standard_F4",
+			ExpectDeallocation:    true,
+			ExpectAvailabilitySet: true,
+		},
+		{
+			Name: "Not Available within the Availability Set",
+			Existing: compute.VirtualMachine{
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					AvailabilitySet: &compute.SubResource{ID: utils.String(availabilitySetId)},
+				},
+			},
+			HostSizes:             []string{"Standard_F2"},
+			AvailabilitySetSizes:  []string{"Standard_F2"},
+			Size:                  "Standard_F4",
+			ExpectError:           true,
+			ExpectAvailabilitySet: true,
+		},
+		{
+			Name: "Within a Proximity Placement Group",
+			Existing: compute.VirtualMachine{
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					ProximityPlacementGroup: &compute.SubResource{ID: utils.String(proximityPlacementGroupId)},
+				},
+			},
+			HostSizes:          []string{"Standard_F2"},
+			Size:               "Standard_F4",
+			ExpectDeallocation: true,
+		},
+	}
+
+	id := parse.NewVirtualMachineID("12345678-1234-9876-4563-123456789012", "group1", "machine1")
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Test %q..", v.Name)
+
+		availabilitySetRequested := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			names := v.HostSizes
+			if strings.Contains(strings.ToLower(r.URL.Path), "/availabilitysets/") {
+				availabilitySetRequested = true
+				names = v.AvailabilitySetSizes
+			}
+
+			sizes := make([]map[string]interface{}, 0)
+			for _, name := range names {
+				sizes = append(sizes, map[string]interface{}{"name": name})
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"value": sizes})
+		}))
+
+		vmClient := compute.NewVirtualMachinesClientWithBaseURI(server.URL, id.SubscriptionId)
+		availabilitySetsClient := compute.NewAvailabilitySetsClientWithBaseURI(server.URL, id.SubscriptionId)
+		computeClient := &client.Client{
+			AvailabilitySetsClient: &availabilitySetsClient,
+			VMClient:               &vmClient,
+		}
+
+		actual, err := determineVirtualMachineResize(context.TODO(), computeClient, id, v.Existing, v.Size)
+		server.Close()
+
+		if availabilitySetRequested != v.ExpectAvailabilitySet {
+			t.Fatalf("Expected the Availability Set sizes to be retrieved to be %t but got %t", v.ExpectAvailabilitySet, availabilitySetRequested)
+		}
+
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if actual.RequiresDeallocation != v.ExpectDeallocation {
+			t.Fatalf("Expected RequiresDeallocation to be %t but got %t", v.ExpectDeallocation, actual.RequiresDeallocation)
+		}
+
+		var expectedProximityPlacementGroupId *string
+		if props := v.Existing.VirtualMachineProperties; props != nil && props.ProximityPlacementGroup != nil {
+			expectedProximityPlacementGroupId = props.ProximityPlacementGroup.ID
+		}
+		if !reflect.DeepEqual(actual.ProximityPlacementGroupId, expectedProximityPlacementGroupId) {
+			t.Fatalf("Expected ProximityPlacementGroupId to be %+v but got %+v", expectedProximityPlacementGroupId, actual.ProximityPlacementGroupId)
+		}
+	}
+}
+
+func TestVirtualMachineSizeIsAvailable(t *testing.T) {
+	buildSizes := func(names ...string) compute.VirtualMachineSizeListResult {
+		sizes := make([]compute.VirtualMachineSize, 0)
+		for _, v := range names {
+			sizes = append(sizes, compute.VirtualMachineSize{
+				Name: utils.String(v),
+			})
+		}
+
+		return compute.VirtualMachineSizeListResult{
+			Value: &sizes,
+		}
+	}
+
+	testCases := []struct {
+		Name     string
+		Input    compute.VirtualMachineSizeListResult
+		Size     string
+		Expected bool
+	}{
+		{
+			Name:     "None",
+			Input:    compute.VirtualMachineSizeListResult{},
+			Size:     "Standard_F2",
+			Expected: false,
+		},
+		{
+			Name:     "Not Available",
+			Input:    buildSizes("Standard_F2", "Standard_F4"),
+			Size:     "Standard_F8",
+			Expected: false,
+		},
+		{
+			Name:     "Available",
+			Input:    buildSizes("Standard_F2", "Standard_F4"),
+			Size:     "Standard_F4",
+			Expected: true,
+		},
+		{
+			Name:     "Available with different casing",
+			Input:    buildSizes("Standard_F2", "Standard_F4"),
+			Size:     "standard_f4",
+			Expected: true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Test %q..", v.Name)
+
+		actual := virtualMachineSizeIsAvailable(v.Input, v.Size)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineHasEphemeralOSDisk(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    compute.VirtualMachine
+		Expected bool
+	}{
+		{
+			Name:     "Empty",
+			Input:    compute.VirtualMachine{},
+			Expected: false,
+		},
+		{
+			Name: "Managed OS Disk",
+			Input: compute.VirtualMachine{
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					StorageProfile: &compute.StorageProfile{
+						OsDisk: &compute.OSDisk{},
+					},
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Ephemeral OS Disk",
+			Input: compute.VirtualMachine{
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					StorageProfile: &compute.StorageProfile{
+						OsDisk: &compute.OSDisk{
+							DiffDiskSettings: &compute.DiffDiskSettings{
+								Option: compute.Local,
+							},
+						},
+					},
+				},
+			},
+			Expected: true,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Test %q..", v.Name)
+
+		actual := virtualMachineHasEphemeralOSDisk(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
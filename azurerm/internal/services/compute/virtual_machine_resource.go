@@ -651,7 +651,7 @@ func resourceVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := meta.(*clients.Client).Tags.Expand(t)
 	zones := azure.ExpandZones(d.Get("zones").([]interface{}))
 
 	osDisk, err := expandAzureRmVirtualMachineOsDisk(d)
@@ -916,7 +916,7 @@ func resourceVirtualMachineRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
@@ -939,6 +939,12 @@ func resourceVirtualMachineDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %s", name, resGroup, err)
 	}
 
+	// Data Disks are deleted using the `delete_data_disks_on_termination` field rather than the Features block
+	attachedResources, err := determineVirtualMachineAttachedResourcesToDelete(ctx, meta, virtualMachine, false, true)
+	if err != nil {
+		return fmt.Errorf("Error determining the resources attached to Virtual Machine %q (Resource Group %q) to delete: %+v", name, resGroup, err)
+	}
+
 	// @tombuildsstuff: sending `nil` here omits this value from being sent - which matches
 	// the previous behaviour - we're only splitting this out so it's clear why
 	var forceDeletion *bool = nil
@@ -1015,6 +1021,10 @@ func resourceVirtualMachineDelete(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if err := deleteVirtualMachineAttachedResources(ctx, meta, name, *attachedResources); err != nil {
+		return fmt.Errorf("Error deleting the resources attached to Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
 	return nil
 }
 
@@ -894,7 +894,7 @@ func resourceVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta int
 	properties := compute.VirtualMachineScaleSet{
 		Name:                             &name,
 		Location:                         &location,
-		Tags:                             tags.Expand(t),
+		Tags:                             meta.(*clients.Client).Tags.Expand(t),
 		Sku:                              sku,
 		VirtualMachineScaleSetProperties: &scaleSetProps,
 		Zones:                            zones,
@@ -1093,7 +1093,7 @@ func resourceVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/client"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -15,8 +19,8 @@ type virtualMachineScaleSetUpdateMetaData struct {
 	// is "automaticOSUpgrade" enable in the upgradeProfile block
 	AutomaticOSUpgradeIsEnabled bool
 
-	// can we roll instances if we need too? this is a feature toggle
-	CanRollInstancesWhenRequired bool
+	// can we roll instances if we need too (and how)? these are feature toggles
+	Features features.VirtualMachineScaleSetFeatures
 
 	// do we need to roll the instances in this scale set?
 	UpdateInstances bool
@@ -43,7 +47,7 @@ func (metadata virtualMachineScaleSetUpdateMetaData) performUpdate(ctx context.C
 
 	// if we update the SKU, we also need to subsequently roll the instances using the `UpdateInstances` API
 	if metadata.UpdateInstances {
-		userWantsToRollInstances := metadata.CanRollInstancesWhenRequired
+		userWantsToRollInstances := metadata.Features.RollInstancesWhenRequired
 		upgradeMode := metadata.Existing.VirtualMachineScaleSetProperties.UpgradePolicy.Mode
 
 		if userWantsToRollInstances {
@@ -119,7 +123,6 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForAutomati
 }
 
 func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUpgradePolicy(ctx context.Context) error {
-	client := metadata.Client.VMScaleSetClient
 	id := metadata.ID
 
 	log.Printf("[DEBUG] Rolling the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q)..", metadata.OSType, id.Name, id.ResourceGroup)
@@ -136,7 +139,7 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUp
 		props := instance.VirtualMachineScaleSetVMProperties
 		if props != nil && instance.InstanceID != nil {
 			latestModel := props.LatestModelApplied
-			if latestModel != nil || !*latestModel {
+			if latestModel == nil || !*latestModel {
 				instanceIdsToRoll = append(instanceIdsToRoll, *instance.InstanceID)
 			}
 		}
@@ -146,40 +149,160 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUp
 		}
 	}
 
-	// TODO: there's a performance enhancement to do batches here, but this is fine for a first pass
-	for _, instanceId := range instanceIdsToRoll {
-		instanceIds := []string{instanceId}
-
-		log.Printf("[DEBUG] Updating Instance %q to the Latest Configuration..", instanceId)
-		ids := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
-			InstanceIds: &instanceIds,
+	batches := batchVirtualMachineScaleSetInstanceIds(instanceIdsToRoll, metadata.Features.ManualUpgradeBatchSize)
+	for i, instanceIds := range batches {
+		log.Printf("[DEBUG] Rolling batch %d of %d (Instances %q)..", i+1, len(batches), strings.Join(instanceIds, ", "))
+		if err := metadata.upgradeInstancesBatch(ctx, instanceIds); err != nil {
+			return err
 		}
-		future, err := client.UpdateInstances(ctx, id.ResourceGroup, id.Name, ids)
-		if err != nil {
-			return fmt.Errorf("Error updating Instance %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+
+		if metadata.Features.ManualUpgradeWaitForHealthyInstances {
+			if err := metadata.waitForInstancesToBeHealthy(ctx, instanceIds); err != nil {
+				return err
+			}
 		}
+		log.Printf("[DEBUG] Rolled batch %d of %d (Instances %q).", i+1, len(batches), strings.Join(instanceIds, ", "))
+	}
+
+	log.Printf("[DEBUG] Rolled the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q).", metadata.OSType, id.Name, id.ResourceGroup)
+	return nil
+}
+
+func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesBatch(ctx context.Context, instanceIds []string) error {
+	client := metadata.Client.VMScaleSetClient
+	id := metadata.ID
+	instanceIdsStr := strings.Join(instanceIds, ", ")
+
+	log.Printf("[DEBUG] Updating Instances %q to the Latest Configuration..", instanceIdsStr)
+	ids := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIds: &instanceIds,
+	}
+	future, err := client.UpdateInstances(ctx, id.ResourceGroup, id.Name, ids)
+	if err != nil {
+		return fmt.Errorf("Error updating Instances %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceIdsStr, metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Instances %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceIdsStr, metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Updated Instances %q to the Latest Configuration.", instanceIdsStr)
+
+	if !metadata.Features.ManualUpgradeReimageInstances {
+		log.Printf("[DEBUG] Skipping reimaging Instances %q since this has been disabled in the Features block", instanceIdsStr)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Reimaging Instances %q..", instanceIdsStr)
+	reimageInput := &compute.VirtualMachineScaleSetReimageParameters{
+		InstanceIds: &instanceIds,
+	}
+	reimageFuture, err := client.Reimage(ctx, id.ResourceGroup, id.Name, reimageInput)
+	if err != nil {
+		return fmt.Errorf("Error reimaging Instances %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceIdsStr, metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+
+	if err = reimageFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for reimage of Instances %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceIdsStr, metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Reimaged Instances %q.", instanceIdsStr)
+
+	return nil
+}
 
-		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("Error waiting for update of Instance %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+// waitForInstancesToBeHealthy waits for the Health (as reported by the Application Health Extension or the
+// Load Balancer Health Probe) of the specified Instances, until the number of unhealthy instances within
+// the batch is within the `ManualUpgradeMaxUnhealthyInstancePercent` threshold
+func (metadata virtualMachineScaleSetUpdateMetaData) waitForInstancesToBeHealthy(ctx context.Context, instanceIds []string) error {
+	id := metadata.ID
+	instanceIdsStr := strings.Join(instanceIds, ", ")
+	maxUnhealthyInstances := maxUnhealthyVirtualMachineScaleSetInstances(len(instanceIds), metadata.Features.ManualUpgradeMaxUnhealthyInstancePercent)
+
+	timeout, _ := ctx.Deadline()
+	log.Printf("[DEBUG] Waiting for Instances %q (%s VM Scale Set %q / Resource Group %q) to become Healthy..", instanceIdsStr, metadata.OSType, id.Name, id.ResourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"Unhealthy"},
+		Target:                    []string{"Healthy"},
+		Refresh:                   metadata.instancesHealthRefreshFunc(ctx, instanceIds, maxUnhealthyInstances),
+		MinTimeout:                15 * time.Second,
+		ContinuousTargetOccurence: 2,
+		Timeout:                   time.Until(timeout),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("waiting for Instances %q (%s VM Scale Set %q / Resource Group %q) to become Healthy: %+v", instanceIdsStr, metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Instances %q (%s VM Scale Set %q / Resource Group %q) are Healthy.", instanceIdsStr, metadata.OSType, id.Name, id.ResourceGroup)
+	return nil
+}
+
+func (metadata virtualMachineScaleSetUpdateMetaData) instancesHealthRefreshFunc(ctx context.Context, instanceIds []string, maxUnhealthyInstances int) resource.StateRefreshFunc {
+	client := metadata.Client.VMScaleSetVMsClient
+	id := metadata.ID
+
+	return func() (interface{}, string, error) {
+		unhealthyInstanceIds := make([]string, 0)
+		for _, instanceId := range instanceIds {
+			instanceView, err := client.GetInstanceView(ctx, id.ResourceGroup, id.Name, instanceId)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving Instance View for Instance %q: %+v", instanceId, err)
+			}
+
+			if !virtualMachineScaleSetInstanceIsHealthy(instanceView) {
+				unhealthyInstanceIds = append(unhealthyInstanceIds, instanceId)
+			}
 		}
-		log.Printf("[DEBUG] Updated Instance %q to the Latest Configuration.", instanceId)
 
-		// TODO: does this want to be a separate, user-configurable toggle?
-		log.Printf("[DEBUG] Reimaging Instance %q..", instanceId)
-		reimageInput := &compute.VirtualMachineScaleSetReimageParameters{
-			InstanceIds: &instanceIds,
+		if len(unhealthyInstanceIds) > maxUnhealthyInstances {
+			log.Printf("[DEBUG] Instances %q are not yet Healthy (%d unhealthy instances are allowed)", strings.Join(unhealthyInstanceIds, ", "), maxUnhealthyInstances)
+			return unhealthyInstanceIds, "Unhealthy", nil
 		}
-		reimageFuture, err := client.Reimage(ctx, id.ResourceGroup, id.Name, reimageInput)
-		if err != nil {
-			return fmt.Errorf("Error reimaging Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+
+		return unhealthyInstanceIds, "Healthy", nil
+	}
+}
+
+// batchVirtualMachineScaleSetInstanceIds splits the Instance IDs into batches of (at most) the specified size
+func batchVirtualMachineScaleSetInstanceIds(instanceIds []string, batchSize int) [][]string {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	batches := make([][]string, 0)
+	for start := 0; start < len(instanceIds); start += batchSize {
+		end := start + batchSize
+		if end > len(instanceIds) {
+			end = len(instanceIds)
 		}
 
-		if err = reimageFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("Error waiting for reimage of Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+		batches = append(batches, instanceIds[start:end])
+	}
+	return batches
+}
+
+// maxUnhealthyVirtualMachineScaleSetInstances returns the number of instances within a batch which can be unhealthy
+// whilst still continuing to roll the Scale Set, which is rounded down
+func maxUnhealthyVirtualMachineScaleSetInstances(batchSize, maxUnhealthyInstancePercent int) int {
+	return batchSize * maxUnhealthyInstancePercent / 100
+}
+
+// virtualMachineScaleSetInstanceIsHealthy returns whether the Instance is Healthy - where an Instance which
+// has no Health Status (since neither the Application Health Extension or a Health Probe is configured)
+// is considered Healthy once it's Running
+func virtualMachineScaleSetInstanceIsHealthy(input compute.VirtualMachineScaleSetVMInstanceView) bool {
+	if input.VMHealth != nil && input.VMHealth.Status != nil && input.VMHealth.Status.Code != nil {
+		return strings.EqualFold(*input.VMHealth.Status.Code, "HealthState/healthy")
+	}
+
+	if input.Statuses == nil {
+		return false
+	}
+
+	for _, status := range *input.Statuses {
+		if status.Code != nil && strings.EqualFold(*status.Code, "PowerState/running") {
+			return true
 		}
-		log.Printf("[DEBUG] Reimaged Instance %q..", instanceId)
 	}
 
-	log.Printf("[DEBUG] Rolled the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q).", metadata.OSType, id.Name, id.ResourceGroup)
-	return nil
+	return false
 }
@@ -0,0 +1,148 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestBatchVirtualMachineScaleSetInstanceIds(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Input     []string
+		BatchSize int
+		Expected  [][]string
+	}{
+		{
+			Name:      "None",
+			Input:     []string{},
+			BatchSize: 2,
+			Expected:  [][]string{},
+		},
+		{
+			Name:      "One at a time",
+			Input:     []string{"0", "1", "2"},
+			BatchSize: 1,
+			Expected:  [][]string{{"0"}, {"1"}, {"2"}},
+		},
+		{
+			Name:      "Invalid Batch Size",
+			Input:     []string{"0", "1"},
+			BatchSize: 0,
+			Expected:  [][]string{{"0"}, {"1"}},
+		},
+		{
+			Name:      "Uneven Batches",
+			Input:     []string{"0", "1", "2", "3", "4"},
+			BatchSize: 2,
+			Expected:  [][]string{{"0", "1"}, {"2", "3"}, {"4"}},
+		},
+		{
+			Name:      "Batch Size larger than the number of Instances",
+			Input:     []string{"0", "1", "2"},
+			BatchSize: 10,
+			Expected:  [][]string{{"0", "1", "2"}},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Test %q..", v.Name)
+
+		actual := batchVirtualMachineScaleSetInstanceIds(v.Input, v.BatchSize)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestMaxUnhealthyVirtualMachineScaleSetInstances(t *testing.T) {
+	testCases := []struct {
+		BatchSize       int
+		MaxUnhealthyPct int
+		Expected        int
+	}{
+		{BatchSize: 1, MaxUnhealthyPct: 20, Expected: 0},
+		{BatchSize: 5, MaxUnhealthyPct: 20, Expected: 1},
+		{BatchSize: 9, MaxUnhealthyPct: 20, Expected: 1},
+		{BatchSize: 10, MaxUnhealthyPct: 0, Expected: 0},
+		{BatchSize: 10, MaxUnhealthyPct: 100, Expected: 10},
+	}
+
+	for _, v := range testCases {
+		actual := maxUnhealthyVirtualMachineScaleSetInstances(v.BatchSize, v.MaxUnhealthyPct)
+		if actual != v.Expected {
+			t.Fatalf("Expected %d but got %d for a Batch Size of %d with %d%%", v.Expected, actual, v.BatchSize, v.MaxUnhealthyPct)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetInstanceIsHealthy(t *testing.T) {
+	buildInstanceView := func(healthState *string, statuses ...string) compute.VirtualMachineScaleSetVMInstanceView {
+		results := make([]compute.InstanceViewStatus, 0)
+		for _, v := range statuses {
+			results = append(results, compute.InstanceViewStatus{
+				Code: utils.String(v),
+			})
+		}
+
+		output := compute.VirtualMachineScaleSetVMInstanceView{
+			Statuses: &results,
+		}
+		if healthState != nil {
+			output.VMHealth = &compute.VirtualMachineHealthStatus{
+				Status: &compute.InstanceViewStatus{
+					Code: healthState,
+				},
+			}
+		}
+		return output
+	}
+
+	testCases := []struct {
+		Name     string
+		Input    compute.VirtualMachineScaleSetVMInstanceView
+		Expected bool
+	}{
+		{
+			Name:     "Empty",
+			Input:    compute.VirtualMachineScaleSetVMInstanceView{},
+			Expected: false,
+		},
+		{
+			Name:     "No Health Status and Starting",
+			Input:    buildInstanceView(nil, "ProvisioningState/succeeded", "PowerState/starting"),
+			Expected: false,
+		},
+		{
+			Name:     "No Health Status and Running",
+			Input:    buildInstanceView(nil, "ProvisioningState/succeeded", "PowerState/running"),
+			Expected: true,
+		},
+		{
+			Name:     "Healthy",
+			Input:    buildInstanceView(utils.String("HealthState/healthy"), "PowerState/running"),
+			Expected: true,
+		},
+		{
+			Name:     "Unhealthy",
+			Input:    buildInstanceView(utils.String("HealthState/unhealthy"), "PowerState/running"),
+			Expected: false,
+		},
+		{
+			Name:     "Unknown",
+			Input:    buildInstanceView(utils.String("HealthState/unknown"), "PowerState/running"),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Test %q..", v.Name)
+
+		actual := virtualMachineScaleSetInstanceIsHealthy(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
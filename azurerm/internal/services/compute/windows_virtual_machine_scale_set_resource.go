@@ -54,7 +54,7 @@ func resourceWindowsVirtualMachineScaleSet() *schema.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
-			"location": azure.SchemaLocation(),
+			"location": azure.SchemaLocationForResourceType("Microsoft.Compute/virtualMachineScaleSets"),
 
 			// Required
 			"admin_username": {
@@ -517,7 +517,7 @@ func resourceWindowsVirtualMachineScaleSetCreate(d *schema.ResourceData, meta in
 		},
 		Identity: identity,
 		Plan:     plan,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
 			AdditionalCapabilities:                 additionalCapabilities,
 			AutomaticRepairsPolicy:                 automaticRepairsPolicy,
@@ -843,20 +843,20 @@ func resourceWindowsVirtualMachineScaleSetUpdate(d *schema.ResourceData, meta in
 		updateProps.VirtualMachineProfile.ExtensionProfile.ExtensionsTimeBudget = utils.String(d.Get("extensions_time_budget").(string))
 	}
 
-	if d.HasChange("tags") {
-		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		update.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	update.VirtualMachineScaleSetUpdateProperties = &updateProps
 
 	metaData := virtualMachineScaleSetUpdateMetaData{
-		AutomaticOSUpgradeIsEnabled:  automaticOSUpgradeIsEnabled,
-		CanRollInstancesWhenRequired: meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired,
-		UpdateInstances:              updateInstances,
-		Client:                       meta.(*clients.Client).Compute,
-		Existing:                     existing,
-		ID:                           id,
-		OSType:                       compute.Windows,
+		AutomaticOSUpgradeIsEnabled: automaticOSUpgradeIsEnabled,
+		Features:                    meta.(*clients.Client).Features.VirtualMachineScaleSet,
+		UpdateInstances:             updateInstances,
+		Client:                      meta.(*clients.Client).Compute,
+		Existing:                    existing,
+		ID:                          id,
+		OSType:                      compute.Windows,
 	}
 
 	if err := metaData.performUpdate(ctx, update); err != nil {
@@ -1078,7 +1078,7 @@ func resourceWindowsVirtualMachineScaleSetRead(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error setting `zones`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceWindowsVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 )
@@ -44,9 +45,32 @@ type authProvider struct {
 type configAzureAD struct {
 	APIServerID string `yaml:"apiserver-id,omitempty"`
 	ClientID    string `yaml:"client-id,omitempty"`
+	Environment string `yaml:"environment,omitempty"`
 	TenantID    string `yaml:"tenant-id,omitempty"`
 }
 
+type userItemExec struct {
+	Name string   `yaml:"name"`
+	User userExec `yaml:"user"`
+}
+
+type userExec struct {
+	Exec execConfig `yaml:"exec"`
+}
+
+type execConfig struct {
+	APIVersion  string       `yaml:"apiVersion"`
+	Command     string       `yaml:"command"`
+	Args        []string     `yaml:"args,omitempty"`
+	Env         []execEnvVar `yaml:"env,omitempty"`
+	InstallHint string       `yaml:"installHint,omitempty"`
+}
+
+type execEnvVar struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
 type contextItem struct {
 	Name    string  `yaml:"name"`
 	Context context `yaml:"context"`
@@ -77,6 +101,29 @@ type KubeConfigAAD struct {
 	Users          []userItemAAD `yaml:"users"`
 }
 
+type KubeConfigExec struct {
+	KubeConfigBase `yaml:",inline"`
+	Users          []userItemExec `yaml:"users"`
+}
+
+// KubeLoginMode is the login mode used by `kubelogin` to retrieve a token for the Kubernetes Cluster
+type KubeLoginMode string
+
+const (
+	// KubeLoginModeManagedIdentity logs in using the Managed Identity available where `kubelogin` is run - since
+	// no `--client-id` is specified this is either the System Assigned Identity or the only User Assigned Identity
+	KubeLoginModeManagedIdentity KubeLoginMode = "msi"
+
+	// KubeLoginModeServicePrincipal logs in using a Service Principal, where the credentials are read
+	// from the `AAD_SERVICE_PRINCIPAL_CLIENT_ID` and `AAD_SERVICE_PRINCIPAL_CLIENT_SECRET` Environment Variables
+	KubeLoginModeServicePrincipal KubeLoginMode = "spn"
+)
+
+const (
+	kubeLoginAPIVersion = "client.authentication.k8s.io/v1beta1"
+	kubeLoginCommand    = "kubelogin"
+)
+
 func ParseKubeConfig(config string) (*KubeConfig, error) {
 	if config == "" {
 		return nil, fmt.Errorf("Cannot parse empty config")
@@ -122,3 +169,135 @@ func ParseKubeConfigAAD(config string) (*KubeConfigAAD, error) {
 
 	return &kubeConfig, nil
 }
+
+func ParseKubeConfigExec(config string) (*KubeConfigExec, error) {
+	if config == "" {
+		return nil, fmt.Errorf("Cannot parse empty config")
+	}
+
+	var kubeConfig KubeConfigExec
+	if err := yaml.Unmarshal([]byte(config), &kubeConfig); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal YAML config with error %+v", err)
+	}
+	if len(kubeConfig.Clusters) == 0 || len(kubeConfig.Users) == 0 {
+		return nil, fmt.Errorf("Config %+v contains no valid clusters or users", kubeConfig)
+	}
+	u := kubeConfig.Users[0].User
+	if u.Exec.Command == "" {
+		return nil, fmt.Errorf("Config requires an exec command for user %+v", u)
+	}
+	c := kubeConfig.Clusters[0].Cluster
+	if c.Server == "" {
+		return nil, fmt.Errorf("Config has invalid or non existent server for cluster %+v", c)
+	}
+
+	return &kubeConfig, nil
+}
+
+// ConvertKubeConfigAADToExec converts an Azure Active Directory kubeconfig using the (deprecated) `azure`
+// auth-provider into one using `kubelogin` as an exec plugin, which can log in non-interactively
+func ConvertKubeConfigAADToExec(input KubeConfigAAD, environment string, loginMode KubeLoginMode) (*KubeConfigExec, error) {
+	users := make([]userItemExec, 0)
+	for _, v := range input.Users {
+		config := v.User.AuthProvider.Config
+		if config.APIServerID == "" {
+			return nil, fmt.Errorf("the auth-provider for user %q has no `apiserver-id`", v.Name)
+		}
+		if config.Environment != "" {
+			environment = config.Environment
+		}
+
+		users = append(users, userItemExec{
+			Name: v.Name,
+			User: userExec{
+				Exec: buildKubeLoginExecConfig(config.APIServerID, config.TenantID, environment, loginMode),
+			},
+		})
+	}
+
+	return &KubeConfigExec{
+		KubeConfigBase: input.KubeConfigBase,
+		Users:          users,
+	}, nil
+}
+
+// ConvertKubeConfigExecLoginMode updates the `kubelogin` exec plugins within the kubeconfig to use the specified
+// login mode (e.g. from the interactive `devicecode` mode), retaining the Server ID, Tenant ID and Environment
+func ConvertKubeConfigExecLoginMode(input KubeConfigExec, environment string, loginMode KubeLoginMode) (*KubeConfigExec, error) {
+	users := make([]userItemExec, 0)
+	for _, v := range input.Users {
+		if v.User.Exec.Command != kubeLoginCommand {
+			return nil, fmt.Errorf("the exec command for user %q is %q but only %q is supported", v.Name, v.User.Exec.Command, kubeLoginCommand)
+		}
+
+		serverId := kubeLoginArgValue(v.User.Exec.Args, "--server-id")
+		if serverId == "" {
+			return nil, fmt.Errorf("the exec arguments for user %q contain no `--server-id`", v.Name)
+		}
+		tenantId := kubeLoginArgValue(v.User.Exec.Args, "--tenant-id")
+		if env := kubeLoginArgValue(v.User.Exec.Args, "--environment"); env != "" {
+			environment = env
+		}
+
+		users = append(users, userItemExec{
+			Name: v.Name,
+			User: userExec{
+				Exec: buildKubeLoginExecConfig(serverId, tenantId, environment, loginMode),
+			},
+		})
+	}
+
+	return &KubeConfigExec{
+		KubeConfigBase: input.KubeConfigBase,
+		Users:          users,
+	}, nil
+}
+
+// MarshalKubeConfigExec returns the YAML representation of the exec-based kubeconfig
+func MarshalKubeConfigExec(input KubeConfigExec) (string, error) {
+	out, err := yaml.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("Failed to marshal YAML config with error %+v", err)
+	}
+
+	return string(out), nil
+}
+
+func buildKubeLoginExecConfig(serverId, tenantId, environment string, loginMode KubeLoginMode) execConfig {
+	args := []string{"get-token"}
+	if loginMode == KubeLoginModeServicePrincipal {
+		// the Client ID/Secret for the Service Principal are read from the Environment
+		if environment != "" {
+			args = append(args, "--environment", environment)
+		}
+		args = append(args, "--server-id", serverId)
+		if tenantId != "" {
+			args = append(args, "--tenant-id", tenantId)
+		}
+	} else {
+		args = append(args, "--server-id", serverId)
+	}
+	args = append(args, "--login", string(loginMode))
+
+	return execConfig{
+		APIVersion: kubeLoginAPIVersion,
+		Command:    kubeLoginCommand,
+		Args:       args,
+	}
+}
+
+// kubeLoginArgValue returns the value for the specified flag within the arguments, supporting
+// both the `--flag value` and `--flag=value` forms
+func kubeLoginArgValue(args []string, flag string) string {
+	for i, v := range args {
+		if v == flag && i+1 < len(args) {
+			return args[i+1]
+		}
+
+		if strings.HasPrefix(v, flag+"=") {
+			return strings.TrimPrefix(v, flag+"=")
+		}
+	}
+
+	return ""
+}
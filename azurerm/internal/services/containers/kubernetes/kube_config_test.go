@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -192,3 +193,197 @@ func LoadConfig(fileName string) string {
 
 	return string(bytes)
 }
+
+func TestParseKubeConfigExec(t *testing.T) {
+	testCases := []struct {
+		sourceFile string
+		expected   *KubeConfigExec
+	}{
+		{
+			"user_with_exec.yml",
+			&KubeConfigExec{
+				KubeConfigBase: KubeConfigBase{
+					APIVersion: "v1",
+					Clusters: []clusterItem{
+						{
+							Name: "test-cluster",
+							Cluster: cluster{
+								ClusterAuthorityData: "test-cluster-authority-data",
+								Server:               "https://testcluster.org:443",
+							},
+						},
+					},
+					Contexts: []contextItem{
+						{
+							Name: "test-cluster",
+							Context: context{
+								Cluster: "test-cluster",
+								User:    "test-user",
+							},
+						},
+					},
+					CurrentContext: "test-cluster",
+					Kind:           "Config",
+				},
+				Users: []userItemExec{
+					{
+						Name: "test-user",
+						User: userExec{
+							Exec: execConfig{
+								APIVersion: "client.authentication.k8s.io/v1beta1",
+								Command:    "kubelogin",
+								Args: []string{
+									"get-token",
+									"--environment", "AzurePublicCloud",
+									"--server-id", "6dae42f8-4368-4678-94ff-3960e28e3630",
+									"--client-id", "80faf920-1908-4b52-b5ef-a8e7bedfc67a",
+									"--tenant-id", "00000000-0000-0000-0000-000000000000",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"user_with_exec_no_command.yml",
+			nil,
+		},
+		{
+			"user_with_token.yml",
+			nil,
+		},
+		{
+			"no_user.yml",
+			nil,
+		},
+		{
+			"cluster_with_no_server.yml",
+			nil,
+		},
+	}
+
+	for i, test := range testCases {
+		encodedConfig := LoadConfig(test.sourceFile)
+		if len(encodedConfig) == 0 {
+			t.Fatalf("Test case [%d]: Failed to read config from file '%+v' \n",
+				i, test.sourceFile)
+		}
+
+		result, err := ParseKubeConfigExec(encodedConfig)
+		if test.expected == nil {
+			if err == nil {
+				t.Fatalf("Test case [%d]: expected config '%+v' to throw error but didn't", i, test.sourceFile)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Test case [%d]: Failed, config '%+v' with error: '%+v'", i, test.sourceFile, err)
+		}
+
+		if !reflect.DeepEqual(*test.expected, *result) {
+			t.Fatalf("Test case [%d]: expected '%+v' but got '%+v' for config '%+v'", i, *test.expected, *result, test.sourceFile)
+		}
+	}
+}
+
+func TestConvertKubeConfigToExec(t *testing.T) {
+	testCases := []struct {
+		sourceFile string
+		loginMode  KubeLoginMode
+		expected   []string
+	}{
+		{
+			"user_with_exec.yml",
+			KubeLoginModeServicePrincipal,
+			[]string{
+				"get-token",
+				"--environment", "AzurePublicCloud",
+				"--server-id", "6dae42f8-4368-4678-94ff-3960e28e3630",
+				"--tenant-id", "00000000-0000-0000-0000-000000000000",
+				"--login", "spn",
+			},
+		},
+		{
+			"user_with_exec.yml",
+			KubeLoginModeManagedIdentity,
+			[]string{
+				"get-token",
+				"--server-id", "6dae42f8-4368-4678-94ff-3960e28e3630",
+				"--login", "msi",
+			},
+		},
+		{
+			"user_with_auth_provider.yml",
+			KubeLoginModeServicePrincipal,
+			[]string{
+				"get-token",
+				"--environment", "AzurePublicCloud",
+				"--server-id", "6dae42f8-4368-4678-94ff-3960e28e3630",
+				"--tenant-id", "00000000-0000-0000-0000-000000000000",
+				"--login", "spn",
+			},
+		},
+		{
+			"user_with_auth_provider.yml",
+			KubeLoginModeManagedIdentity,
+			[]string{
+				"get-token",
+				"--server-id", "6dae42f8-4368-4678-94ff-3960e28e3630",
+				"--login", "msi",
+			},
+		},
+	}
+
+	for i, test := range testCases {
+		encodedConfig := LoadConfig(test.sourceFile)
+		if len(encodedConfig) == 0 {
+			t.Fatalf("Test case [%d]: Failed to read config from file '%+v' \n",
+				i, test.sourceFile)
+		}
+
+		var result *KubeConfigExec
+		if strings.Contains(encodedConfig, "exec:") {
+			config, err := ParseKubeConfigExec(encodedConfig)
+			if err != nil {
+				t.Fatalf("Test case [%d]: Failed, config '%+v' with error: '%+v'", i, test.sourceFile, err)
+			}
+
+			result, err = ConvertKubeConfigExecLoginMode(*config, "AzureChinaCloud", test.loginMode)
+			if err != nil {
+				t.Fatalf("Test case [%d]: Failed to convert config '%+v' with error: '%+v'", i, test.sourceFile, err)
+			}
+		} else {
+			config, err := ParseKubeConfigAAD(encodedConfig)
+			if err != nil {
+				t.Fatalf("Test case [%d]: Failed, config '%+v' with error: '%+v'", i, test.sourceFile, err)
+			}
+
+			result, err = ConvertKubeConfigAADToExec(*config, "AzureChinaCloud", test.loginMode)
+			if err != nil {
+				t.Fatalf("Test case [%d]: Failed to convert config '%+v' with error: '%+v'", i, test.sourceFile, err)
+			}
+		}
+
+		exec := result.Users[0].User.Exec
+		if exec.Command != "kubelogin" || exec.APIVersion != "client.authentication.k8s.io/v1beta1" {
+			t.Fatalf("Test case [%d]: expected a kubelogin exec plugin but got '%+v'", i, exec)
+		}
+		if !reflect.DeepEqual(test.expected, exec.Args) {
+			t.Fatalf("Test case [%d]: expected the args '%+v' but got '%+v'", i, test.expected, exec.Args)
+		}
+
+		// the converted config should round-trip
+		raw, err := MarshalKubeConfigExec(*result)
+		if err != nil {
+			t.Fatalf("Test case [%d]: Failed to marshal config '%+v' with error: '%+v'", i, test.sourceFile, err)
+		}
+		parsed, err := ParseKubeConfigExec(raw)
+		if err != nil {
+			t.Fatalf("Test case [%d]: Failed to parse the marshalled config '%+v' with error: '%+v'", i, raw, err)
+		}
+		if !reflect.DeepEqual(*result, *parsed) {
+			t.Fatalf("Test case [%d]: expected '%+v' but got '%+v' after marshalling", i, *result, *parsed)
+		}
+	}
+}
@@ -46,6 +46,7 @@ func testAccKubernetesCluster_apiServerAuthorizedIPRanges(t *testing.T) {
 				check.That(data.ResourceName).Key("kube_config.0.password").Exists(),
 				check.That(data.ResourceName).Key("kube_admin_config.#").HasValue("0"),
 				check.That(data.ResourceName).Key("kube_admin_config_raw").HasValue(""),
+				check.That(data.ResourceName).Key("kube_config_exec.#").HasValue("0"),
 				check.That(data.ResourceName).Key("default_node_pool.0.max_pods").Exists(),
 				check.That(data.ResourceName).Key("api_server_authorized_ip_ranges.#").HasValue("3"),
 			),
@@ -238,6 +239,9 @@ func testAccKubernetesCluster_roleBasedAccessControlAADManaged(t *testing.T) {
 				check.That(data.ResourceName).Key("role_based_access_control.0.azure_active_directory.0.managed").Exists(),
 				check.That(data.ResourceName).Key("kube_admin_config.#").HasValue("1"),
 				check.That(data.ResourceName).Key("kube_admin_config_raw").Exists(),
+				check.That(data.ResourceName).Key("kube_config_exec.#").HasValue("1"),
+				check.That(data.ResourceName).Key("kube_config_exec.0.command").HasValue("kubelogin"),
+				check.That(data.ResourceName).Key("kube_config_exec_raw").Exists(),
 			),
 		},
 		data.ImportStep(
@@ -371,6 +371,16 @@ func dataSourceKubernetesCluster() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"kube_config_exec_login_mode": schemaKubernetesClusterKubeConfigExecLoginMode(),
+
+			"kube_config_exec": schemaKubernetesClusterKubeConfigExec(),
+
+			"kube_config_exec_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			"kubelet_identity": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -670,6 +680,13 @@ func dataSourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error setting `kube_config`: %+v", err)
 	}
 
+	environment := meta.(*clients.Client).Containers.Environment.Name
+	kubeConfigExecRaw, kubeConfigExec := flattenKubernetesClusterKubeConfigExecFromAccessProfile(profile, environment, d.Get("kube_config_exec_login_mode").(string))
+	d.Set("kube_config_exec_raw", kubeConfigExecRaw)
+	if err := d.Set("kube_config_exec", kubeConfigExec); err != nil {
+		return fmt.Errorf("Error setting `kube_config_exec`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -729,7 +746,14 @@ func flattenKubernetesClusterDataSourceAccessProfile(profile containerservice.Ma
 		rawConfig := string(*kubeConfigRaw)
 		var flattenedKubeConfig []interface{}
 
-		if strings.Contains(rawConfig, "apiserver-id:") {
+		if strings.Contains(rawConfig, "exec:") {
+			kubeConfigExec, err := kubernetes.ParseKubeConfigExec(rawConfig)
+			if err != nil {
+				return utils.String(rawConfig), []interface{}{}
+			}
+
+			flattenedKubeConfig = flattenKubernetesClusterDataSourceKubeConfigExec(*kubeConfigExec)
+		} else if strings.Contains(rawConfig, "apiserver-id:") {
 			kubeConfigAAD, err := kubernetes.ParseKubeConfigAAD(rawConfig)
 			if err != nil {
 				return utils.String(rawConfig), []interface{}{}
@@ -1120,6 +1144,24 @@ func flattenKubernetesClusterDataSourceKubeConfigAAD(config kubernetes.KubeConfi
 	return []interface{}{values}
 }
 
+func flattenKubernetesClusterDataSourceKubeConfigExec(config kubernetes.KubeConfigExec) []interface{} {
+	values := make(map[string]interface{})
+
+	cluster := config.Clusters[0].Cluster
+	name := config.Users[0].Name
+
+	values["host"] = cluster.Server
+	values["username"] = name
+
+	values["password"] = ""
+	values["client_certificate"] = ""
+	values["client_key"] = ""
+
+	values["cluster_ca_certificate"] = cluster.ClusterAuthorityData
+
+	return []interface{}{values}
+}
+
 func flattenKubernetesClusterDataSourceManagedClusterIdentity(input *containerservice.ManagedClusterIdentity) ([]interface{}, error) {
 	// if it's none, omit the block
 	if input == nil || input.Type == containerservice.ResourceIdentityTypeNone {
@@ -226,6 +226,10 @@ func resourceKubernetesClusterNodePool() *schema.Resource {
 			},
 
 			"upgrade_settings": upgradeSettingsSchema(),
+
+			"kubelet_config": schemaNodePoolKubeletConfig(),
+
+			"linux_os_config": schemaNodePoolLinuxOSConfig(),
 		},
 	}
 }
@@ -300,11 +304,12 @@ func resourceKubernetesClusterNodePoolCreate(d *schema.ResourceData, meta interf
 		EnableNodePublicIP:     utils.Bool(d.Get("enable_node_public_ip").(bool)),
 		Mode:                   mode,
 		ScaleSetPriority:       containerservice.ScaleSetPriority(priority),
-		Tags:                   tags.Expand(t),
+		Tags:                   meta.(*clients.Client).Tags.Expand(t),
 		Type:                   containerservice.VirtualMachineScaleSets,
 		VMSize:                 containerservice.VMSizeTypes(vmSize),
 		EnableEncryptionAtHost: utils.Bool(enableHostEncryption),
 		UpgradeSettings:        expandUpgradeSettings(d.Get("upgrade_settings").([]interface{})),
+		KubeletConfig:          expandAgentPoolKubeletConfig(d, "kubelet_config"),
 
 		// this must always be sent during creation, but is optional for auto-scaled clusters during update
 		Count: utils.Int32(int32(count)),
@@ -332,6 +337,16 @@ func resourceKubernetesClusterNodePoolCreate(d *schema.ResourceData, meta interf
 		profile.OrchestratorVersion = utils.String(orchestratorVersion)
 	}
 
+	linuxOSConfigRaw := d.Get("linux_os_config").([]interface{})
+	if len(linuxOSConfigRaw) > 0 && osType != string(containerservice.Linux) {
+		return fmt.Errorf("`linux_os_config` can only be configured when `os_type` is set to `Linux`")
+	}
+	linuxOSConfig, err := expandAgentPoolLinuxOSConfig(d, "linux_os_config")
+	if err != nil {
+		return fmt.Errorf("expanding `linux_os_config`: %+v", err)
+	}
+	profile.LinuxOSConfig = linuxOSConfig
+
 	availabilityZonesRaw := d.Get("availability_zones").([]interface{})
 	if availabilityZones := utils.ExpandStringSlice(availabilityZonesRaw); len(*availabilityZones) > 0 {
 		profile.AvailabilityZones = availabilityZones
@@ -515,9 +530,9 @@ func resourceKubernetesClusterNodePoolUpdate(d *schema.ResourceData, meta interf
 		props.OrchestratorVersion = utils.String(orchestratorVersion)
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		t := d.Get("tags").(map[string]interface{})
-		props.Tags = tags.Expand(t)
+		props.Tags = meta.(*clients.Client).Tags.Expand(t)
 	}
 
 	if d.HasChange("upgrade_settings") {
@@ -693,9 +708,21 @@ func resourceKubernetesClusterNodePoolRead(d *schema.ResourceData, meta interfac
 		if err := d.Set("upgrade_settings", flattenUpgradeSettings(props.UpgradeSettings)); err != nil {
 			return fmt.Errorf("setting `upgrade_settings`: %+v", err)
 		}
+
+		if err := d.Set("kubelet_config", flattenAgentPoolKubeletConfig(props.KubeletConfig)); err != nil {
+			return fmt.Errorf("setting `kubelet_config`: %+v", err)
+		}
+
+		linuxOSConfig, err := flattenAgentPoolLinuxOSConfig(props.LinuxOSConfig)
+		if err != nil {
+			return fmt.Errorf("flattening `linux_os_config`: %+v", err)
+		}
+		if err := d.Set("linux_os_config", linuxOSConfig); err != nil {
+			return fmt.Errorf("setting `linux_os_config`: %+v", err)
+		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceKubernetesClusterNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
@@ -49,6 +49,7 @@ var kubernetesNodePoolTests = map[string]func(t *testing.T){
 	"windowsAndLinux":                testAccKubernetesClusterNodePool_windowsAndLinux,
 	"zeroSize":                       testAccKubernetesClusterNodePool_zeroSize,
 	"hostEncryption":                 testAccKubernetesClusterNodePool_hostEncryption,
+	"kubeletAndLinuxOSConfig":        testAccKubernetesClusterNodePool_kubeletAndLinuxOSConfig,
 }
 
 func TestAccKubernetesClusterNodePool_autoScale(t *testing.T) {
@@ -636,6 +637,31 @@ func testAccKubernetesClusterNodePool_upgradeSettings(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesClusterNodePool_kubeletAndLinuxOSConfig(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesClusterNodePool_kubeletAndLinuxOSConfig(t)
+}
+
+func testAccKubernetesClusterNodePool_kubeletAndLinuxOSConfig(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.kubeletAndLinuxOSConfig(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("kubelet_config.#").HasValue("1"),
+				check.That(data.ResourceName).Key("kubelet_config.0.cpu_manager_policy").HasValue("static"),
+				check.That(data.ResourceName).Key("linux_os_config.#").HasValue("1"),
+				check.That(data.ResourceName).Key("linux_os_config.0.sysctl_config.0.net_ipv4_ip_local_port_range_min").HasValue("1024"),
+				check.That(data.ResourceName).Key("linux_os_config.0.sysctl_config.0.net_ipv4_ip_local_port_range_max").HasValue("32768"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKubernetesClusterNodePool_virtualNetworkAutomatic(t *testing.T) {
 	checkIfShouldRunTestsIndividually(t)
 	testAccKubernetesClusterNodePool_virtualNetworkAutomatic(t)
@@ -1682,3 +1708,71 @@ resource "azurerm_kubernetes_cluster_node_pool" "test" {
 }
 `, r.templateConfig(data))
 }
+
+func (r KubernetesClusterNodePoolResource) kubeletAndLinuxOSConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  vm_size               = "Standard_DS2_v2"
+  node_count            = 1
+
+  kubelet_config {
+    cpu_manager_policy        = "static"
+    cpu_cfs_quota_enabled     = true
+    cpu_cfs_quota_period      = "10ms"
+    image_gc_high_threshold   = 90
+    image_gc_low_threshold    = 70
+    topology_manager_policy   = "best-effort"
+    allowed_unsafe_sysctls    = ["kernel.msg*", "net.core.somaxconn"]
+    container_log_max_size_mb = 100
+    container_log_max_files   = 10
+    pod_max_pid               = 12345
+  }
+
+  linux_os_config {
+    transparent_huge_page_enabled = "always"
+    transparent_huge_page_defrag  = "always"
+    swap_file_size_mb             = 300
+
+    sysctl_config {
+      fs_aio_max_nr                      = 65536
+      fs_file_max                        = 100000
+      fs_inotify_max_user_watches        = 1000000
+      fs_nr_open                         = 1048576
+      kernel_threads_max                 = 200000
+      net_core_netdev_max_backlog        = 1800
+      net_core_optmem_max                = 30000
+      net_core_rmem_default              = 300000
+      net_core_rmem_max                  = 300000
+      net_core_somaxconn                 = 5000
+      net_core_wmem_default              = 300000
+      net_core_wmem_max                  = 300000
+      net_ipv4_ip_local_port_range_min   = 1024
+      net_ipv4_ip_local_port_range_max   = 32768
+      net_ipv4_neigh_default_gc_thresh1  = 128
+      net_ipv4_neigh_default_gc_thresh2  = 512
+      net_ipv4_neigh_default_gc_thresh3  = 1024
+      net_ipv4_tcp_fin_timeout           = 60
+      net_ipv4_tcp_keepalive_probes      = 9
+      net_ipv4_tcp_keepalive_time        = 6000
+      net_ipv4_tcp_max_syn_backlog       = 2048
+      net_ipv4_tcp_max_tw_buckets        = 100000
+      net_ipv4_tcp_tw_reuse              = true
+      net_ipv4_tcp_keepalive_intvl       = 70
+      net_netfilter_nf_conntrack_buckets = 65536
+      net_netfilter_nf_conntrack_max     = 200000
+      vm_max_map_count                   = 65530
+      vm_swappiness                      = 45
+      vm_vfs_cache_pressure              = 80
+    }
+  }
+}
+`, r.templateConfig(data))
+}
@@ -29,6 +29,8 @@ var kubernetesOtherTests = map[string]func(t *testing.T){
 	"privateClusterPrivateDNSSystem": testAccKubernetesCluster_privateClusterOnWithPrivateDNSZoneSystem,
 	"privateClusterPrivateDNSAndSP":  testAccKubernetesCluster_privateClusterOnWithPrivateDNSZoneAndServicePrincipal,
 	"upgradeChannel":                 testAccKubernetesCluster_upgradeChannel,
+	"maintenanceWindow":              testAccKubernetesCluster_maintenanceWindow,
+	"kubeletAndLinuxOSConfig":        testAccKubernetesCluster_kubeletAndLinuxOSConfig,
 }
 
 func TestAccKubernetesCluster_basicAvailabilitySet(t *testing.T) {
@@ -460,6 +462,70 @@ func testAccKubernetesCluster_upgradeChannel(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCluster_maintenanceWindow(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesCluster_maintenanceWindow(t)
+}
+
+func testAccKubernetesCluster_maintenanceWindow(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.maintenanceWindowConfig(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_window.#").HasValue("1"),
+				check.That(data.ResourceName).Key("maintenance_window.0.allowed.#").HasValue("1"),
+				check.That(data.ResourceName).Key("maintenance_window.0.not_allowed.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.maintenanceWindowCompleteConfig(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_window.#").HasValue("1"),
+				check.That(data.ResourceName).Key("maintenance_window.0.allowed.#").HasValue("2"),
+				check.That(data.ResourceName).Key("maintenance_window.0.not_allowed.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.upgradeChannelConfig(data, olderKubernetesVersion, ""),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maintenance_window.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesCluster_kubeletAndLinuxOSConfig(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesCluster_kubeletAndLinuxOSConfig(t)
+}
+
+func testAccKubernetesCluster_kubeletAndLinuxOSConfig(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.kubeletAndLinuxOSConfig(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_node_pool.0.kubelet_config.#").HasValue("1"),
+				check.That(data.ResourceName).Key("default_node_pool.0.linux_os_config.#").HasValue("1"),
+				check.That(data.ResourceName).Key("default_node_pool.0.linux_os_config.0.sysctl_config.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (KubernetesClusterResource) basicAvailabilitySetConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -1267,3 +1333,161 @@ resource "azurerm_kubernetes_cluster" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, controlPlaneVersion, upgradeChannel)
 }
+
+func (KubernetesClusterResource) maintenanceWindowConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+  kubernetes_version  = %q
+
+  default_node_pool {
+    name       = "default"
+    vm_size    = "Standard_DS2_v2"
+    node_count = 1
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  maintenance_window {
+    allowed {
+      day   = "Monday"
+      hours = [1, 2]
+    }
+
+    not_allowed {
+      start = "2021-11-26T03:00:00Z"
+      end   = "2021-11-30T12:00:00Z"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, olderKubernetesVersion)
+}
+
+func (KubernetesClusterResource) maintenanceWindowCompleteConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+  kubernetes_version  = %q
+
+  default_node_pool {
+    name       = "default"
+    vm_size    = "Standard_DS2_v2"
+    node_count = 1
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  maintenance_window {
+    allowed {
+      day   = "Monday"
+      hours = [1, 2]
+    }
+
+    allowed {
+      day   = "Saturday"
+      hours = [0, 1, 2, 3, 4, 5]
+    }
+
+    not_allowed {
+      start = "2021-11-26T03:00:00Z"
+      end   = "2021-11-30T12:00:00Z"
+    }
+
+    not_allowed {
+      start = "2021-12-24T00:00:00Z"
+      end   = "2021-12-27T00:00:00Z"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, olderKubernetesVersion)
+}
+
+func (KubernetesClusterResource) kubeletAndLinuxOSConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name       = "default"
+    vm_size    = "Standard_DS2_v2"
+    node_count = 1
+
+    kubelet_config {
+      cpu_manager_policy        = "static"
+      cpu_cfs_quota_enabled     = true
+      cpu_cfs_quota_period      = "10ms"
+      image_gc_high_threshold   = 90
+      image_gc_low_threshold    = 70
+      topology_manager_policy   = "best-effort"
+      allowed_unsafe_sysctls    = ["kernel.msg*", "net.core.somaxconn"]
+      container_log_max_size_mb = 100
+      container_log_max_files   = 10
+      pod_max_pid               = 12345
+    }
+
+    linux_os_config {
+      transparent_huge_page_enabled = "always"
+      transparent_huge_page_defrag  = "always"
+      swap_file_size_mb             = 300
+
+      sysctl_config {
+        fs_aio_max_nr                    = 65536
+        fs_file_max                      = 100000
+        fs_inotify_max_user_watches      = 1000000
+        kernel_threads_max               = 200000
+        net_core_somaxconn               = 5000
+        net_ipv4_ip_local_port_range_max = 32768
+        net_ipv4_ip_local_port_range_min = 1024
+        net_ipv4_tcp_tw_reuse            = true
+        vm_max_map_count                 = 70000
+        vm_swappiness                    = 20
+      }
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
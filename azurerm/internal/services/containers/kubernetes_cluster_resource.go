@@ -47,6 +47,13 @@ func resourceKubernetesCluster() *schema.Resource {
 			customdiff.ForceNewIfChange("sku_tier", func(old, new, meta interface{}) bool {
 				return new == "Free"
 			}),
+			// the `kube_config_exec` blocks are generated using the `kube_config_exec_login_mode` - so need to be recomputed when this changes
+			customdiff.ComputedIf("kube_config_exec", func(d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("kube_config_exec_login_mode")
+			}),
+			customdiff.ComputedIf("kube_config_exec_raw", func(d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("kube_config_exec_login_mode")
+			}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -64,7 +71,7 @@ func resourceKubernetesCluster() *schema.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
-			"location": azure.SchemaLocation(),
+			"location": azure.SchemaLocationForResourceType("Microsoft.ContainerService/managedClusters"),
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
@@ -616,6 +623,8 @@ func resourceKubernetesCluster() *schema.Resource {
 				}, false),
 			},
 
+			"maintenance_window": schemaKubernetesClusterMaintenanceWindow(),
+
 			// Computed
 			"fqdn": {
 				Type:     schema.TypeString,
@@ -707,6 +716,16 @@ func resourceKubernetesCluster() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+
+			"kube_config_exec_login_mode": schemaKubernetesClusterKubeConfigExecLoginMode(),
+
+			"kube_config_exec": schemaKubernetesClusterKubeConfigExec(),
+
+			"kube_config_exec_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
@@ -821,7 +840,7 @@ func resourceKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) e
 			NetworkProfile:         networkProfile,
 			NodeResourceGroup:      utils.String(nodeResourceGroup),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if v := d.Get("automatic_channel_upgrade").(string); v != "" {
@@ -885,6 +904,17 @@ func resourceKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(*read.ID)
 
+	if v := d.Get("maintenance_window").([]interface{}); len(v) > 0 {
+		id, err := parse.ClusterID(*read.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := updateKubernetesClusterMaintenanceWindow(ctx, meta.(*clients.Client).Containers, *id, v); err != nil {
+			return err
+		}
+	}
+
 	return resourceKubernetesClusterRead(d, meta)
 }
 
@@ -1107,10 +1137,10 @@ func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) e
 		existing.ManagedClusterProperties.NetworkProfile.LoadBalancerProfile = &loadBalancerProfile
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		updateCluster = true
 		t := d.Get("tags").(map[string]interface{})
-		existing.Tags = tags.Expand(t)
+		existing.Tags = meta.(*clients.Client).Tags.Expand(t)
 	}
 
 	if d.HasChange("windows_profile") {
@@ -1158,6 +1188,12 @@ func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) e
 		log.Printf("[DEBUG] Updated the Kubernetes Cluster %q (Resource Group %q)..", id.ManagedClusterName, id.ResourceGroup)
 	}
 
+	if d.HasChange("maintenance_window") {
+		if err := updateKubernetesClusterMaintenanceWindow(ctx, containersClient, *id, d.Get("maintenance_window").([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	// then roll the version of Kubernetes if necessary
 	if d.HasChange("kubernetes_version") {
 		existing, err = clusterClient.Get(ctx, id.ResourceGroup, id.ManagedClusterName)
@@ -1221,6 +1257,7 @@ func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Containers.KubernetesClustersClient
+	maintenanceClient := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -1245,6 +1282,14 @@ func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("retrieving Access Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
 	}
 
+	maintenanceConfiguration, err := maintenanceClient.Get(ctx, id.ResourceGroup, id.ManagedClusterName, kubernetesClusterMaintenanceConfigurationName)
+	if err != nil && !utils.ResponseWasNotFound(maintenanceConfiguration.Response) {
+		return fmt.Errorf("retrieving Maintenance Window for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+	}
+	if err := d.Set("maintenance_window", flattenKubernetesClusterMaintenanceWindow(maintenanceConfiguration.MaintenanceConfigurationProperties)); err != nil {
+		return fmt.Errorf("setting `maintenance_window`: %+v", err)
+	}
+
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
 	if location := resp.Location; location != nil {
@@ -1368,7 +1413,14 @@ func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `kube_config`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	environment := meta.(*clients.Client).Containers.Environment.Name
+	kubeConfigExecRaw, kubeConfigExec := flattenKubernetesClusterKubeConfigExecFromAccessProfile(profile, environment, d.Get("kube_config_exec_login_mode").(string))
+	d.Set("kube_config_exec_raw", kubeConfigExecRaw)
+	if err := d.Set("kube_config_exec", kubeConfigExec); err != nil {
+		return fmt.Errorf("setting `kube_config_exec`: %+v", err)
+	}
+
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
@@ -1399,7 +1451,14 @@ func flattenKubernetesClusterAccessProfile(profile containerservice.ManagedClust
 			rawConfig := string(*kubeConfigRaw)
 			var flattenedKubeConfig []interface{}
 
-			if strings.Contains(rawConfig, "apiserver-id:") {
+			if strings.Contains(rawConfig, "exec:") {
+				kubeConfigExec, err := kubernetes.ParseKubeConfigExec(rawConfig)
+				if err != nil {
+					return utils.String(rawConfig), []interface{}{}
+				}
+
+				flattenedKubeConfig = flattenKubernetesClusterKubeConfigFromExec(*kubeConfigExec)
+			} else if strings.Contains(rawConfig, "apiserver-id:") {
 				kubeConfigAAD, err := kubernetes.ParseKubeConfigAAD(rawConfig)
 				if err != nil {
 					return utils.String(rawConfig), []interface{}{}
@@ -1989,6 +2048,23 @@ func flattenKubernetesClusterKubeConfigAAD(config kubernetes.KubeConfigAAD) []in
 	}
 }
 
+func flattenKubernetesClusterKubeConfigFromExec(config kubernetes.KubeConfigExec) []interface{} {
+	// we don't size-check these since they're validated in the Parse method
+	cluster := config.Clusters[0].Cluster
+	name := config.Users[0].Name
+
+	return []interface{}{
+		map[string]interface{}{
+			"client_certificate":     "",
+			"client_key":             "",
+			"cluster_ca_certificate": cluster.ClusterAuthorityData,
+			"host":                   cluster.Server,
+			"password":               "",
+			"username":               name,
+		},
+	}
+}
+
 func flattenKubernetesClusterManagedClusterIdentity(input *containerservice.ManagedClusterIdentity) ([]interface{}, error) {
 	// if it's none, omit the block
 	if input == nil || input.Type == containerservice.ResourceIdentityTypeNone {
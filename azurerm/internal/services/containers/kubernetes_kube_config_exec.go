@@ -0,0 +1,133 @@
+package containers
+
+import (
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-12-01/containerservice"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/kubernetes"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func schemaKubernetesClusterKubeConfigExecLoginMode() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(kubernetes.KubeLoginModeManagedIdentity),
+			string(kubernetes.KubeLoginModeServicePrincipal),
+		}, false),
+	}
+}
+
+func schemaKubernetesClusterKubeConfigExec() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"username": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"cluster_ca_certificate": {
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+				"api_version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"command": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"args": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenKubernetesClusterKubeConfigExecFromAccessProfile converts the Azure Active Directory kubeconfig for the
+// Kubernetes Cluster into one using `kubelogin` as an exec plugin, which can be used non-interactively - this
+// is only possible for Azure Active Directory enabled clusters, otherwise nothing is returned
+func flattenKubernetesClusterKubeConfigExecFromAccessProfile(profile containerservice.ManagedClusterAccessProfile, environment string, loginMode string) (*string, []interface{}) {
+	if profile.AccessProfile == nil || profile.AccessProfile.KubeConfig == nil {
+		return nil, []interface{}{}
+	}
+
+	mode := kubernetes.KubeLoginModeServicePrincipal
+	if loginMode != "" {
+		mode = kubernetes.KubeLoginMode(loginMode)
+	}
+
+	rawConfig := string(*profile.AccessProfile.KubeConfig)
+
+	var kubeConfig *kubernetes.KubeConfigExec
+	if strings.Contains(rawConfig, "exec:") {
+		existing, err := kubernetes.ParseKubeConfigExec(rawConfig)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to parse the exec kubeconfig: %+v", err)
+			return nil, []interface{}{}
+		}
+
+		kubeConfig, err = kubernetes.ConvertKubeConfigExecLoginMode(*existing, environment, mode)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to convert the exec kubeconfig: %+v", err)
+			return nil, []interface{}{}
+		}
+	} else if strings.Contains(rawConfig, "apiserver-id:") {
+		existing, err := kubernetes.ParseKubeConfigAAD(rawConfig)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to parse the Azure Active Directory kubeconfig: %+v", err)
+			return nil, []interface{}{}
+		}
+
+		kubeConfig, err = kubernetes.ConvertKubeConfigAADToExec(*existing, environment, mode)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to convert the Azure Active Directory kubeconfig: %+v", err)
+			return nil, []interface{}{}
+		}
+	} else {
+		return nil, []interface{}{}
+	}
+
+	raw, err := kubernetes.MarshalKubeConfigExec(*kubeConfig)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to marshal the exec kubeconfig: %+v", err)
+		return nil, []interface{}{}
+	}
+
+	return utils.String(raw), flattenKubernetesClusterKubeConfigExec(*kubeConfig)
+}
+
+func flattenKubernetesClusterKubeConfigExec(config kubernetes.KubeConfigExec) []interface{} {
+	// we don't size-check these since they're validated in the Parse method
+	cluster := config.Clusters[0].Cluster
+	exec := config.Users[0].User.Exec
+	name := config.Users[0].Name
+
+	return []interface{}{
+		map[string]interface{}{
+			"api_version":            exec.APIVersion,
+			"args":                   utils.FlattenStringSlice(&exec.Args),
+			"cluster_ca_certificate": cluster.ClusterAuthorityData,
+			"command":                exec.Command,
+			"host":                   cluster.Server,
+			"username":               name,
+		},
+	}
+}
@@ -0,0 +1,239 @@
+package containers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-12-01/containerservice"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the AKS API only supports a single Maintenance Configuration per cluster, which must be named `default`
+const kubernetesClusterMaintenanceConfigurationName = "default"
+
+func schemaKubernetesClusterMaintenanceWindow() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed": {
+					Type:         schema.TypeSet,
+					Optional:     true,
+					AtLeastOneOf: []string{"maintenance_window.0.allowed", "maintenance_window.0.not_allowed"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"day": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(containerservice.Sunday),
+									string(containerservice.Monday),
+									string(containerservice.Tuesday),
+									string(containerservice.Wednesday),
+									string(containerservice.Thursday),
+									string(containerservice.Friday),
+									string(containerservice.Saturday),
+								}, false),
+							},
+
+							"hours": {
+								Type:     schema.TypeSet,
+								Required: true,
+								MinItems: 1,
+								Elem: &schema.Schema{
+									Type:         schema.TypeInt,
+									ValidateFunc: validation.IntBetween(0, 23),
+								},
+							},
+						},
+					},
+				},
+
+				"not_allowed": {
+					Type:         schema.TypeSet,
+					Optional:     true,
+					AtLeastOneOf: []string{"maintenance_window.0.allowed", "maintenance_window.0.not_allowed"},
+					Set:          resourceKubernetesClusterMaintenanceWindowNotAllowedHash,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"end": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateFunc:     validation.IsRFC3339Time,
+								DiffSuppressFunc: suppress.RFC3339Time,
+							},
+
+							"start": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateFunc:     validation.IsRFC3339Time,
+								DiffSuppressFunc: suppress.RFC3339Time,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// updateKubernetesClusterMaintenanceWindow creates/updates the `default` Maintenance Configuration for the
+// Kubernetes Cluster when a `maintenance_window` block is specified, otherwise removes it
+func updateKubernetesClusterMaintenanceWindow(ctx context.Context, client *client.Client, id parse.ClusterId, input []interface{}) error {
+	maintenanceClient := client.MaintenanceConfigurationsClient
+
+	if len(input) == 0 || input[0] == nil {
+		log.Printf("[DEBUG] Removing the Maintenance Window for Managed Kubernetes Cluster %q (Resource Group %q)..", id.ManagedClusterName, id.ResourceGroup)
+		resp, err := maintenanceClient.Delete(ctx, id.ResourceGroup, id.ManagedClusterName, kubernetesClusterMaintenanceConfigurationName)
+		if err != nil && !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("removing Maintenance Window for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+		}
+		log.Printf("[DEBUG] Removed the Maintenance Window for Managed Kubernetes Cluster %q (Resource Group %q).", id.ManagedClusterName, id.ResourceGroup)
+		return nil
+	}
+
+	props, err := expandKubernetesClusterMaintenanceWindow(input)
+	if err != nil {
+		return fmt.Errorf("expanding `maintenance_window`: %+v", err)
+	}
+
+	parameters := containerservice.MaintenanceConfiguration{
+		MaintenanceConfigurationProperties: props,
+	}
+	log.Printf("[DEBUG] Updating the Maintenance Window for Managed Kubernetes Cluster %q (Resource Group %q)..", id.ManagedClusterName, id.ResourceGroup)
+	if _, err := maintenanceClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, kubernetesClusterMaintenanceConfigurationName, parameters); err != nil {
+		return fmt.Errorf("updating Maintenance Window for Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Updated the Maintenance Window for Managed Kubernetes Cluster %q (Resource Group %q).", id.ManagedClusterName, id.ResourceGroup)
+
+	return nil
+}
+
+func expandKubernetesClusterMaintenanceWindow(input []interface{}) (*containerservice.MaintenanceConfigurationProperties, error) {
+	raw := input[0].(map[string]interface{})
+
+	timeInWeek := make([]containerservice.TimeInWeek, 0)
+	for _, item := range raw["allowed"].(*schema.Set).List() {
+		v := item.(map[string]interface{})
+
+		hourSlots := make([]int32, 0)
+		for _, hour := range v["hours"].(*schema.Set).List() {
+			hourSlots = append(hourSlots, int32(hour.(int)))
+		}
+
+		timeInWeek = append(timeInWeek, containerservice.TimeInWeek{
+			Day:       containerservice.WeekDay(v["day"].(string)),
+			HourSlots: &hourSlots,
+		})
+	}
+
+	notAllowedTime := make([]containerservice.TimeSpan, 0)
+	for _, item := range raw["not_allowed"].(*schema.Set).List() {
+		v := item.(map[string]interface{})
+
+		start, err := time.Parse(time.RFC3339, v["start"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing `start` %q: %+v", v["start"].(string), err)
+		}
+		end, err := time.Parse(time.RFC3339, v["end"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing `end` %q: %+v", v["end"].(string), err)
+		}
+		if !end.After(start) {
+			return nil, fmt.Errorf("the `end` time (%q) must be after the `start` time (%q)", v["end"].(string), v["start"].(string))
+		}
+
+		notAllowedTime = append(notAllowedTime, containerservice.TimeSpan{
+			Start: &date.Time{Time: start},
+			End:   &date.Time{Time: end},
+		})
+	}
+
+	return &containerservice.MaintenanceConfigurationProperties{
+		TimeInWeek:     &timeInWeek,
+		NotAllowedTime: &notAllowedTime,
+	}, nil
+}
+
+func flattenKubernetesClusterMaintenanceWindow(input *containerservice.MaintenanceConfigurationProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	allowed := make([]interface{}, 0)
+	if input.TimeInWeek != nil {
+		for _, v := range *input.TimeInWeek {
+			hours := make([]interface{}, 0)
+			if v.HourSlots != nil {
+				for _, hour := range *v.HourSlots {
+					hours = append(hours, int(hour))
+				}
+			}
+
+			allowed = append(allowed, map[string]interface{}{
+				"day":   string(v.Day),
+				"hours": schema.NewSet(schema.HashInt, hours),
+			})
+		}
+	}
+
+	notAllowed := make([]interface{}, 0)
+	if input.NotAllowedTime != nil {
+		for _, v := range *input.NotAllowedTime {
+			start := ""
+			if v.Start != nil {
+				start = v.Start.Format(time.RFC3339)
+			}
+
+			end := ""
+			if v.End != nil {
+				end = v.End.Format(time.RFC3339)
+			}
+
+			notAllowed = append(notAllowed, map[string]interface{}{
+				"end":   end,
+				"start": start,
+			})
+		}
+	}
+
+	if len(allowed) == 0 && len(notAllowed) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allowed":     allowed,
+			"not_allowed": notAllowed,
+		},
+	}
+}
+
+// resourceKubernetesClusterMaintenanceWindowNotAllowedHash hashes the `start` and `end` times in UTC, since these are
+// returned from the API in UTC - meaning the same time specified with a different offset would otherwise show a diff
+func resourceKubernetesClusterMaintenanceWindowNotAllowedHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		for _, key := range []string{"end", "start"} {
+			value := m[key].(string)
+			if t, err := time.Parse(time.RFC3339, value); err == nil {
+				value = t.UTC().Format(time.RFC3339)
+			}
+			buf.WriteString(fmt.Sprintf("%s-", value))
+		}
+	}
+
+	return schema.HashString(buf.String())
+}
@@ -167,6 +167,10 @@ func SchemaDefaultNodePool() *schema.Schema {
 				},
 
 				"upgrade_settings": upgradeSettingsSchema(),
+
+				"kubelet_config": schemaNodePoolKubeletConfig(),
+
+				"linux_os_config": schemaNodePoolLinuxOSConfig(),
 			},
 		},
 	}
@@ -200,6 +204,8 @@ func ConvertDefaultNodePoolToAgentPool(input *[]containerservice.ManagedClusterA
 			NodeTaints:                defaultCluster.NodeTaints,
 			Tags:                      defaultCluster.Tags,
 			UpgradeSettings:           defaultCluster.UpgradeSettings,
+			KubeletConfig:             defaultCluster.KubeletConfig,
+			LinuxOSConfig:             defaultCluster.LinuxOSConfig,
 		},
 	}
 }
@@ -247,12 +253,19 @@ func ExpandDefaultNodePool(d *schema.ResourceData) (*[]containerservice.ManagedC
 		Mode: containerservice.System,
 
 		UpgradeSettings: expandUpgradeSettings(raw["upgrade_settings"].([]interface{})),
+		KubeletConfig:   expandAgentPoolKubeletConfig(d, "default_node_pool.0.kubelet_config"),
 
 		// // TODO: support these in time
 		// ScaleSetEvictionPolicy: "",
 		// ScaleSetPriority:       "",
 	}
 
+	linuxOSConfig, err := expandAgentPoolLinuxOSConfig(d, "default_node_pool.0.linux_os_config")
+	if err != nil {
+		return nil, fmt.Errorf("expanding `linux_os_config`: %+v", err)
+	}
+	profile.LinuxOSConfig = linuxOSConfig
+
 	availabilityZonesRaw := raw["availability_zones"].([]interface{})
 	availabilityZones := utils.ExpandStringSlice(availabilityZonesRaw)
 
@@ -437,6 +450,11 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 
 	upgradeSettings := flattenUpgradeSettings(agentPool.UpgradeSettings)
 
+	linuxOSConfig, err := flattenAgentPoolLinuxOSConfig(agentPool.LinuxOSConfig)
+	if err != nil {
+		return nil, fmt.Errorf("flattening `linux_os_config`: %+v", err)
+	}
+
 	return &[]interface{}{
 		map[string]interface{}{
 			"availability_zones":           availabilityZones,
@@ -460,6 +478,8 @@ func FlattenDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolPro
 			"upgrade_settings":             upgradeSettings,
 			"vnet_subnet_id":               vnetSubnetId,
 			"only_critical_addons_enabled": criticalAddonsEnabled,
+			"kubelet_config":               flattenAgentPoolKubeletConfig(agentPool.KubeletConfig),
+			"linux_os_config":              linuxOSConfig,
 		},
 	}, nil
 }
@@ -505,3 +525,460 @@ func findDefaultNodePool(input *[]containerservice.ManagedClusterAgentPoolProfil
 
 	return agentPool, nil
 }
+
+func schemaNodePoolKubeletConfig() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_unsafe_sysctls": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+
+				"container_log_max_files": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(2),
+				},
+
+				"container_log_max_size_mb": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+
+				"cpu_cfs_quota_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  true,
+				},
+
+				"cpu_cfs_quota_period": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+
+				"cpu_manager_policy": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"none",
+						"static",
+					}, false),
+				},
+
+				"image_gc_high_threshold": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+
+				"image_gc_low_threshold": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+
+				"pod_max_pid": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+
+				"topology_manager_policy": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"none",
+						"best-effort",
+						"restricted",
+						"single-numa-node",
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func schemaNodePoolLinuxOSConfig() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"sysctl_config": schemaNodePoolSysctlConfig(),
+
+				"transparent_huge_page_enabled": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"always",
+						"madvise",
+						"never",
+					}, false),
+				},
+
+				"transparent_huge_page_defrag": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"always",
+						"defer",
+						"defer+madvise",
+						"madvise",
+						"never",
+					}, false),
+				},
+
+				"swap_file_size_mb": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func schemaNodePoolSysctlConfig() *schema.Schema {
+	intBetween := func(min, max int) *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(min, max),
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"fs_aio_max_nr":                      intBetween(65536, 6553500),
+				"fs_file_max":                        intBetween(8192, 12000500),
+				"fs_inotify_max_user_watches":        intBetween(781250, 2097152),
+				"fs_nr_open":                         intBetween(8192, 20000500),
+				"kernel_threads_max":                 intBetween(20, 513785),
+				"net_core_netdev_max_backlog":        intBetween(1000, 3240000),
+				"net_core_optmem_max":                intBetween(20480, 4194304),
+				"net_core_rmem_default":              intBetween(212992, 134217728),
+				"net_core_rmem_max":                  intBetween(212992, 134217728),
+				"net_core_somaxconn":                 intBetween(4096, 3240000),
+				"net_core_wmem_default":              intBetween(212992, 134217728),
+				"net_core_wmem_max":                  intBetween(212992, 134217728),
+				"net_ipv4_ip_local_port_range_max":   intBetween(1024, 60999),
+				"net_ipv4_ip_local_port_range_min":   intBetween(1024, 60999),
+				"net_ipv4_neigh_default_gc_thresh1":  intBetween(128, 80000),
+				"net_ipv4_neigh_default_gc_thresh2":  intBetween(512, 90000),
+				"net_ipv4_neigh_default_gc_thresh3":  intBetween(1024, 100000),
+				"net_ipv4_tcp_fin_timeout":           intBetween(5, 120),
+				"net_ipv4_tcp_keepalive_intvl":       intBetween(10, 75),
+				"net_ipv4_tcp_keepalive_probes":      intBetween(1, 15),
+				"net_ipv4_tcp_keepalive_time":        intBetween(30, 432000),
+				"net_ipv4_tcp_max_syn_backlog":       intBetween(128, 3240000),
+				"net_ipv4_tcp_max_tw_buckets":        intBetween(8000, 1440000),
+				"net_netfilter_nf_conntrack_buckets": intBetween(65536, 147456),
+				"net_netfilter_nf_conntrack_max":     intBetween(131072, 589824),
+				"vm_max_map_count":                   intBetween(65530, 262144),
+				"vm_swappiness":                      intBetween(0, 100),
+				"vm_vfs_cache_pressure":              intBetween(0, 100),
+
+				"net_ipv4_tcp_tw_reuse": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+// expandAgentPoolKubeletConfig expands the `kubelet_config` block at the specified path - since 0 is a valid value for
+// some of the fields within this block, whether these have been specified is determined using the config
+func expandAgentPoolKubeletConfig(d *schema.ResourceData, path string) *containerservice.KubeletConfig {
+	input := d.Get(path).([]interface{})
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	result := &containerservice.KubeletConfig{
+		CPUCfsQuota:          utils.Bool(raw["cpu_cfs_quota_enabled"].(bool)),
+		AllowedUnsafeSysctls: utils.ExpandStringSlice(raw["allowed_unsafe_sysctls"].(*schema.Set).List()),
+	}
+
+	if v := raw["cpu_manager_policy"].(string); v != "" {
+		result.CPUManagerPolicy = utils.String(v)
+	}
+	if v := raw["cpu_cfs_quota_period"].(string); v != "" {
+		result.CPUCfsQuotaPeriod = utils.String(v)
+	}
+	if v, ok := d.GetOkExists(fmt.Sprintf("%s.0.image_gc_high_threshold", path)); ok {
+		result.ImageGcHighThreshold = utils.Int32(int32(v.(int)))
+	}
+	if v, ok := d.GetOkExists(fmt.Sprintf("%s.0.image_gc_low_threshold", path)); ok {
+		result.ImageGcLowThreshold = utils.Int32(int32(v.(int)))
+	}
+	if v := raw["topology_manager_policy"].(string); v != "" {
+		result.TopologyManagerPolicy = utils.String(v)
+	}
+	if v := raw["container_log_max_size_mb"].(int); v != 0 {
+		result.ContainerLogMaxSizeMB = utils.Int32(int32(v))
+	}
+	if v := raw["container_log_max_files"].(int); v != 0 {
+		result.ContainerLogMaxFiles = utils.Int32(int32(v))
+	}
+	if v := raw["pod_max_pid"].(int); v != 0 {
+		result.PodMaxPids = utils.Int32(int32(v))
+	}
+
+	return result
+}
+
+// expandAgentPoolLinuxOSConfig expands the `linux_os_config` block at the specified path
+func expandAgentPoolLinuxOSConfig(d *schema.ResourceData, path string) (*containerservice.LinuxOSConfig, error) {
+	input := d.Get(path).([]interface{})
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	sysctlConfig, err := expandAgentPoolSysctlConfig(d, fmt.Sprintf("%s.0.sysctl_config", path))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &containerservice.LinuxOSConfig{
+		Sysctls: sysctlConfig,
+	}
+	if v := raw["transparent_huge_page_enabled"].(string); v != "" {
+		result.TransparentHugePageEnabled = utils.String(v)
+	}
+	if v := raw["transparent_huge_page_defrag"].(string); v != "" {
+		result.TransparentHugePageDefrag = utils.String(v)
+	}
+	if v := raw["swap_file_size_mb"].(int); v != 0 {
+		result.SwapFileSizeMB = utils.Int32(int32(v))
+	}
+
+	return result, nil
+}
+
+// expandAgentPoolSysctlConfig expands the `sysctl_config` block at the specified path - since 0 is a valid value for
+// some of the fields within this block, whether these have been specified is determined using the config
+func expandAgentPoolSysctlConfig(d *schema.ResourceData, path string) (*containerservice.SysctlConfig, error) {
+	input := d.Get(path).([]interface{})
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	expandInt32 := func(key string) *int32 {
+		if v, ok := d.GetOkExists(fmt.Sprintf("%s.0.%s", path, key)); ok {
+			return utils.Int32(int32(v.(int)))
+		}
+		return nil
+	}
+
+	result := &containerservice.SysctlConfig{
+		FsAioMaxNr:                     expandInt32("fs_aio_max_nr"),
+		FsFileMax:                      expandInt32("fs_file_max"),
+		FsInotifyMaxUserWatches:        expandInt32("fs_inotify_max_user_watches"),
+		FsNrOpen:                       expandInt32("fs_nr_open"),
+		KernelThreadsMax:               expandInt32("kernel_threads_max"),
+		NetCoreNetdevMaxBacklog:        expandInt32("net_core_netdev_max_backlog"),
+		NetCoreOptmemMax:               expandInt32("net_core_optmem_max"),
+		NetCoreRmemDefault:             expandInt32("net_core_rmem_default"),
+		NetCoreRmemMax:                 expandInt32("net_core_rmem_max"),
+		NetCoreSomaxconn:               expandInt32("net_core_somaxconn"),
+		NetCoreWmemDefault:             expandInt32("net_core_wmem_default"),
+		NetCoreWmemMax:                 expandInt32("net_core_wmem_max"),
+		NetIpv4NeighDefaultGcThresh1:   expandInt32("net_ipv4_neigh_default_gc_thresh1"),
+		NetIpv4NeighDefaultGcThresh2:   expandInt32("net_ipv4_neigh_default_gc_thresh2"),
+		NetIpv4NeighDefaultGcThresh3:   expandInt32("net_ipv4_neigh_default_gc_thresh3"),
+		NetIpv4TCPFinTimeout:           expandInt32("net_ipv4_tcp_fin_timeout"),
+		NetIpv4TcpkeepaliveIntvl:       expandInt32("net_ipv4_tcp_keepalive_intvl"),
+		NetIpv4TCPKeepaliveProbes:      expandInt32("net_ipv4_tcp_keepalive_probes"),
+		NetIpv4TCPKeepaliveTime:        expandInt32("net_ipv4_tcp_keepalive_time"),
+		NetIpv4TCPMaxSynBacklog:        expandInt32("net_ipv4_tcp_max_syn_backlog"),
+		NetIpv4TCPMaxTwBuckets:         expandInt32("net_ipv4_tcp_max_tw_buckets"),
+		NetNetfilterNfConntrackBuckets: expandInt32("net_netfilter_nf_conntrack_buckets"),
+		NetNetfilterNfConntrackMax:     expandInt32("net_netfilter_nf_conntrack_max"),
+		VMMaxMapCount:                  expandInt32("vm_max_map_count"),
+		VMSwappiness:                   expandInt32("vm_swappiness"),
+		VMVfsCachePressure:             expandInt32("vm_vfs_cache_pressure"),
+	}
+
+	if raw["net_ipv4_tcp_tw_reuse"].(bool) {
+		result.NetIpv4TCPTwReuse = utils.Bool(true)
+	}
+
+	portRangeMin := raw["net_ipv4_ip_local_port_range_min"].(int)
+	portRangeMax := raw["net_ipv4_ip_local_port_range_max"].(int)
+	if (portRangeMin != 0 && portRangeMax == 0) || (portRangeMin == 0 && portRangeMax != 0) {
+		return nil, fmt.Errorf("`net_ipv4_ip_local_port_range_min` and `net_ipv4_ip_local_port_range_max` must both be specified")
+	}
+	if portRangeMin != 0 && portRangeMax != 0 {
+		if portRangeMin > portRangeMax {
+			return nil, fmt.Errorf("`net_ipv4_ip_local_port_range_min` must be less than or equal to `net_ipv4_ip_local_port_range_max`")
+		}
+		result.NetIpv4IPLocalPortRange = utils.String(fmt.Sprintf("%d %d", portRangeMin, portRangeMax))
+	}
+
+	return result, nil
+}
+
+func flattenAgentPoolKubeletConfig(input *containerservice.KubeletConfig) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	cpuCfsQuotaEnabled := false
+	if input.CPUCfsQuota != nil {
+		cpuCfsQuotaEnabled = *input.CPUCfsQuota
+	}
+
+	cpuCfsQuotaPeriod := ""
+	if input.CPUCfsQuotaPeriod != nil {
+		cpuCfsQuotaPeriod = *input.CPUCfsQuotaPeriod
+	}
+
+	cpuManagerPolicy := ""
+	if input.CPUManagerPolicy != nil {
+		cpuManagerPolicy = *input.CPUManagerPolicy
+	}
+
+	topologyManagerPolicy := ""
+	if input.TopologyManagerPolicy != nil {
+		topologyManagerPolicy = *input.TopologyManagerPolicy
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allowed_unsafe_sysctls":    utils.FlattenStringSlice(input.AllowedUnsafeSysctls),
+			"container_log_max_files":   flattenAgentPoolInt32(input.ContainerLogMaxFiles),
+			"container_log_max_size_mb": flattenAgentPoolInt32(input.ContainerLogMaxSizeMB),
+			"cpu_cfs_quota_enabled":     cpuCfsQuotaEnabled,
+			"cpu_cfs_quota_period":      cpuCfsQuotaPeriod,
+			"cpu_manager_policy":        cpuManagerPolicy,
+			"image_gc_high_threshold":   flattenAgentPoolInt32(input.ImageGcHighThreshold),
+			"image_gc_low_threshold":    flattenAgentPoolInt32(input.ImageGcLowThreshold),
+			"pod_max_pid":               flattenAgentPoolInt32(input.PodMaxPids),
+			"topology_manager_policy":   topologyManagerPolicy,
+		},
+	}
+}
+
+func flattenAgentPoolLinuxOSConfig(input *containerservice.LinuxOSConfig) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	sysctlConfig, err := flattenAgentPoolSysctlConfig(input.Sysctls)
+	if err != nil {
+		return nil, err
+	}
+
+	transparentHugePageEnabled := ""
+	if input.TransparentHugePageEnabled != nil {
+		transparentHugePageEnabled = *input.TransparentHugePageEnabled
+	}
+
+	transparentHugePageDefrag := ""
+	if input.TransparentHugePageDefrag != nil {
+		transparentHugePageDefrag = *input.TransparentHugePageDefrag
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"sysctl_config":                 sysctlConfig,
+			"swap_file_size_mb":             flattenAgentPoolInt32(input.SwapFileSizeMB),
+			"transparent_huge_page_enabled": transparentHugePageEnabled,
+			"transparent_huge_page_defrag":  transparentHugePageDefrag,
+		},
+	}, nil
+}
+
+func flattenAgentPoolSysctlConfig(input *containerservice.SysctlConfig) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	tcpTwReuse := false
+	if input.NetIpv4TCPTwReuse != nil {
+		tcpTwReuse = *input.NetIpv4TCPTwReuse
+	}
+
+	// the API returns the range as a single space-separated string, e.g. `32768 60999`
+	portRangeMin := 0
+	portRangeMax := 0
+	if input.NetIpv4IPLocalPortRange != nil && *input.NetIpv4IPLocalPortRange != "" {
+		if _, err := fmt.Sscanf(*input.NetIpv4IPLocalPortRange, "%d %d", &portRangeMin, &portRangeMax); err != nil {
+			return nil, fmt.Errorf("parsing `net_ipv4_ip_local_port_range` %q: %+v", *input.NetIpv4IPLocalPortRange, err)
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"fs_aio_max_nr":                      flattenAgentPoolInt32(input.FsAioMaxNr),
+			"fs_file_max":                        flattenAgentPoolInt32(input.FsFileMax),
+			"fs_inotify_max_user_watches":        flattenAgentPoolInt32(input.FsInotifyMaxUserWatches),
+			"fs_nr_open":                         flattenAgentPoolInt32(input.FsNrOpen),
+			"kernel_threads_max":                 flattenAgentPoolInt32(input.KernelThreadsMax),
+			"net_core_netdev_max_backlog":        flattenAgentPoolInt32(input.NetCoreNetdevMaxBacklog),
+			"net_core_optmem_max":                flattenAgentPoolInt32(input.NetCoreOptmemMax),
+			"net_core_rmem_default":              flattenAgentPoolInt32(input.NetCoreRmemDefault),
+			"net_core_rmem_max":                  flattenAgentPoolInt32(input.NetCoreRmemMax),
+			"net_core_somaxconn":                 flattenAgentPoolInt32(input.NetCoreSomaxconn),
+			"net_core_wmem_default":              flattenAgentPoolInt32(input.NetCoreWmemDefault),
+			"net_core_wmem_max":                  flattenAgentPoolInt32(input.NetCoreWmemMax),
+			"net_ipv4_ip_local_port_range_max":   portRangeMax,
+			"net_ipv4_ip_local_port_range_min":   portRangeMin,
+			"net_ipv4_neigh_default_gc_thresh1":  flattenAgentPoolInt32(input.NetIpv4NeighDefaultGcThresh1),
+			"net_ipv4_neigh_default_gc_thresh2":  flattenAgentPoolInt32(input.NetIpv4NeighDefaultGcThresh2),
+			"net_ipv4_neigh_default_gc_thresh3":  flattenAgentPoolInt32(input.NetIpv4NeighDefaultGcThresh3),
+			"net_ipv4_tcp_fin_timeout":           flattenAgentPoolInt32(input.NetIpv4TCPFinTimeout),
+			"net_ipv4_tcp_keepalive_intvl":       flattenAgentPoolInt32(input.NetIpv4TcpkeepaliveIntvl),
+			"net_ipv4_tcp_keepalive_probes":      flattenAgentPoolInt32(input.NetIpv4TCPKeepaliveProbes),
+			"net_ipv4_tcp_keepalive_time":        flattenAgentPoolInt32(input.NetIpv4TCPKeepaliveTime),
+			"net_ipv4_tcp_max_syn_backlog":       flattenAgentPoolInt32(input.NetIpv4TCPMaxSynBacklog),
+			"net_ipv4_tcp_max_tw_buckets":        flattenAgentPoolInt32(input.NetIpv4TCPMaxTwBuckets),
+			"net_ipv4_tcp_tw_reuse":              tcpTwReuse,
+			"net_netfilter_nf_conntrack_buckets": flattenAgentPoolInt32(input.NetNetfilterNfConntrackBuckets),
+			"net_netfilter_nf_conntrack_max":     flattenAgentPoolInt32(input.NetNetfilterNfConntrackMax),
+			"vm_max_map_count":                   flattenAgentPoolInt32(input.VMMaxMapCount),
+			"vm_swappiness":                      flattenAgentPoolInt32(input.VMSwappiness),
+			"vm_vfs_cache_pressure":              flattenAgentPoolInt32(input.VMVfsCachePressure),
+		},
+	}, nil
+}
+
+func flattenAgentPoolInt32(input *int32) int {
+	if input == nil {
+		return 0
+	}
+
+	return int(*input)
+}
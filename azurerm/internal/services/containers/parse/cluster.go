@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ClusterId struct {
@@ -67,3 +68,19 @@ func ClusterID(input string) (*ClusterId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "containers",
+		TypeName:           "Cluster",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/managedClusters/cluster1",
+		ResourceTypes:      []string{"azurerm_kubernetes_cluster"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ClusterID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
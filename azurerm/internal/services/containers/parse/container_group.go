@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ContainerGroupId struct {
@@ -67,3 +68,19 @@ func ContainerGroupID(input string) (*ContainerGroupId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "containers",
+		TypeName:           "ContainerGroup",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerInstance/containerGroups/containerGroup1",
+		ResourceTypes:      []string{"azurerm_container_group"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ContainerGroupID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
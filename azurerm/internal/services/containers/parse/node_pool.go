@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NodePoolId struct {
@@ -73,3 +74,19 @@ func NodePoolID(input string) (*NodePoolId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "containers",
+		TypeName:           "NodePool",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/managedClusters/cluster1/agentPools/pool1",
+		ResourceTypes:      []string{"azurerm_kubernetes_cluster_node_pool"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := NodePoolID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CassandraTableId struct {
@@ -79,3 +80,19 @@ func CassandraTableID(input string) (*CassandraTableId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "cosmos",
+		TypeName:           "CassandraTable",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/cassandraKeyspaces/keyspace1/tables/table1",
+		ResourceTypes:      []string{"azurerm_cosmosdb_cassandra_table"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := CassandraTableID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabaseAccountId struct {
@@ -67,3 +68,19 @@ func DatabaseAccountID(input string) (*DatabaseAccountId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "cosmos",
+		TypeName:           "DatabaseAccount",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1",
+		ResourceTypes:      []string{"azurerm_cosmosdb_account"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DatabaseAccountID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
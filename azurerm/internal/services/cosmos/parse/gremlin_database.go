@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type GremlinDatabaseId struct {
@@ -73,3 +74,19 @@ func GremlinDatabaseID(input string) (*GremlinDatabaseId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "cosmos",
+		TypeName:           "GremlinDatabase",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/gremlinDatabases/database1",
+		ResourceTypes:      []string{"azurerm_cosmosdb_gremlin_database"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := GremlinDatabaseID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
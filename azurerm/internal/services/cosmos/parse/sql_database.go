@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SqlDatabaseId struct {
@@ -73,3 +74,19 @@ func SqlDatabaseID(input string) (*SqlDatabaseId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "cosmos",
+		TypeName:           "SqlDatabase",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/sqlDatabases/db1",
+		ResourceTypes:      []string{"azurerm_cosmosdb_sql_database"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SqlDatabaseID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SqlStoredProcedureId struct {
@@ -85,3 +86,19 @@ func SqlStoredProcedureID(input string) (*SqlStoredProcedureId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "cosmos",
+		TypeName:           "SqlStoredProcedure",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/sqlDatabases/db1/containers/container1/storedProcedures/sproc1",
+		ResourceTypes:      []string{"azurerm_cosmosdb_sql_stored_procedure"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SqlStoredProcedureID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
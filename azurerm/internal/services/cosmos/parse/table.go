@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type TableId struct {
@@ -73,3 +74,19 @@ func TableID(input string) (*TableId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "cosmos",
+		TypeName:           "Table",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/tables/table1",
+		ResourceTypes:      []string{"azurerm_cosmosdb_table"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := TableID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -145,7 +145,7 @@ func resourceCustomProviderCreateUpdate(d *schema.ResourceData, meta interface{}
 			Validations:   expandCustomProviderValidation(d.Get("validation").(*schema.Set).List()),
 		},
 		Location: &location,
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, provider)
@@ -208,7 +208,7 @@ func resourceCustomProviderRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("setting `validation`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceCustomProviderDelete(d *schema.ResourceData, meta interface{}) error {
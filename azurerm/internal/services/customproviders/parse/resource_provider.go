@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ResourceProviderId struct {
@@ -67,3 +68,19 @@ func ResourceProviderID(input string) (*ResourceProviderId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "customproviders",
+		TypeName:           "ResourceProvider",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.CustomProviders/resourceproviders/provider1",
+		ResourceTypes:      []string{"azurerm_custom_provider"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ResourceProviderID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
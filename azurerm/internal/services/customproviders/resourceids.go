@@ -1,3 +1,3 @@
 package customproviders
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ResourceProvider -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.CustomProviders/resourceproviders/provider1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ResourceProvider -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.CustomProviders/resourceproviders/provider1 -resource-types=azurerm_custom_provider
@@ -113,7 +113,7 @@ func resourceDatabaseMigrationProjectCreateUpdate(d *schema.ResourceData, meta i
 			SourcePlatform: datamigration.ProjectSourcePlatform(sourcePlatform),
 			TargetPlatform: datamigration.ProjectTargetPlatform(targetPlatform),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, parameters, resourceGroup, serviceName, name); err != nil {
@@ -167,7 +167,7 @@ func resourceDatabaseMigrationProjectRead(d *schema.ResourceData, meta interface
 		d.Set("target_platform", string(prop.TargetPlatform))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceDatabaseMigrationProjectDelete(d *schema.ResourceData, meta interface{}) error {
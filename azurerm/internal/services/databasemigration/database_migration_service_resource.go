@@ -112,7 +112,7 @@ func resourceDatabaseMigrationServiceCreate(d *schema.ResourceData, meta interfa
 		Kind: utils.String("Cloud"), // currently only "Cloud" is supported, hence hardcode here
 	}
 	if t, ok := d.GetOk("tags"); ok {
-		parameters.Tags = tags.Expand(t.(map[string]interface{}))
+		parameters.Tags = meta.(*clients.Client).Tags.Expand(t.(map[string]interface{}))
 	}
 
 	future, err := client.CreateOrUpdate(ctx, parameters, resourceGroup, name)
@@ -172,7 +172,7 @@ func resourceDatabaseMigrationServiceRead(d *schema.ResourceData, meta interface
 		d.Set("sku_name", resp.Sku.Name)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceDatabaseMigrationServiceUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -186,7 +186,7 @@ func resourceDatabaseMigrationServiceUpdate(d *schema.ResourceData, meta interfa
 	}
 
 	parameters := datamigration.Service{
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Update(ctx, parameters, id.ResourceGroup, id.Name)
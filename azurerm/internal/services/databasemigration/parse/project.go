@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProjectId struct {
@@ -73,3 +74,19 @@ func ProjectID(input string) (*ProjectId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "databasemigration",
+		TypeName:           "Project",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataMigration/services/service1/projects/project1",
+		ResourceTypes:      []string{"azurerm_database_migration_project"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ProjectID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServiceId struct {
@@ -67,3 +68,19 @@ func ServiceID(input string) (*ServiceId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "databasemigration",
+		TypeName:           "Service",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataMigration/services/service1",
+		ResourceTypes:      []string{"azurerm_database_migration_service"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ServiceID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
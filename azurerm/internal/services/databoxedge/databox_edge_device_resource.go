@@ -150,7 +150,7 @@ func resourceDataboxEdgeDeviceCreate(d *schema.ResourceData, meta interface{}) e
 	dataBoxEdgeDevice := databoxedge.Device{
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		Sku:      expandDeviceSku(d.Get("sku_name").(string)),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 	future, err := client.CreateOrUpdate(ctx, name, dataBoxEdgeDevice, resourceGroup)
 	if err != nil {
@@ -214,7 +214,7 @@ func resourceDataboxEdgeDeviceRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `sku_name`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceDataboxEdgeDeviceUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -228,8 +228,8 @@ func resourceDataboxEdgeDeviceUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	parameters := databoxedge.DevicePatch{}
-	if d.HasChange("tags") {
-		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		parameters.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.Name, parameters, id.ResourceGroup); err != nil {
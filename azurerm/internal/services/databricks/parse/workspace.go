@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type WorkspaceId struct {
@@ -67,3 +68,19 @@ func WorkspaceID(input string) (*WorkspaceId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "databricks",
+		TypeName:           "Workspace",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Databricks/workspaces/workspace1",
+		ResourceTypes:      []string{"azurerm_databricks_workspace"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := WorkspaceID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
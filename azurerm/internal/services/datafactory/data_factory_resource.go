@@ -198,7 +198,7 @@ func resourceDataFactoryCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	dataFactory := datafactory.Factory{
 		Location:          &location,
 		FactoryProperties: &datafactory.FactoryProperties{},
-		Tags:              tags.Expand(t),
+		Tags:              meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	dataFactory.PublicNetworkAccess = datafactory.PublicNetworkAccessEnabled
@@ -297,7 +297,7 @@ func resourceDataFactoryRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("public_network_enabled", resp.PublicNetworkAccess == datafactory.PublicNetworkAccessEnabled)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceDataFactoryDelete(d *schema.ResourceData, meta interface{}) error {
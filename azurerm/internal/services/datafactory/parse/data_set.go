@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DataSetId struct {
@@ -73,3 +74,19 @@ func DataSetID(input string) (*DataSetId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "datafactory",
+		TypeName:           "DataSet",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/facName1/datasets/dataSet1",
+		ResourceTypes:      []string{"azurerm_data_factory_dataset_azure_blob", "azurerm_data_factory_dataset_cosmosdb_sqlapi", "azurerm_data_factory_dataset_delimited_text", "azurerm_data_factory_dataset_http", "azurerm_data_factory_dataset_json", "azurerm_data_factory_dataset_mysql", "azurerm_data_factory_dataset_postgresql", "azurerm_data_factory_dataset_sql_server_table"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DataSetID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
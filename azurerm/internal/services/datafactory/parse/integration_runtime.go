@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IntegrationRuntimeId struct {
@@ -73,3 +74,19 @@ func IntegrationRuntimeID(input string) (*IntegrationRuntimeId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "datafactory",
+		TypeName:           "IntegrationRuntime",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/integrationruntimes/runtime1",
+		ResourceTypes:      []string{"azurerm_data_factory_integration_runtime_self_hosted"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := IntegrationRuntimeID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LinkedServiceId struct {
@@ -73,3 +74,19 @@ func LinkedServiceID(input string) (*LinkedServiceId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "datafactory",
+		TypeName:           "LinkedService",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/linkedservices/linkedService1",
+		ResourceTypes:      []string{"azurerm_data_factory_linked_service_azure_blob_storage", "azurerm_data_factory_linked_service_azure_file_storage", "azurerm_data_factory_linked_service_azure_function", "azurerm_data_factory_linked_service_azure_sql_database", "azurerm_data_factory_linked_service_azure_table_storage", "azurerm_data_factory_linked_service_cosmosdb", "azurerm_data_factory_linked_service_data_lake_storage_gen2", "azurerm_data_factory_linked_service_key_vault", "azurerm_data_factory_linked_service_mysql", "azurerm_data_factory_linked_service_postgresql", "azurerm_data_factory_linked_service_sftp", "azurerm_data_factory_linked_service_snowflake", "azurerm_data_factory_linked_service_sql_server", "azurerm_data_factory_linked_service_synapse", "azurerm_data_factory_linked_service_web"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := LinkedServiceID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -107,7 +107,7 @@ func resourceArmDateLakeAnalyticsAccountCreate(d *schema.ResourceData, meta inte
 
 	dateLakeAnalyticsAccount := account.CreateDataLakeAnalyticsAccountParameters{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		CreateDataLakeAnalyticsAccountProperties: &account.CreateDataLakeAnalyticsAccountProperties{
 			NewTier:                     account.TierType(tier),
 			DefaultDataLakeStoreAccount: &storeAccountName,
@@ -153,7 +153,7 @@ func resourceArmDateLakeAnalyticsAccountUpdate(d *schema.ResourceData, meta inte
 	newTags := d.Get("tags").(map[string]interface{})
 
 	props := &account.UpdateDataLakeAnalyticsAccountParameters{
-		Tags: tags.Expand(newTags),
+		Tags: meta.(*clients.Client).Tags.Expand(newTags),
 		UpdateDataLakeAnalyticsAccountProperties: &account.UpdateDataLakeAnalyticsAccountProperties{
 			NewTier: account.TierType(newTier),
 			DataLakeStoreAccounts: &[]account.UpdateDataLakeStoreWithAccountParameters{
@@ -210,7 +210,7 @@ func resourceArmDateLakeAnalyticsAccountRead(d *schema.ResourceData, meta interf
 		d.Set("default_store_account_name", properties.DefaultDataLakeStoreAccount)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceArmDateLakeAnalyticsAccountDelete(d *schema.ResourceData, meta interface{}) error {
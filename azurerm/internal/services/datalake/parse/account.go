@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AccountId struct {
@@ -67,3 +68,19 @@ func AccountID(input string) (*AccountId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "datalake",
+		TypeName:           "Account",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataLakeStore/accounts/account1",
+		ResourceTypes:      []string{"azurerm_data_lake_store"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := AccountID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
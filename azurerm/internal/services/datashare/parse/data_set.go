@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DataSetId struct {
@@ -79,3 +80,19 @@ func DataSetID(input string) (*DataSetId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "datashare",
+		TypeName:           "DataSet",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataShare/accounts/account1/shares/share1/dataSets/dataSet1",
+		ResourceTypes:      []string{"azurerm_data_share_dataset_blob_storage", "azurerm_data_share_dataset_data_lake_gen1", "azurerm_data_share_dataset_data_lake_gen2", "azurerm_data_share_dataset_kusto_cluster", "azurerm_data_share_dataset_kusto_database"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DataSetID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ShareId struct {
@@ -73,3 +74,19 @@ func ShareID(input string) (*ShareId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "datashare",
+		TypeName:           "Share",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataShare/accounts/account1/shares/share1",
+		ResourceTypes:      []string{"azurerm_data_share"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ShareID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -137,7 +137,7 @@ func resourceVirtualDesktopApplicationGroupCreateUpdate(d *schema.ResourceData,
 
 	context := desktopvirtualization.ApplicationGroup{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		ApplicationGroupProperties: &desktopvirtualization.ApplicationGroupProperties{
 			ApplicationGroupType: desktopvirtualization.ApplicationGroupType(d.Get("type").(string)),
 			FriendlyName:         utils.String(d.Get("friendly_name").(string)),
@@ -200,7 +200,7 @@ func resourceVirtualDesktopApplicationGroupRead(d *schema.ResourceData, meta int
 		d.Set("host_pool_id", hostPoolIdStr)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVirtualDesktopApplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
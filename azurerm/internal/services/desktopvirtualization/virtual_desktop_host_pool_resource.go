@@ -190,7 +190,7 @@ func resourceVirtualDesktopHostPoolCreateUpdate(d *schema.ResourceData, meta int
 
 	context := desktopvirtualization.HostPool{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		HostPoolProperties: &desktopvirtualization.HostPoolProperties{
 			HostPoolType:                  desktopvirtualization.HostPoolType(d.Get("type").(string)),
 			FriendlyName:                  utils.String(d.Get("friendly_name").(string)),
@@ -260,7 +260,7 @@ func resourceVirtualDesktopHostPoolRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVirtualDesktopHostPoolDelete(d *schema.ResourceData, meta interface{}) error {
@@ -109,7 +109,7 @@ func resourceArmDesktopVirtualizationWorkspaceCreateUpdate(d *schema.ResourceDat
 
 	context := desktopvirtualization.Workspace{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		WorkspaceProperties: &desktopvirtualization.WorkspaceProperties{
 			Description:  utils.String(d.Get("description").(string)),
 			FriendlyName: utils.String(d.Get("friendly_name").(string)),
@@ -158,7 +158,7 @@ func resourceArmDesktopVirtualizationWorkspaceRead(d *schema.ResourceData, meta
 		d.Set("friendly_name", props.FriendlyName)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceArmDesktopVirtualizationWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
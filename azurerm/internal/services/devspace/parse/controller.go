@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ControllerId struct {
@@ -67,3 +68,19 @@ func ControllerID(input string) (*ControllerId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "devspace",
+		TypeName:           "Controller",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DevSpaces/controllers/controller1",
+		ResourceTypes:      []string{"azurerm_devspace_controller"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ControllerID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
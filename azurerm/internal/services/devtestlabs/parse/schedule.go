@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ScheduleId struct {
@@ -67,3 +68,19 @@ func ScheduleID(input string) (*ScheduleId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "devtestlabs",
+		TypeName:           "Schedule",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DevTestLab/schedules/schedule1",
+		ResourceTypes:      []string{"azurerm_dev_test_global_vm_shutdown_schedule"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ScheduleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
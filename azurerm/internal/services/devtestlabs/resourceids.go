@@ -1,3 +1,3 @@
 package devtestlabs
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Schedule -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DevTestLab/schedules/schedule1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Schedule -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DevTestLab/schedules/schedule1 -resource-types=azurerm_dev_test_global_vm_shutdown_schedule
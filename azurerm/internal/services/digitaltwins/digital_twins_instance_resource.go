@@ -82,7 +82,7 @@ func resourceDigitalTwinsInstanceCreate(d *schema.ResourceData, meta interface{}
 
 	properties := digitaltwins.Description{
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, properties)
@@ -130,7 +130,7 @@ func resourceDigitalTwinsInstanceRead(d *schema.ResourceData, meta interface{})
 		d.Set("host_name", props.HostName)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceDigitalTwinsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -145,8 +145,8 @@ func resourceDigitalTwinsInstanceUpdate(d *schema.ResourceData, meta interface{}
 
 	props := digitaltwins.PatchDescription{}
 
-	if d.HasChange("tags") {
-		props.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		props.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, props); err != nil {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DigitalTwinsEndpointId struct {
@@ -73,3 +74,19 @@ func DigitalTwinsEndpointID(input string) (*DigitalTwinsEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "digitaltwins",
+		TypeName:           "DigitalTwinsEndpoint",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DigitalTwins/digitalTwinsInstances/instance1/endpoints/endpoint1",
+		ResourceTypes:      []string{"azurerm_digital_twins_endpoint_eventgrid", "azurerm_digital_twins_endpoint_eventhub", "azurerm_digital_twins_endpoint_servicebus"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DigitalTwinsEndpointID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
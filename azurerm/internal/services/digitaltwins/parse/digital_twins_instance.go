@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DigitalTwinsInstanceId struct {
@@ -67,3 +68,19 @@ func DigitalTwinsInstanceID(input string) (*DigitalTwinsInstanceId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "digitaltwins",
+		TypeName:           "DigitalTwinsInstance",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DigitalTwins/digitalTwinsInstances/instance1",
+		ResourceTypes:      []string{"azurerm_digital_twins_instance"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DigitalTwinsInstanceID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
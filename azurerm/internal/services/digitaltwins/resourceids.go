@@ -1,5 +1,5 @@
 package digitaltwins
 
 // leaving the DigitalTwins prefix here to avoid stuttering the property name for now
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=DigitalTwinsInstance -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DigitalTwins/digitalTwinsInstances/instance1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=DigitalTwinsEndpoint -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DigitalTwins/digitalTwinsInstances/instance1/endpoints/endpoint1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=DigitalTwinsInstance -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DigitalTwins/digitalTwinsInstances/instance1 -resource-types=azurerm_digital_twins_instance
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=DigitalTwinsEndpoint -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DigitalTwins/digitalTwinsInstances/instance1/endpoints/endpoint1 -resource-types=azurerm_digital_twins_endpoint_eventgrid,azurerm_digital_twins_endpoint_eventhub,azurerm_digital_twins_endpoint_servicebus
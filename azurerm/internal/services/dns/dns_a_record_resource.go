@@ -114,7 +114,7 @@ func resourceDnsARecordCreateUpdate(d *schema.ResourceData, meta interface{}) er
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:       tags.Expand(t),
+			Metadata:       meta.(*clients.Client).Tags.Expand(t),
 			TTL:            &ttl,
 			ARecords:       expandAzureRmDnsARecords(recordsRaw),
 			TargetResource: &dns.SubResource{},
@@ -177,7 +177,7 @@ func resourceDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("target_resource_id", targetResourceId)
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Metadata)
 }
 
 func resourceDnsARecordDelete(d *schema.ResourceData, meta interface{}) error {
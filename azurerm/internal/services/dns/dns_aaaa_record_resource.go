@@ -118,7 +118,7 @@ func resourceDnsAaaaRecordCreateUpdate(d *schema.ResourceData, meta interface{})
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:       tags.Expand(t),
+			Metadata:       meta.(*clients.Client).Tags.Expand(t),
 			TTL:            &ttl,
 			AaaaRecords:    expandAzureRmDnsAaaaRecords(recordsRaw),
 			TargetResource: &dns.SubResource{},
@@ -181,7 +181,7 @@ func resourceDnsAaaaRecordRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("target_resource_id", targetResourceId)
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Metadata)
 }
 
 func resourceDnsAaaaRecordDelete(d *schema.ResourceData, meta interface{}) error {
@@ -129,7 +129,7 @@ func resourceDnsCaaRecordCreateUpdate(d *schema.ResourceData, meta interface{})
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   meta.(*clients.Client).Tags.Expand(t),
 			TTL:        &ttl,
 			CaaRecords: expandAzureRmDnsCaaRecords(d),
 		},
@@ -175,7 +175,7 @@ func resourceDnsCaaRecordRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("record", flattenAzureRmDnsCaaRecords(resp.CaaRecords)); err != nil {
 		return err
 	}
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Metadata)
 }
 
 func resourceDnsCaaRecordDelete(d *schema.ResourceData, meta interface{}) error {
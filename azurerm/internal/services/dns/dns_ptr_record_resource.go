@@ -101,7 +101,7 @@ func resourceDnsPtrRecordCreateUpdate(d *schema.ResourceData, meta interface{})
 
 	parameters := dns.RecordSet{
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   meta.(*clients.Client).Tags.Expand(t),
 			TTL:        &ttl,
 			PtrRecords: expandAzureRmDnsPtrRecords(d),
 		},
@@ -148,7 +148,7 @@ func resourceDnsPtrRecordRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("records", flattenAzureRmDnsPtrRecords(resp.PtrRecords)); err != nil {
 		return err
 	}
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Metadata)
 }
 
 func resourceDnsPtrRecordDelete(d *schema.ResourceData, meta interface{}) error {
@@ -124,7 +124,7 @@ func resourceDnsSrvRecordCreateUpdate(d *schema.ResourceData, meta interface{})
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   meta.(*clients.Client).Tags.Expand(t),
 			TTL:        &ttl,
 			SrvRecords: expandAzureRmDnsSrvRecords(d),
 		},
@@ -169,7 +169,7 @@ func resourceDnsSrvRecordRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("record", flattenAzureRmDnsSrvRecords(resp.SrvRecords)); err != nil {
 		return err
 	}
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Metadata)
 }
 
 func resourceDnsSrvRecordDelete(d *schema.ResourceData, meta interface{}) error {
@@ -110,7 +110,7 @@ func resourceDnsTxtRecordCreateUpdate(d *schema.ResourceData, meta interface{})
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   meta.(*clients.Client).Tags.Expand(t),
 			TTL:        &ttl,
 			TxtRecords: expandAzureRmDnsTxtRecords(d),
 		},
@@ -155,7 +155,7 @@ func resourceDnsTxtRecordRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("record", flattenAzureRmDnsTxtRecords(resp.TxtRecords)); err != nil {
 		return err
 	}
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Metadata)
 }
 
 func resourceDnsTxtRecordDelete(d *schema.ResourceData, meta interface{}) error {
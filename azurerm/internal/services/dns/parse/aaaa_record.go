@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AaaaRecordId struct {
@@ -73,3 +74,19 @@ func AaaaRecordID(input string) (*AaaaRecordId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "dns",
+		TypeName:           "AaaaRecord",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/AAAA/eheh1",
+		ResourceTypes:      []string{"azurerm_dns_aaaa_record"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := AaaaRecordID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
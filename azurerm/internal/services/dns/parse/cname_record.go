@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CnameRecordId struct {
@@ -73,3 +74,19 @@ func CnameRecordID(input string) (*CnameRecordId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "dns",
+		TypeName:           "CnameRecord",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/CNAME/name1",
+		ResourceTypes:      []string{"azurerm_dns_cname_record"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := CnameRecordID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DnsZoneId struct {
@@ -67,3 +68,19 @@ func DnsZoneID(input string) (*DnsZoneId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "dns",
+		TypeName:           "DnsZone",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1",
+		ResourceTypes:      []string{"azurerm_dns_zone"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DnsZoneID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
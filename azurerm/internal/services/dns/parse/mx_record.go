@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type MxRecordId struct {
@@ -73,3 +74,19 @@ func MxRecordID(input string) (*MxRecordId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "dns",
+		TypeName:           "MxRecord",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/MX/mx1",
+		ResourceTypes:      []string{"azurerm_dns_mx_record"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := MxRecordID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
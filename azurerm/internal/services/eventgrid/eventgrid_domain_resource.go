@@ -196,7 +196,7 @@ func resourceEventGridDomainCreateUpdate(d *schema.ResourceData, meta interface{
 	domain := eventgrid.Domain{
 		Location:         &location,
 		DomainProperties: domainProperties,
-		Tags:             tags.Expand(t),
+		Tags:             meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	log.Printf("[INFO] preparing arguments for AzureRM EventGrid Domain creation with Properties: %+v", domain)
@@ -289,7 +289,7 @@ func resourceEventGridDomainRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("primary_access_key", keys.Key1)
 	d.Set("secondary_access_key", keys.Key2)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceEventGridDomainDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DomainTopicId struct {
@@ -73,3 +74,19 @@ func DomainTopicID(input string) (*DomainTopicId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "eventgrid",
+		TypeName:           "DomainTopic",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/domains/domain1/topics/topic1",
+		ResourceTypes:      []string{"azurerm_eventgrid_domain_topic"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DomainTopicID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SystemTopicId struct {
@@ -67,3 +68,19 @@ func SystemTopicID(input string) (*SystemTopicId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "eventgrid",
+		TypeName:           "SystemTopic",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/systemTopics/systemTopic1",
+		ResourceTypes:      []string{"azurerm_eventgrid_system_topic"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SystemTopicID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub/migration"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -25,6 +27,7 @@ type ConsumerGroupObject struct {
 
 var _ sdk.Resource = ConsumerGroupResource{}
 var _ sdk.ResourceWithUpdate = ConsumerGroupResource{}
+var _ sdk.ResourceWithStateMigration = ConsumerGroupResource{}
 
 type ConsumerGroupResource struct {
 }
@@ -103,8 +106,7 @@ func (r ConsumerGroupResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("creating Consumer Group %q (EventHub %q / Namespace %q / Resource Group %q): %+v", state.Name, state.EventHubName, state.NamespaceName, state.ResourceGroupName, err)
 			}
 
-			metadata.SetID(id)
-			return nil
+			return metadata.SetID(id)
 		},
 		Timeout: 30 * time.Minute,
 	}
@@ -208,3 +210,15 @@ func (r ConsumerGroupResource) ModelObject() interface{} {
 func (r ConsumerGroupResource) IDValidationFunc() schema.SchemaValidateFunc {
 	return validate.EventHubConsumerGroupID
 }
+
+func (r ConsumerGroupResource) StateUpgraders() sdk.StateUpgradeData {
+	return sdk.StateUpgradeData{
+		SchemaVersion: 1,
+		Upgraders: map[int]sdk.StateUpgrade{
+			// prior versions of this Resource used the ID returned from the API, which can use `resourcegroups`
+			0: sdk.NewResourceIDStateUpgrade(migration.EventHubConsumerGroupUpgradeV0Schema().Schema, func(input string) (resourceid.Formatter, error) {
+				return parse.EventHubConsumerGroupID(input)
+			}),
+		},
+	}
+}
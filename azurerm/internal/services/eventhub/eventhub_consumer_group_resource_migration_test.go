@@ -0,0 +1,65 @@
+package eventhub_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub"
+)
+
+func TestEventHubConsumerGroupMigrateStateV0ToV1(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "Lower-cased Resource Group segment",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/consumergroup1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/consumergroup1",
+		},
+		{
+			Name:     "Already Normalized",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/consumergroup1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/consumergroup1",
+		},
+	}
+
+	wrapper := sdk.NewResourceWrapper(eventhub.ConsumerGroupResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+	if resource.SchemaVersion != 1 || len(resource.StateUpgraders) != 1 {
+		t.Fatalf("expected Schema Version 1 with a single State Upgrader but got Schema Version %d with %d", resource.SchemaVersion, len(resource.StateUpgraders))
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		input := map[string]interface{}{
+			"id":                  v.Input,
+			"name":                "consumergroup1",
+			"namespace_name":      "namespace1",
+			"eventhub_name":       "eventhub1",
+			"resource_group_name": "group1",
+		}
+		expected := map[string]interface{}{
+			"id":                  v.Expected,
+			"name":                "consumergroup1",
+			"namespace_name":      "namespace1",
+			"eventhub_name":       "eventhub1",
+			"resource_group_name": "group1",
+		}
+
+		actual, err := resource.StateUpgraders[0].Upgrade(input, nil)
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("expected %+v but got %+v", expected, actual)
+		}
+	}
+}
@@ -291,7 +291,7 @@ func resourceEventHubNamespaceCreateUpdate(d *schema.ResourceData, meta interfac
 			IsAutoInflateEnabled: utils.Bool(autoInflateEnabled),
 			ZoneRedundant:        utils.Bool(zoneRedundant),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if v := d.Get("dedicated_cluster_id").(string); v != "" {
@@ -417,7 +417,7 @@ func resourceEventHubNamespaceRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("default_secondary_key", keys.SecondaryKey)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceEventHubNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
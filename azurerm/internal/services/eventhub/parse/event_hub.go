@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EventHubId struct {
@@ -73,3 +74,19 @@ func EventHubID(input string) (*EventHubId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "eventhub",
+		TypeName:           "EventHub",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1",
+		ResourceTypes:      []string{"azurerm_eventhub"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := EventHubID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
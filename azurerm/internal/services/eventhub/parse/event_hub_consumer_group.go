@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EventHubConsumerGroupId struct {
@@ -79,3 +80,19 @@ func EventHubConsumerGroupID(input string) (*EventHubConsumerGroupId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "eventhub",
+		TypeName:           "EventHubConsumerGroup",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/consumergroup1",
+		ResourceTypes:      []string{"azurerm_eventhub_consumer_group"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := EventHubConsumerGroupID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
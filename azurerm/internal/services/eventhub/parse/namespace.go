@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NamespaceId struct {
@@ -67,3 +68,19 @@ func NamespaceID(input string) (*NamespaceId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "eventhub",
+		TypeName:           "Namespace",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1",
+		ResourceTypes:      []string{"azurerm_eventhub_namespace"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := NamespaceID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NamespaceAuthorizationRuleId struct {
@@ -129,3 +130,19 @@ func NamespaceAuthorizationRuleIDInsensitively(input string) (*NamespaceAuthoriz
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "eventhub",
+		TypeName:           "NamespaceAuthorizationRule",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/authorizationRules/rule1",
+		ResourceTypes:      []string{"azurerm_eventhub_namespace_authorization_rule"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := NamespaceAuthorizationRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
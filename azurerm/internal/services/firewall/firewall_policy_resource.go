@@ -200,7 +200,7 @@ func resourceFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}
 			DNSSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
 		},
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 	if id, ok := d.GetOk("base_policy_id"); ok {
 		props.FirewallPolicyPropertiesFormat.BasePolicy = &network.SubResource{ID: utils.String(id.(string))}
@@ -290,7 +290,7 @@ func resourceFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
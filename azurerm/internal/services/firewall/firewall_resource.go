@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/firewall/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/firewall/validate"
+	networkParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
@@ -233,7 +234,7 @@ func resourceFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 	i := d.Get("ip_configuration").([]interface{})
-	ipConfigs, subnetToLock, vnetToLock, err := expandFirewallIPConfigurations(i)
+	ipConfigs, idsToLock, err := expandFirewallIPConfigurations(i)
 	if err != nil {
 		return fmt.Errorf("Error building list of Azure Firewall IP Configurations: %+v", err)
 	}
@@ -241,7 +242,7 @@ func resourceFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	parameters := network.AzureFirewall{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		AzureFirewallPropertiesFormat: &network.AzureFirewallPropertiesFormat{
 			IPConfigurations:     ipConfigs,
 			ThreatIntelMode:      network.AzureFirewallThreatIntelMode(d.Get("threat_intel_mode").(string)),
@@ -252,18 +253,12 @@ func resourceFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	m := d.Get("management_ip_configuration").([]interface{})
 	if len(m) == 1 {
-		mgmtIPConfig, mgmtSubnetName, mgmtVirtualNetworkName, err := expandFirewallIPConfigurations(m)
+		mgmtIPConfig, mgmtIdsToLock, err := expandFirewallIPConfigurations(m)
 		if err != nil {
 			return fmt.Errorf("Error parsing Azure Firewall Management IP Configurations: %+v", err)
 		}
 
-		if !utils.SliceContainsValue(*subnetToLock, (*mgmtSubnetName)[0]) {
-			*subnetToLock = append(*subnetToLock, (*mgmtSubnetName)[0])
-		}
-
-		if !utils.SliceContainsValue(*vnetToLock, (*mgmtVirtualNetworkName)[0]) {
-			*vnetToLock = append(*vnetToLock, (*mgmtVirtualNetworkName)[0])
-		}
+		idsToLock = append(idsToLock, mgmtIdsToLock...)
 		if *mgmtIPConfig != nil {
 			parameters.ManagementIPConfiguration = &(*mgmtIPConfig)[0]
 		}
@@ -302,11 +297,8 @@ func resourceFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 	locks.ByName(name, azureFirewallResourceName)
 	defer locks.UnlockByName(name, azureFirewallResourceName)
 
-	locks.MultipleByName(vnetToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetToLock, SubnetResourceName)
+	locks.MultipleByResourceID(idsToLock...)
+	defer locks.UnlockMultipleByResourceID(idsToLock...)
 
 	if !d.IsNewResource() {
 		exists, err2 := client.Get(ctx, resourceGroup, name)
@@ -415,7 +407,7 @@ func resourceFirewallRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting `zones`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, read.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, read.Tags)
 }
 
 func resourceFirewallDelete(d *schema.ResourceData, meta interface{}) error {
@@ -441,8 +433,7 @@ func resourceFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error retrieving Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	subnetNamesToLock := make([]string, 0)
-	virtualNetworkNamesToLock := make([]string, 0)
+	idsToLock := make([]locks.ResourceID, 0)
 	if props := read.AzureFirewallPropertiesFormat; props != nil {
 		if configs := props.IPConfigurations; configs != nil {
 			for _, config := range *configs {
@@ -450,39 +441,21 @@ func resourceFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 					continue
 				}
 
-				parsedSubnetID, err2 := azure.ParseAzureResourceID(*config.Subnet.ID)
+				subnetIdsToLock, err2 := firewallSubnetIDsToLock(*config.Subnet.ID)
 				if err2 != nil {
 					return err2
 				}
-				subnetName := parsedSubnetID.Path["subnets"]
-
-				if !utils.SliceContainsValue(subnetNamesToLock, subnetName) {
-					subnetNamesToLock = append(subnetNamesToLock, subnetName)
-				}
-
-				virtualNetworkName := parsedSubnetID.Path["virtualNetworks"]
-				if !utils.SliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
-					virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
-				}
+				idsToLock = append(idsToLock, subnetIdsToLock...)
 			}
 		}
 
 		if mconfig := props.ManagementIPConfiguration; mconfig != nil {
 			if mconfig.Subnet != nil && mconfig.Subnet.ID != nil {
-				parsedSubnetID, err2 := azure.ParseAzureResourceID(*mconfig.Subnet.ID)
+				subnetIdsToLock, err2 := firewallSubnetIDsToLock(*mconfig.Subnet.ID)
 				if err2 != nil {
 					return err2
 				}
-				subnetName := parsedSubnetID.Path["subnets"]
-
-				if !utils.SliceContainsValue(subnetNamesToLock, subnetName) {
-					subnetNamesToLock = append(subnetNamesToLock, subnetName)
-				}
-
-				virtualNetworkName := parsedSubnetID.Path["virtualNetworks"]
-				if !utils.SliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
-					virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
-				}
+				idsToLock = append(idsToLock, subnetIdsToLock...)
 			}
 		}
 	}
@@ -490,11 +463,8 @@ func resourceFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 	locks.ByName(name, azureFirewallResourceName)
 	defer locks.UnlockByName(name, azureFirewallResourceName)
 
-	locks.MultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(&subnetNamesToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(&subnetNamesToLock, SubnetResourceName)
+	locks.MultipleByResourceID(idsToLock...)
+	defer locks.UnlockMultipleByResourceID(idsToLock...)
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -508,10 +478,9 @@ func resourceFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 	return err
 }
 
-func expandFirewallIPConfigurations(configs []interface{}) (*[]network.AzureFirewallIPConfiguration, *[]string, *[]string, error) {
+func expandFirewallIPConfigurations(configs []interface{}) (*[]network.AzureFirewallIPConfiguration, []locks.ResourceID, error) {
 	ipConfigs := make([]network.AzureFirewallIPConfiguration, 0)
-	subnetNamesToLock := make([]string, 0)
-	virtualNetworkNamesToLock := make([]string, 0)
+	idsToLock := make([]locks.ResourceID, 0)
 
 	for _, configRaw := range configs {
 		data := configRaw.(map[string]interface{})
@@ -529,21 +498,11 @@ func expandFirewallIPConfigurations(configs []interface{}) (*[]network.AzureFire
 		}
 
 		if subnetId != "" {
-			subnetID, err := azure.ParseAzureResourceID(subnetId)
+			subnetIdsToLock, err := firewallSubnetIDsToLock(subnetId)
 			if err != nil {
-				return nil, nil, nil, err
-			}
-
-			subnetName := subnetID.Path["subnets"]
-			virtualNetworkName := subnetID.Path["virtualNetworks"]
-
-			if !utils.SliceContainsValue(subnetNamesToLock, subnetName) {
-				subnetNamesToLock = append(subnetNamesToLock, subnetName)
-			}
-
-			if !utils.SliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
-				virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
+				return nil, nil, err
 			}
+			idsToLock = append(idsToLock, subnetIdsToLock...)
 
 			ipConfig.AzureFirewallIPConfigurationPropertiesFormat.Subnet = &network.SubResource{
 				ID: utils.String(subnetId),
@@ -551,7 +510,19 @@ func expandFirewallIPConfigurations(configs []interface{}) (*[]network.AzureFire
 		}
 		ipConfigs = append(ipConfigs, ipConfig)
 	}
-	return &ipConfigs, &subnetNamesToLock, &virtualNetworkNamesToLock, nil
+	return &ipConfigs, idsToLock, nil
+}
+
+// firewallSubnetIDsToLock returns the ID's of the Virtual Network and Subnet which need to be locked
+// when using the specified Subnet - duplicates are ignored when locking
+func firewallSubnetIDsToLock(input string) ([]locks.ResourceID, error) {
+	id, err := networkParse.SubnetID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	virtualNetworkId := networkParse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+	return []locks.ResourceID{virtualNetworkId, *id}, nil
 }
 
 func flattenFirewallIPConfigurations(input *[]network.AzureFirewallIPConfiguration) []interface{} {
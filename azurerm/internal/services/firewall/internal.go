@@ -1,6 +0,0 @@
-package firewall
-
-// TODO: determine what to do with these locking handlers, could we replace it with the ID?
-
-var SubnetResourceName = "azurerm_subnet"
-var VirtualNetworkResourceName = "azurerm_virtual_network"
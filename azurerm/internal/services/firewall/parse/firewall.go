@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallId struct {
@@ -67,3 +68,19 @@ func FirewallID(input string) (*FirewallId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "firewall",
+		TypeName:           "Firewall",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/azureFirewalls/firewall1",
+		ResourceTypes:      []string{"azurerm_firewall"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := FirewallID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallNatRuleCollectionId struct {
@@ -73,3 +74,19 @@ func FirewallNatRuleCollectionID(input string) (*FirewallNatRuleCollectionId, er
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "firewall",
+		TypeName:           "FirewallNatRuleCollection",
+		ExampleID:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1",
+		ResourceTypes:      []string{"azurerm_firewall_nat_rule_collection"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := FirewallNatRuleCollectionID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
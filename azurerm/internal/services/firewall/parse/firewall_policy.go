@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallPolicyId struct {
@@ -67,3 +68,19 @@ func FirewallPolicyID(input string) (*FirewallPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "firewall",
+		TypeName:           "FirewallPolicy",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/firewallPolicies/policy1",
+		ResourceTypes:      []string{"azurerm_firewall_policy"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := FirewallPolicyID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallPolicyRuleCollectionGroupId struct {
@@ -73,3 +74,19 @@ func FirewallPolicyRuleCollectionGroupID(input string) (*FirewallPolicyRuleColle
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "firewall",
+		TypeName:           "FirewallPolicyRuleCollectionGroup",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/firewallPolicies/policy1/ruleCollectionGroups/ruleCollectionGroup1",
+		ResourceTypes:      []string{"azurerm_firewall_policy_rule_collection_group"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := FirewallPolicyRuleCollectionGroupID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
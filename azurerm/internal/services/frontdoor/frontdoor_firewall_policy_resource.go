@@ -482,7 +482,7 @@ func resourceFrontDoorFirewallPolicyCreateUpdate(d *schema.ResourceData, meta in
 			CustomRules:  expandFrontDoorFirewallCustomRules(customRules),
 			ManagedRules: expandFrontDoorFirewallManagedRules(managedRules),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if redirectUrl != "" {
@@ -556,7 +556,7 @@ func resourceFrontDoorFirewallPolicyRead(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceFrontDoorFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
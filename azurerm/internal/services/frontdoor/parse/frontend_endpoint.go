@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FrontendEndpointId struct {
@@ -129,3 +130,19 @@ func FrontendEndpointIDInsensitively(input string) (*FrontendEndpointId, error)
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "frontdoor",
+		TypeName:           "FrontendEndpoint",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoors/frontdoor1/frontendEndpoints/endpoint1",
+		ResourceTypes:      []string{"azurerm_frontdoor_custom_https_configuration"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := FrontendEndpointID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancingId struct {
@@ -129,3 +130,18 @@ func LoadBalancingIDInsensitively(input string) (*LoadBalancingId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "frontdoor",
+		TypeName:           "LoadBalancing",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoors/frontdoor1/loadBalancingSettings/setting1",
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := LoadBalancingID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
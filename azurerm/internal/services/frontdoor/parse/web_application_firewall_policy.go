@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type WebApplicationFirewallPolicyId struct {
@@ -111,3 +112,19 @@ func WebApplicationFirewallPolicyIDInsensitively(input string) (*WebApplicationF
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "frontdoor",
+		TypeName:           "WebApplicationFirewallPolicy",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoorWebApplicationFirewallPolicies/policy1",
+		ResourceTypes:      []string{"azurerm_frontdoor_firewall_policy"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := WebApplicationFirewallPolicyID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -249,7 +249,7 @@ func resourceHDInsightHadoopClusterCreate(d *schema.ResourceData, meta interface
 				Roles: roles,
 			},
 		},
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		Identity: identity,
 	}
 	future, err := client.Create(ctx, resourceGroup, name, params)
@@ -407,7 +407,7 @@ func resourceHDInsightHadoopClusterRead(d *schema.ResourceData, meta interface{}
 		d.Set("monitor", flattenHDInsightMonitoring(monitor))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func flattenHDInsightEdgeNode(roles []interface{}, props *hdinsight.ApplicationProperties) []interface{} {
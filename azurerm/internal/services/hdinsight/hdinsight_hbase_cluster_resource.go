@@ -202,7 +202,7 @@ func resourceHDInsightHBaseClusterCreate(d *schema.ResourceData, meta interface{
 				Roles: roles,
 			},
 		},
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		Identity: identity,
 	}
 	future, err := client.Create(ctx, resourceGroup, name, params)
@@ -320,7 +320,7 @@ func resourceHDInsightHBaseClusterRead(d *schema.ResourceData, meta interface{})
 		d.Set("monitor", flattenHDInsightMonitoring(monitor))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func expandHDInsightHBaseComponentVersion(input []interface{}) map[string]*string {
@@ -202,7 +202,7 @@ func resourceHDInsightInteractiveQueryClusterCreate(d *schema.ResourceData, meta
 				Roles: roles,
 			},
 		},
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		Identity: identity,
 	}
 	future, err := client.Create(ctx, resourceGroup, name, params)
@@ -320,7 +320,7 @@ func resourceHDInsightInteractiveQueryClusterRead(d *schema.ResourceData, meta i
 		d.Set("monitor", flattenHDInsightMonitoring(monitor))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func expandHDInsightInteractiveQueryComponentVersion(input []interface{}) map[string]*string {
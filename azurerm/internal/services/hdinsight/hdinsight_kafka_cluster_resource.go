@@ -247,7 +247,7 @@ func resourceHDInsightKafkaClusterCreate(d *schema.ResourceData, meta interface{
 			},
 			KafkaRestProperties: kafkaRestProperty,
 		},
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		Identity: identity,
 	}
 
@@ -372,7 +372,7 @@ func resourceHDInsightKafkaClusterRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func expandHDInsightKafkaComponentVersion(input []interface{}) map[string]*string {
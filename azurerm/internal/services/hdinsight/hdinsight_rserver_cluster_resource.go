@@ -210,7 +210,7 @@ func resourceHDInsightRServerClusterCreate(d *schema.ResourceData, meta interfac
 				Roles: roles,
 			},
 		},
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		Identity: identity,
 	}
 	future, err := client.Create(ctx, resourceGroup, name, params)
@@ -320,5 +320,5 @@ func resourceHDInsightRServerClusterRead(d *schema.ResourceData, meta interface{
 		d.Set("ssh_endpoint", sshEndpoint)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
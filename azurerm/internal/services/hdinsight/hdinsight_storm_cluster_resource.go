@@ -204,7 +204,7 @@ func resourceHDInsightStormClusterCreate(d *schema.ResourceData, meta interface{
 				Roles: roles,
 			},
 		},
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		Identity: identity,
 	}
 	future, err := client.Create(ctx, resourceGroup, name, params)
@@ -322,7 +322,7 @@ func resourceHDInsightStormClusterRead(d *schema.ResourceData, meta interface{})
 		d.Set("monitor", flattenHDInsightMonitoring(monitor))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func expandHDInsightStormComponentVersion(input []interface{}) map[string]*string {
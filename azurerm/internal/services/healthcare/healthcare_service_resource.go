@@ -190,7 +190,7 @@ func resourceHealthcareServiceCreateUpdate(d *schema.ResourceData, meta interfac
 
 	healthcareServiceDescription := healthcareapis.ServicesDescription{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		Kind:     healthcareapis.Kind(kind),
 		Properties: &healthcareapis.ServicesProperties{
 			AccessPolicies: expandAzureRMhealthcareapisAccessPolicyEntries(d),
@@ -274,7 +274,7 @@ func resourceHealthcareServiceRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceHealthcareServiceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CacheId struct {
@@ -67,3 +68,19 @@ func CacheID(input string) (*CacheId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "hpccache",
+		TypeName:           "Cache",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.StorageCache/caches/cache1",
+		ResourceTypes:      []string{"azurerm_hpc_cache"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := CacheID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageTargetId struct {
@@ -73,3 +74,19 @@ func StorageTargetID(input string) (*StorageTargetId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "hpccache",
+		TypeName:           "StorageTarget",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.StorageCache/caches/cache1/storageTargets/target1",
+		ResourceTypes:      []string{"azurerm_hpc_cache_blob_target", "azurerm_hpc_cache_nfs_target"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := StorageTargetID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -1,4 +1,4 @@
 package hpccache
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Cache -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.StorageCache/caches/cache1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=StorageTarget -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.StorageCache/caches/cache1/storageTargets/target1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Cache -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.StorageCache/caches/cache1 -resource-types=azurerm_hpc_cache
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=StorageTarget -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.StorageCache/caches/cache1/storageTargets/target1 -resource-types=azurerm_hpc_cache_blob_target,azurerm_hpc_cache_nfs_target
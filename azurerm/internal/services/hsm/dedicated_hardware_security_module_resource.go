@@ -131,7 +131,7 @@ func resourceDedicatedHardwareSecurityModuleCreate(d *schema.ResourceData, meta
 		Sku: &hardwaresecuritymodules.Sku{
 			Name: hardwaresecuritymodules.Name(d.Get("sku_name").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("stamp_id"); ok {
@@ -204,7 +204,7 @@ func resourceDedicatedHardwareSecurityModuleRead(d *schema.ResourceData, meta in
 		return fmt.Errorf("setting `zones`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceDedicatedHardwareSecurityModuleUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -218,8 +218,8 @@ func resourceDedicatedHardwareSecurityModuleUpdate(d *schema.ResourceData, meta
 	}
 
 	parameters := hardwaresecuritymodules.DedicatedHsmPatchParameters{}
-	if d.HasChange("tags") {
-		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		parameters.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.DedicatedHSMName, parameters)
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DedicatedHardwareSecurityModuleId struct {
@@ -67,3 +68,19 @@ func DedicatedHardwareSecurityModuleID(input string) (*DedicatedHardwareSecurity
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "hsm",
+		TypeName:           "DedicatedHardwareSecurityModule",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1",
+		ResourceTypes:      []string{"azurerm_dedicated_hardware_security_module"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DedicatedHardwareSecurityModuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
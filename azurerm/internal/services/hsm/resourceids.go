@@ -1,3 +1,3 @@
 package hsm
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=DedicatedHardwareSecurityModule -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=DedicatedHardwareSecurityModule -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1 -resource-types=azurerm_dedicated_hardware_security_module
@@ -131,7 +131,7 @@ func resourceIotCentralAppCreate(d *schema.ResourceData, meta interface{}) error
 			Name: iotcentral.AppSku(d.Get("sku").(string)),
 		},
 		Location: &location,
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, app)
@@ -174,7 +174,7 @@ func resourceIotCentralAppUpdate(d *schema.ResourceData, meta interface{}) error
 	subdomain := d.Get("sub_domain").(string)
 	template := d.Get("template").(string)
 	appPatch := iotcentral.AppPatch{
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 		AppProperties: &iotcentral.AppProperties{
 			DisplayName: &displayName,
 			Subdomain:   &subdomain,
@@ -238,7 +238,7 @@ func resourceIotCentralAppRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("template", props.Template)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceIotCentralAppDelete(d *schema.ResourceData, meta interface{}) error {
@@ -175,7 +175,7 @@ func resourceIotHubDPSCreateUpdate(d *schema.ResourceData, meta interface{}) err
 		Properties: &iothub.IotDpsPropertiesDescription{
 			IotHubs: expandIoTHubDPSIoTHubs(d.Get("linked_hub").([]interface{})),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, iotdps)
@@ -248,7 +248,7 @@ func resourceIotHubDPSRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("allocation_policy", props.AllocationPolicy)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceIotHubDPSDelete(d *schema.ResourceData, meta interface{}) error {
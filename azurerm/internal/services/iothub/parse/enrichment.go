@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EnrichmentId struct {
@@ -73,3 +74,19 @@ func EnrichmentID(input string) (*EnrichmentId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "iothub",
+		TypeName:           "Enrichment",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/hub1/Enrichments/enrichment1",
+		ResourceTypes:      []string{"azurerm_iothub_enrichment"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := EnrichmentID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
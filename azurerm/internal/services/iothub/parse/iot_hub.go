@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IotHubId struct {
@@ -67,3 +68,19 @@ func IotHubID(input string) (*IotHubId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "iothub",
+		TypeName:           "IotHub",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/hub1",
+		ResourceTypes:      []string{"azurerm_iothub"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := IotHubID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -147,7 +147,7 @@ func resourceIoTTimeSeriesInsightsStandardEnvironmentCreateUpdate(d *schema.Reso
 
 	environment := timeseriesinsights.Gen1EnvironmentCreateOrUpdateParameters{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		Sku:      sku,
 		Gen1EnvironmentCreationProperties: &timeseriesinsights.Gen1EnvironmentCreationProperties{
 			StorageLimitExceededBehavior: timeseriesinsights.StorageLimitExceededBehavior(d.Get("storage_limit_exceeded_behavior").(string)),
@@ -235,7 +235,7 @@ func resourceIoTTimeSeriesInsightsStandardEnvironmentRead(d *schema.ResourceData
 		}
 	}
 
-	return tags.FlattenAndSet(d, environment.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, environment.Tags)
 }
 
 func resourceIoTTimeSeriesInsightsStandardEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AccessPolicyId struct {
@@ -73,3 +74,19 @@ func AccessPolicyID(input string) (*AccessPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "iottimeseriesinsights",
+		TypeName:           "AccessPolicy",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/accessPolicies/policy1",
+		ResourceTypes:      []string{"azurerm_iot_time_series_insights_access_policy"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := AccessPolicyID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
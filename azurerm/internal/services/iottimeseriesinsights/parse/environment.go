@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EnvironmentId struct {
@@ -67,3 +68,19 @@ func EnvironmentID(input string) (*EnvironmentId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "iottimeseriesinsights",
+		TypeName:           "Environment",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1",
+		ResourceTypes:      []string{"azurerm_iot_time_series_insights_gen2_environment", "azurerm_iot_time_series_insights_standard_environment"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := EnvironmentID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -394,7 +394,7 @@ func resourceKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{})
 			Base64EncodedCertificate: utils.String(certificate.CertificateData),
 			Password:                 utils.String(certificate.CertificatePassword),
 			CertificatePolicy:        &policy,
-			Tags:                     tags.Expand(t),
+			Tags:                     meta.(*clients.Client).Tags.Expand(t),
 		}
 		if _, err := client.ImportCertificate(ctx, *keyVaultBaseUrl, name, importParameters); err != nil {
 			return err
@@ -403,7 +403,7 @@ func resourceKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{})
 		// Generate new
 		parameters := keyvault.CertificateCreateParameters{
 			CertificatePolicy: &policy,
-			Tags:              tags.Expand(t),
+			Tags:              meta.(*clients.Client).Tags.Expand(t),
 		}
 		if resp, err := client.CreateCertificate(ctx, *keyVaultBaseUrl, name, parameters); err != nil {
 			if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeyVaults && utils.ResponseWasConflict(resp.Response) {
@@ -572,7 +572,7 @@ func resourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) e
 	}
 	d.Set("thumbprint", thumbprint)
 
-	return tags.FlattenAndSet(d, cert.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, cert.Tags)
 }
 
 func resourceKeyVaultCertificateDelete(d *schema.ResourceData, meta interface{}) error {
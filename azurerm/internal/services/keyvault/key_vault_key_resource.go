@@ -203,7 +203,7 @@ func resourceKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
 			Enabled: utils.Bool(true),
 		},
 
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if parameters.Kty == keyvault.EC || parameters.Kty == keyvault.ECHSM {
@@ -313,7 +313,7 @@ func resourceKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		KeyAttributes: &keyvault.KeyAttributes{
 			Enabled: utils.Bool(true),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if v, ok := d.GetOk("not_before_date"); ok {
@@ -421,7 +421,7 @@ func resourceKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("version", id.Version)
 	d.Set("versionless_id", fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(id.KeyVaultBaseUrl, "/"), id.NestedItemType, id.Name))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
@@ -25,7 +25,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/migration"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	networkParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/set"
@@ -327,7 +326,7 @@ func resourceKeyVaultCreate(d *schema.ResourceData, meta interface{}) error {
 			// documentation with further details
 			EnableSoftDelete: utils.Bool(true),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if purgeProtectionEnabled := d.Get("purge_protection_enabled").(bool); purgeProtectionEnabled {
@@ -343,19 +342,17 @@ func resourceKeyVaultCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
-	virtualNetworkNames := make([]string, 0)
+	virtualNetworkIds := make([]locks.ResourceID, 0)
 	for _, v := range subnetIds {
 		id, err := networkParse.SubnetIDInsensitively(v)
 		if err != nil {
 			return err
 		}
-		if !utils.SliceContainsValue(virtualNetworkNames, id.VirtualNetworkName) {
-			virtualNetworkNames = append(virtualNetworkNames, id.VirtualNetworkName)
-		}
+		virtualNetworkIds = append(virtualNetworkIds, networkParse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName))
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	locks.MultipleByResourceID(virtualNetworkIds...)
+	defer locks.UnlockMultipleByResourceID(virtualNetworkIds...)
 
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -485,20 +482,18 @@ func resourceKeyVaultUpdate(d *schema.ResourceData, meta interface{}) error {
 		networkAcls, subnetIds := expandKeyVaultNetworkAcls(networkAclsRaw)
 
 		// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
-		virtualNetworkNames := make([]string, 0)
+		virtualNetworkIds := make([]locks.ResourceID, 0)
 		for _, v := range subnetIds {
 			id, err := networkParse.SubnetIDInsensitively(v)
 			if err != nil {
 				return err
 			}
 
-			if !utils.SliceContainsValue(virtualNetworkNames, id.VirtualNetworkName) {
-				virtualNetworkNames = append(virtualNetworkNames, id.VirtualNetworkName)
-			}
+			virtualNetworkIds = append(virtualNetworkIds, networkParse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName))
 		}
 
-		locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-		defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+		locks.MultipleByResourceID(virtualNetworkIds...)
+		defer locks.UnlockMultipleByResourceID(virtualNetworkIds...)
 
 		update.Properties.NetworkAcls = networkAcls
 	}
@@ -566,9 +561,9 @@ func resourceKeyVaultUpdate(d *schema.ResourceData, meta interface{}) error {
 		update.Properties.TenantID = &tenantUUID
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		t := d.Get("tags").(map[string]interface{})
-		update.Tags = tags.Expand(t)
+		update.Tags = meta.(*clients.Client).Tags.Expand(t)
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, update); err != nil {
@@ -687,7 +682,7 @@ func resourceKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `contact` for KeyVault: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
@@ -730,7 +725,7 @@ func resourceKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// ensure we lock on the latest network names, to ensure we handle Azure's networking layer being limited to one change at a time
-	virtualNetworkNames := make([]string, 0)
+	virtualNetworkIds := make([]locks.ResourceID, 0)
 	if props := read.Properties; props != nil {
 		if acls := props.NetworkAcls; acls != nil {
 			if rules := acls.VirtualNetworkRules; rules != nil {
@@ -744,16 +739,14 @@ func resourceKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 						return err
 					}
 
-					if !utils.SliceContainsValue(virtualNetworkNames, subnetId.VirtualNetworkName) {
-						virtualNetworkNames = append(virtualNetworkNames, subnetId.VirtualNetworkName)
-					}
+					virtualNetworkIds = append(virtualNetworkIds, networkParse.NewVirtualNetworkID(subnetId.SubscriptionId, subnetId.ResourceGroup, subnetId.VirtualNetworkName))
 				}
 			}
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	locks.MultipleByResourceID(virtualNetworkIds...)
+	defer locks.UnlockMultipleByResourceID(virtualNetworkIds...)
 
 	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VaultId struct {
@@ -67,3 +68,19 @@ func VaultID(input string) (*VaultId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "keyvault",
+		TypeName:           "Vault",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1",
+		ResourceTypes:      []string{"azurerm_key_vault"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := VaultID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
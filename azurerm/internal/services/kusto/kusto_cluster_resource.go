@@ -298,7 +298,7 @@ func resourceKustoClusterCreateUpdate(d *schema.ResourceData, meta interface{})
 		Sku:               sku,
 		Zones:             zones,
 		ClusterProperties: &clusterProperties,
-		Tags:              tags.Expand(t),
+		Tags:              meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if _, ok := d.GetOk("identity"); ok {
@@ -431,7 +431,7 @@ func resourceKustoClusterRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("engine", clusterProperties.EngineType)
 	}
 
-	return tags.FlattenAndSet(d, clusterResponse.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, clusterResponse.Tags)
 }
 
 func resourceKustoClusterDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AttachedDatabaseConfigurationId struct {
@@ -73,3 +74,19 @@ func AttachedDatabaseConfigurationID(input string) (*AttachedDatabaseConfigurati
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "kusto",
+		TypeName:           "AttachedDatabaseConfiguration",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Kusto/Clusters/cluster1/AttachedDatabaseConfigurations/config1",
+		ResourceTypes:      []string{"azurerm_kusto_attached_database_configuration"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := AttachedDatabaseConfigurationID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
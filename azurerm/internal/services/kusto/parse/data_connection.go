@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DataConnectionId struct {
@@ -79,3 +80,19 @@ func DataConnectionID(input string) (*DataConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "kusto",
+		TypeName:           "DataConnection",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Kusto/Clusters/cluster1/Databases/database1/DataConnections/connection1",
+		ResourceTypes:      []string{"azurerm_kusto_eventgrid_data_connection", "azurerm_kusto_eventhub_data_connection", "azurerm_kusto_iothub_data_connection"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DataConnectionID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabaseId struct {
@@ -73,3 +74,19 @@ func DatabaseID(input string) (*DatabaseId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "kusto",
+		TypeName:           "Database",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Kusto/Clusters/cluster1/Databases/database1",
+		ResourceTypes:      []string{"azurerm_kusto_database"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DatabaseID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
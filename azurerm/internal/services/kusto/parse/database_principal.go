@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabasePrincipalId struct {
@@ -85,3 +86,19 @@ func DatabasePrincipalID(input string) (*DatabasePrincipalId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "kusto",
+		TypeName:           "DatabasePrincipal",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Kusto/Clusters/cluster1/Databases/database1/Role/Viewer/FQN/aaduser=11111111-1111-1111-1111-111111111111;22222222-2222-2222-2222-222222222222",
+		ResourceTypes:      []string{"azurerm_kusto_database_principal"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DatabasePrincipalID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
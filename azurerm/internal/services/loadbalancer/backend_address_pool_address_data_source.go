@@ -0,0 +1,29 @@
+package loadbalancer
+
+import (
+	"context"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loadbalancer/parse"
+)
+
+// BackendAddressPoolAddressDataSource returns a Data Source for an existing Backend Address Pool Address,
+// which reuses the Schema and Read function from the Resource
+func BackendAddressPoolAddressDataSource() sdk.DataSource {
+	return sdk.DataSourceFromResource(BackendAddressPoolAddressResource{}, []string{"name", "backend_address_pool_id"}, backendAddressPoolAddressDataSourceID)
+}
+
+func backendAddressPoolAddressDataSourceID(_ context.Context, metadata sdk.ResourceMetaData) (resourceid.Formatter, error) {
+	var model BackendAddressPoolAddressModel
+	if err := metadata.Decode(&model); err != nil {
+		return nil, err
+	}
+
+	poolId, err := parse.LoadBalancerBackendAddressPoolID(model.BackendAddressPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return parse.NewBackendAddressPoolAddressID(poolId.SubscriptionId, poolId.ResourceGroup, poolId.LoadBalancerName, poolId.BackendAddressPoolName, model.Name), nil
+}
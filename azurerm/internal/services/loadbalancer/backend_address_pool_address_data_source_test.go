@@ -0,0 +1,38 @@
+package loadbalancer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+func TestAccDataSourceBackendAddressPoolAddress_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_lb_backend_address_pool_address", "test")
+	r := BackendAddressPoolAddressResourceTests{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.dataSourceBasic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("ip_address").HasValue("191.168.0.1"),
+				check.That(data.ResourceName).Key("virtual_network_id").Exists(),
+			),
+		},
+	})
+}
+
+func (t BackendAddressPoolAddressResourceTests) dataSourceBasic(data acceptance.TestData) string {
+	template := t.basic(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_lb_backend_address_pool_address" "test" {
+  name                    = azurerm_lb_backend_address_pool_address.test.name
+  backend_address_pool_id = azurerm_lb_backend_address_pool_address.test.backend_address_pool_id
+}
+`, template)
+}
@@ -147,8 +147,7 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
 				return fmt.Errorf("waiting for update of %s: %+v", id, err)
 			}
-			metadata.SetID(id)
-			return nil
+			return metadata.SetID(id)
 		},
 		Timeout: 30 * time.Minute,
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BackendAddressPoolAddressId struct {
@@ -79,3 +80,19 @@ func BackendAddressPoolAddressID(input string) (*BackendAddressPoolAddressId, er
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "loadbalancer",
+		TypeName:           "BackendAddressPoolAddress",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/backendAddressPools/backendAddressPool1/addresses/address1",
+		ResourceTypes:      []string{"azurerm_lb_backend_address_pool_address"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := BackendAddressPoolAddressID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
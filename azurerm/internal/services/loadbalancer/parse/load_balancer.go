@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerId struct {
@@ -67,3 +68,19 @@ func LoadBalancerID(input string) (*LoadBalancerId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "loadbalancer",
+		TypeName:           "LoadBalancer",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1",
+		ResourceTypes:      []string{"azurerm_lb"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := LoadBalancerID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
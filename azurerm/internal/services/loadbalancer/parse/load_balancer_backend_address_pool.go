@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerBackendAddressPoolId struct {
@@ -73,3 +74,19 @@ func LoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddress
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "loadbalancer",
+		TypeName:           "LoadBalancerBackendAddressPool",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/backendAddressPools/backendAddressPool1",
+		ResourceTypes:      []string{"azurerm_lb_backend_address_pool"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := LoadBalancerBackendAddressPoolID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
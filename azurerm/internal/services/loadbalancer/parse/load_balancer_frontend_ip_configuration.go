@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerFrontendIpConfigurationId struct {
@@ -73,3 +74,18 @@ func LoadBalancerFrontendIpConfigurationID(input string) (*LoadBalancerFrontendI
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "loadbalancer",
+		TypeName:           "LoadBalancerFrontendIpConfiguration",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/frontendIPConfigurations/frontendIPConfig1",
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := LoadBalancerFrontendIpConfigurationID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
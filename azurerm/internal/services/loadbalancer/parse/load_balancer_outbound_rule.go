@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerOutboundRuleId struct {
@@ -73,3 +74,19 @@ func LoadBalancerOutboundRuleID(input string) (*LoadBalancerOutboundRuleId, erro
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "loadbalancer",
+		TypeName:           "LoadBalancerOutboundRule",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/outboundRules/rule1",
+		ResourceTypes:      []string{"azurerm_lb_outbound_rule"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := LoadBalancerOutboundRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -24,7 +24,9 @@ func (r Registration) WebsiteCategories() []string {
 
 // DataSources returns a list of Data Sources supported by this Service
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		BackendAddressPoolAddressDataSource(),
+	}
 }
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
@@ -131,7 +131,7 @@ func resourceLogAnalyticsClusterCreate(d *schema.ResourceData, meta interface{})
 			Capacity: utils.Int64(int64(d.Get("size_gb").(int))),
 			Name:     operationalinsights.CapacityReservation,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
@@ -194,7 +194,7 @@ func resourceLogAnalyticsClusterRead(d *schema.ResourceData, meta interface{}) e
 	}
 	d.Set("size_gb", capacity)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceLogAnalyticsClusterUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -216,8 +216,8 @@ func resourceLogAnalyticsClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
-		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		parameters.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.ClusterName, parameters); err != nil {
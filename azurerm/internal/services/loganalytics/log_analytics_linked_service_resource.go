@@ -263,7 +263,7 @@ func resourceLogAnalyticsLinkedServiceCreateUpdate(d *schema.ResourceData, meta
 
 	parameters := operationalinsights.LinkedService{
 		LinkedServiceProperties: &operationalinsights.LinkedServiceProperties{},
-		Tags:                    tags.Expand(t),
+		Tags:                    meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if id.LinkedServiceName == "Automation" {
@@ -329,7 +329,7 @@ func resourceLogAnalyticsLinkedServiceRead(d *schema.ResourceData, meta interfac
 		d.Set("write_access_id", props.WriteAccessResourceID)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceLogAnalyticsLinkedServiceDelete(d *schema.ResourceData, meta interface{}) error {
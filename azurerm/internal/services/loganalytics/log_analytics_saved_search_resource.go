@@ -133,7 +133,7 @@ func resourceLogAnalyticsSavedSearchCreate(d *schema.ResourceData, meta interfac
 			DisplayName:   utils.String(d.Get("display_name").(string)),
 			Query:         utils.String(d.Get("query").(string)),
 			FunctionAlias: utils.String(d.Get("function_alias").(string)),
-			Tags:          expandSavedSearchTag(d.Get("tags").(map[string]interface{})), // expand tags because it's defined as object set in service
+			Tags:          expandSavedSearchTag(meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))), // expand tags because it's defined as object set in service
 		},
 	}
 
@@ -202,8 +202,8 @@ func resourceLogAnalyticsSavedSearchRead(d *schema.ResourceData, meta interface{
 		d.Set("function_parameters", functionParams)
 
 		// flatten tags because it's defined as object set in service
-		if err := d.Set("tags", flattenSavedSearchTag(props.Tags)); err != nil {
-			return fmt.Errorf("setting `tag`: %+v", err)
+		if err := meta.(*clients.Client).Tags.FlattenAndSet(d, flattenSavedSearchTag(props.Tags)); err != nil {
+			return err
 		}
 	}
 
@@ -227,20 +227,20 @@ func resourceLogAnalyticsSavedSearchDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func expandSavedSearchTag(input map[string]interface{}) *[]operationalinsights.Tag {
+func expandSavedSearchTag(input map[string]*string) *[]operationalinsights.Tag {
 	results := make([]operationalinsights.Tag, 0)
 	for key, value := range input {
 		result := operationalinsights.Tag{
 			Name:  utils.String(key),
-			Value: utils.String(value.(string)),
+			Value: value,
 		}
 		results = append(results, result)
 	}
 	return &results
 }
 
-func flattenSavedSearchTag(input *[]operationalinsights.Tag) map[string]interface{} {
-	results := make(map[string]interface{})
+func flattenSavedSearchTag(input *[]operationalinsights.Tag) map[string]*string {
+	results := make(map[string]*string)
 	if input == nil {
 		return results
 	}
@@ -250,9 +250,9 @@ func flattenSavedSearchTag(input *[]operationalinsights.Tag) map[string]interfac
 		if item.Name != nil {
 			key = *item.Name
 		}
-		var value string
-		if item.Value != nil {
-			value = *item.Value
+		value := item.Value
+		if value == nil {
+			value = utils.String("")
 		}
 		results[key] = value
 	}
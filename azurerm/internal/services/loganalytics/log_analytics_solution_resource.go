@@ -136,7 +136,7 @@ func resourceLogAnalyticsSolutionCreateUpdate(d *schema.ResourceData, meta inter
 		Properties: &operationsmanagement.SolutionProperties{
 			WorkspaceResourceID: utils.String(workspaceID),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
@@ -222,7 +222,7 @@ func resourceLogAnalyticsSolutionRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error setting `plan`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceLogAnalyticsSolutionDelete(d *schema.ResourceData, meta interface{}) error {
@@ -127,7 +127,7 @@ func resourceLogAnalyticsStorageInsightsCreateUpdate(d *schema.ResourceData, met
 		StorageInsightProperties: &operationalinsights.StorageInsightProperties{
 			StorageAccount: expandStorageInsightConfigStorageAccount(storageAccountId, storageAccountKey),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, ok := d.GetOk("table_names"); ok {
@@ -180,7 +180,7 @@ func resourceLogAnalyticsStorageInsightsRead(d *schema.ResourceData, meta interf
 		d.Set("table_names", utils.FlattenStringSlice(props.Tables))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceLogAnalyticsStorageInsightsDelete(d *schema.ResourceData, meta interface{}) error {
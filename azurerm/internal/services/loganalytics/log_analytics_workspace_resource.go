@@ -199,7 +199,7 @@ func resourceLogAnalyticsWorkspaceCreateUpdate(d *schema.ResourceData, meta inte
 	parameters := operationalinsights.Workspace{
 		Name:     &name,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		WorkspaceProperties: &operationalinsights.WorkspaceProperties{
 			Sku:                             sku,
 			PublicNetworkAccessForIngestion: internetIngestionEnabled,
@@ -299,7 +299,7 @@ func resourceLogAnalyticsWorkspaceRead(d *schema.ResourceData, meta interface{})
 		d.Set("secondary_shared_key", sharedKeys.SecondarySharedKey)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceLogAnalyticsWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
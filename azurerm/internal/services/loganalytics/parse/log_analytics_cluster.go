@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsClusterId struct {
@@ -67,3 +68,19 @@ func LogAnalyticsClusterID(input string) (*LogAnalyticsClusterId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "loganalytics",
+		TypeName:           "LogAnalyticsCluster",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/clusters/cluster1",
+		ResourceTypes:      []string{"azurerm_log_analytics_cluster"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := LogAnalyticsClusterID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsDataExportId struct {
@@ -73,3 +74,19 @@ func LogAnalyticsDataExportID(input string) (*LogAnalyticsDataExportId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "loganalytics",
+		TypeName:           "LogAnalyticsDataExport",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/dataexports/dataExport1",
+		ResourceTypes:      []string{"azurerm_log_analytics_data_export_rule"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := LogAnalyticsDataExportID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsSavedSearchId struct {
@@ -73,3 +74,19 @@ func LogAnalyticsSavedSearchID(input string) (*LogAnalyticsSavedSearchId, error)
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "loganalytics",
+		TypeName:           "LogAnalyticsSavedSearch",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/savedSearches/search1",
+		ResourceTypes:      []string{"azurerm_log_analytics_saved_search"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := LogAnalyticsSavedSearchID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
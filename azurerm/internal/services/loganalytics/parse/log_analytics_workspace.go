@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsWorkspaceId struct {
@@ -67,3 +68,19 @@ func LogAnalyticsWorkspaceID(input string) (*LogAnalyticsWorkspaceId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "loganalytics",
+		TypeName:           "LogAnalyticsWorkspace",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1",
+		ResourceTypes:      []string{"azurerm_log_analytics_workspace"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := LogAnalyticsWorkspaceID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -1,11 +1,11 @@
 package loganalytics
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsCluster -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/clusters/cluster1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsDataExport -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/dataexports/dataExport1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsDataSourceWindowsEvent -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/dataSources/dataSource1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsLinkedService -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/linkedServices/linkedService1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsLinkedStorageAccount -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/linkedStorageAccounts/query
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsSavedSearch -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/savedSearches/search1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsSolution -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationsManagement/solutions/solution1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsStorageInsights -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/storageInsightConfigs/storageInsight1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsWorkspace -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsCluster -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/clusters/cluster1 -resource-types=azurerm_log_analytics_cluster
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsDataExport -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/dataexports/dataExport1 -resource-types=azurerm_log_analytics_data_export_rule
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsDataSourceWindowsEvent -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/dataSources/dataSource1 -resource-types=azurerm_log_analytics_datasource_windows_event,azurerm_log_analytics_datasource_windows_performance_counter
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsLinkedService -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/linkedServices/linkedService1 -resource-types=azurerm_log_analytics_linked_service
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsLinkedStorageAccount -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/linkedStorageAccounts/query -resource-types=azurerm_log_analytics_linked_storage_account
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsSavedSearch -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/savedSearches/search1 -resource-types=azurerm_log_analytics_saved_search
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsSolution -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationsManagement/solutions/solution1 -resource-types=azurerm_log_analytics_solution
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsStorageInsights -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/storageInsightConfigs/storageInsight1 -resource-types=azurerm_log_analytics_storage_insights
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LogAnalyticsWorkspace -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1 -resource-types=azurerm_log_analytics_workspace
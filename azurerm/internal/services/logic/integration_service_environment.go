@@ -183,7 +183,7 @@ func resourceIntegrationServiceEnvironmentCreateUpdate(d *schema.ResourceData, m
 			},
 		},
 		Sku:  sku,
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, integrationServiceEnvironment)
@@ -265,7 +265,7 @@ func resourceIntegrationServiceEnvironmentRead(d *schema.ResourceData, meta inte
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceIntegrationServiceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
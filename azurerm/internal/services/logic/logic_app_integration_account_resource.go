@@ -91,7 +91,7 @@ func resourceLogicAppIntegrationAccountCreateUpdate(d *schema.ResourceData, meta
 		Sku: &logic.IntegrationAccountSku{
 			Name: logic.IntegrationAccountSkuName(d.Get("sku_name").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, account); err != nil {
@@ -131,7 +131,7 @@ func resourceLogicAppIntegrationAccountRead(d *schema.ResourceData, meta interfa
 	d.Set("location", location.NormalizeNilable(resp.Location))
 	d.Set("sku_name", string(resp.Sku.Name))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceLogicAppIntegrationAccountDelete(d *schema.ResourceData, meta interface{}) error {
@@ -164,7 +164,7 @@ func resourceLogicAppWorkflowCreate(d *schema.ResourceData, meta interface{}) er
 			},
 			Parameters: parameters,
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if iseID, ok := d.GetOk("integration_service_environment_id"); ok {
@@ -237,7 +237,7 @@ func resourceLogicAppWorkflowUpdate(d *schema.ResourceData, meta interface{}) er
 			Definition: read.WorkflowProperties.Definition,
 			Parameters: parameters,
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if v, ok := d.GetOk("logic_app_integration_account_id"); ok {
@@ -329,7 +329,7 @@ func resourceLogicAppWorkflowRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("logic_app_integration_account_id", integrationAccountId)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceLogicAppWorkflowDelete(d *schema.ResourceData, meta interface{}) error {
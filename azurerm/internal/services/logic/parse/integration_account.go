@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IntegrationAccountId struct {
@@ -67,3 +68,19 @@ func IntegrationAccountID(input string) (*IntegrationAccountId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "logic",
+		TypeName:           "IntegrationAccount",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Logic/integrationAccounts/account1",
+		ResourceTypes:      []string{"azurerm_logic_app_integration_account"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := IntegrationAccountID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IntegrationServiceEnvironmentId struct {
@@ -67,3 +68,19 @@ func IntegrationServiceEnvironmentID(input string) (*IntegrationServiceEnvironme
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "logic",
+		TypeName:           "IntegrationServiceEnvironment",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Logic/integrationServiceEnvironments/ise1",
+		ResourceTypes:      []string{"azurerm_integration_service_environment"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := IntegrationServiceEnvironmentID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
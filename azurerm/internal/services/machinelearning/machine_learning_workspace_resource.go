@@ -177,7 +177,7 @@ func resourceMachineLearningWorkspaceCreate(d *schema.ResourceData, meta interfa
 	workspace := machinelearningservices.Workspace{
 		Name:     &name,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		Sku: &machinelearningservices.Sku{
 			Name: utils.String(skuName),
 			Tier: utils.String(skuName),
@@ -283,7 +283,7 @@ func resourceMachineLearningWorkspaceRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error flattening identity on Workspace %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceMachineLearningWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -316,8 +316,8 @@ func resourceMachineLearningWorkspaceUpdate(d *schema.ResourceData, meta interfa
 		update.WorkspacePropertiesUpdateParameters.FriendlyName = utils.String(d.Get("friendly_name").(string))
 	}
 
-	if d.HasChange("tags") {
-		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		update.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, update); err != nil {
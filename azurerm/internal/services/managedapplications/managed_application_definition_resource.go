@@ -157,7 +157,7 @@ func resourceManagedApplicationDefinitionCreateUpdate(d *schema.ResourceData, me
 			IsEnabled:      utils.Bool(d.Get("package_enabled").(bool)),
 			LockLevel:      managedapplications.ApplicationLockLevel(d.Get("lock_level").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("create_ui_definition"); ok {
@@ -241,7 +241,7 @@ func resourceManagedApplicationDefinitionRead(d *schema.ResourceData, meta inter
 		d.Set("package_file_uri", v.(string))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceManagedApplicationDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
@@ -149,7 +149,7 @@ func resourceManagedApplicationCreateUpdate(d *schema.ResourceData, meta interfa
 	parameters := managedapplications.Application{
 		Location: utils.String(azure.NormalizeLocation(d.Get("location"))),
 		Kind:     utils.String(d.Get("kind").(string)),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("managed_resource_group_name"); ok {
@@ -244,7 +244,7 @@ func resourceManagedApplicationRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceManagedApplicationDelete(d *schema.ResourceData, meta interface{}) error {
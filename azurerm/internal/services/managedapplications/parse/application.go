@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApplicationId struct {
@@ -67,3 +68,19 @@ func ApplicationID(input string) (*ApplicationId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "managedapplications",
+		TypeName:           "Application",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Solutions/applications/app1",
+		ResourceTypes:      []string{"azurerm_managed_application"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ApplicationID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApplicationDefinitionId struct {
@@ -67,3 +68,19 @@ func ApplicationDefinitionID(input string) (*ApplicationDefinitionId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "managedapplications",
+		TypeName:           "ApplicationDefinition",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Solutions/applicationDefinitions/definition1",
+		ResourceTypes:      []string{"azurerm_managed_application_definition"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ApplicationDefinitionID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -1,4 +1,4 @@
 package managedapplications
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Application -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Solutions/applications/app1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ApplicationDefinition -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Solutions/applicationDefinitions/definition1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Application -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Solutions/applications/app1 -resource-types=azurerm_managed_application
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ApplicationDefinition -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Solutions/applicationDefinitions/definition1 -resource-types=azurerm_managed_application_definition
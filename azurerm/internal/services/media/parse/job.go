@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type JobId struct {
@@ -79,3 +80,19 @@ func JobID(input string) (*JobId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "media",
+		TypeName:           "Job",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/transforms/transform1/jobs/job1",
+		ResourceTypes:      []string{"azurerm_media_job"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := JobID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
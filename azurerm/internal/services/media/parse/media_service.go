@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type MediaServiceId struct {
@@ -67,3 +68,19 @@ func MediaServiceID(input string) (*MediaServiceId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "media",
+		TypeName:           "MediaService",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1",
+		ResourceTypes:      []string{"azurerm_media_services_account"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := MediaServiceID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
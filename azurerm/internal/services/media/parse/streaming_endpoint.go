@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StreamingEndpointId struct {
@@ -73,3 +74,19 @@ func StreamingEndpointID(input string) (*StreamingEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "media",
+		TypeName:           "StreamingEndpoint",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/streamingendpoints/endpoint1",
+		ResourceTypes:      []string{"azurerm_media_streaming_endpoint"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := StreamingEndpointID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
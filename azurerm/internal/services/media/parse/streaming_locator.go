@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StreamingLocatorId struct {
@@ -73,3 +74,19 @@ func StreamingLocatorID(input string) (*StreamingLocatorId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "media",
+		TypeName:           "StreamingLocator",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/streaminglocators/locator1",
+		ResourceTypes:      []string{"azurerm_media_streaming_locator"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := StreamingLocatorID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpatialAnchorsAccountId struct {
@@ -67,3 +68,19 @@ func SpatialAnchorsAccountID(input string) (*SpatialAnchorsAccountId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "mixedreality",
+		TypeName:           "SpatialAnchorsAccount",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.MixedReality/spatialAnchorsAccounts/Account1",
+		ResourceTypes:      []string{"azurerm_spatial_anchors_account"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SpatialAnchorsAccountID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
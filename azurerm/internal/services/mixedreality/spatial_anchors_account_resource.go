@@ -80,7 +80,7 @@ func resourceSpatialAnchorsAccountCreate(d *schema.ResourceData, meta interface{
 
 	account := mixedreality.SpatialAnchorsAccount{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if _, err := client.Create(ctx, resourceGroup, name, account); err != nil {
@@ -127,7 +127,7 @@ func resourceSpatialAnchorsAccountRead(d *schema.ResourceData, meta interface{})
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceSpatialAnchorsAccountDelete(d *schema.ResourceData, meta interface{}) error {
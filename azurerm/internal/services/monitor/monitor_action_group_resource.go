@@ -403,7 +403,7 @@ func resourceMonitorActionGroupCreateUpdate(d *schema.ResourceData, meta interfa
 	armRoleReceiversRaw := d.Get("arm_role_receiver").([]interface{})
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := meta.(*clients.Client).Tags.Expand(t)
 
 	parameters := insights.ActionGroupResource{
 		Location: utils.String(azure.NormalizeLocation("Global")),
@@ -508,7 +508,7 @@ func resourceMonitorActionGroupRead(d *schema.ResourceData, meta interface{}) er
 			return fmt.Errorf("Error setting `arm_role_receiver`: %+v", err)
 		}
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceMonitorActionGroupDelete(d *schema.ResourceData, meta interface{}) error {
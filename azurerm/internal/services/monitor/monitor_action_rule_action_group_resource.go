@@ -136,7 +136,7 @@ func resourceMonitorActionRuleActionGroupCreateUpdate(d *schema.ResourceData, me
 			Status:        actionRuleStatus,
 			Type:          alertsmanagement.TypeActionGroup,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.CreateUpdate(ctx, resourceGroup, name, actionRule); err != nil {
@@ -191,7 +191,7 @@ func resourceMonitorActionRuleActionGroupRead(d *schema.ResourceData, meta inter
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceMonitorActionRuleActionGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -199,7 +199,7 @@ func resourceMonitorActionRuleSuppressionCreateUpdate(d *schema.ResourceData, me
 			Status:            actionRuleStatus,
 			Type:              alertsmanagement.TypeSuppression,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.CreateUpdate(ctx, resourceGroup, name, actionRule); err != nil {
@@ -256,7 +256,7 @@ func resourceMonitorActionRuleSuppressionRead(d *schema.ResourceData, meta inter
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceMonitorActionRuleSuppressionDelete(d *schema.ResourceData, meta interface{}) error {
@@ -428,7 +428,7 @@ func resourceMonitorAutoScaleSettingCreateUpdate(d *schema.ResourceData, meta in
 	}
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := meta.(*clients.Client).Tags.Expand(t)
 
 	parameters := insights.AutoscaleSettingResource{
 		Location: utils.String(location),
@@ -505,7 +505,7 @@ func resourceMonitorAutoScaleSettingRead(d *schema.ResourceData, meta interface{
 
 	// Return a new tag map filtered by the specified tag names.
 	tagMap := tags.Filter(resp.Tags, "$type")
-	return tags.FlattenAndSet(d, tagMap)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, tagMap)
 }
 
 func resourceMonitorAutoScaleSettingDelete(d *schema.ResourceData, meta interface{}) error {
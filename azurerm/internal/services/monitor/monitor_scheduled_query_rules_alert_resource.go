@@ -252,7 +252,7 @@ func resourceMonitorScheduledQueryRulesAlertCreateUpdate(d *schema.ResourceData,
 	source := expandMonitorScheduledQueryRulesCommonSource(d)
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := meta.(*clients.Client).Tags.Expand(t)
 
 	parameters := insights.LogSearchRuleResource{
 		Location: utils.String(location),
@@ -356,7 +356,7 @@ func resourceMonitorScheduledQueryRulesAlertRead(d *schema.ResourceData, meta in
 		d.Set("query_type", string(source.QueryType))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceMonitorScheduledQueryRulesAlertDelete(d *schema.ResourceData, meta interface{}) error {
@@ -155,7 +155,7 @@ func resourceMonitorScheduledQueryRulesLogCreateUpdate(d *schema.ResourceData, m
 	source := expandMonitorScheduledQueryRulesCommonSource(d)
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := meta.(*clients.Client).Tags.Expand(t)
 
 	parameters := insights.LogSearchRuleResource{
 		Location: utils.String(location),
@@ -236,7 +236,7 @@ func resourceMonitorScheduledQueryRulesLogRead(d *schema.ResourceData, meta inte
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceMonitorScheduledQueryRulesLogDelete(d *schema.ResourceData, meta interface{}) error {
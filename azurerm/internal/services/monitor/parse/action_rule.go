@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ActionRuleId struct {
@@ -67,3 +68,19 @@ func ActionRuleID(input string) (*ActionRuleId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "monitor",
+		TypeName:           "ActionRule",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AlertsManagement/actionRules/actionRule1",
+		ResourceTypes:      []string{"azurerm_monitor_action_rule_action_group", "azurerm_monitor_action_rule_suppression"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ActionRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SmartDetectorAlertRuleId struct {
@@ -67,3 +68,19 @@ func SmartDetectorAlertRuleID(input string) (*SmartDetectorAlertRuleId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "monitor",
+		TypeName:           "SmartDetectorAlertRule",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AlertsManagement/smartdetectoralertrules/rule1",
+		ResourceTypes:      []string{"azurerm_monitor_smart_detector_alert_rule"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SmartDetectorAlertRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type UserAssignedIdentityId struct {
@@ -111,3 +112,19 @@ func UserAssignedIdentityIDInsensitively(input string) (*UserAssignedIdentityId,
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "msi",
+		TypeName:           "UserAssignedIdentity",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+		ResourceTypes:      []string{"azurerm_user_assigned_identity"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := UserAssignedIdentityID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
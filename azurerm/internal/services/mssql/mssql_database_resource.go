@@ -366,7 +366,7 @@ func resourceMsSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{})
 			ZoneRedundant:      utils.Bool(d.Get("zone_redundant").(bool)),
 		},
 
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	createMode, ok := d.GetOk("create_mode")
@@ -604,7 +604,7 @@ func resourceMsSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("short_term_retention_policy", zero)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceMsSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
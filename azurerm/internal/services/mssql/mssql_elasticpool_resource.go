@@ -211,7 +211,7 @@ func resourceMsSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interface
 		Name:     &elasticPoolName,
 		Location: &location,
 		Sku:      sku,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		ElasticPoolProperties: &sql.ElasticPoolProperties{
 			LicenseType:         sql.ElasticPoolLicenseType(d.Get("license_type").(string)),
 			PerDatabaseSettings: expandMsSqlElasticPoolPerDatabaseSettings(d),
@@ -299,7 +299,7 @@ func resourceMsSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceMsSqlElasticPoolDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabaseExtendedAuditingPolicyId struct {
@@ -79,3 +80,19 @@ func DatabaseExtendedAuditingPolicyID(input string) (*DatabaseExtendedAuditingPo
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "mssql",
+		TypeName:           "DatabaseExtendedAuditingPolicy",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1/extendedAuditingSettings/default",
+		ResourceTypes:      []string{"azurerm_mssql_database_extended_auditing_policy"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DatabaseExtendedAuditingPolicyID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabaseVulnerabilityAssessmentRuleBaselineId struct {
@@ -91,3 +92,19 @@ func DatabaseVulnerabilityAssessmentRuleBaselineID(input string) (*DatabaseVulne
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "mssql",
+		TypeName:           "DatabaseVulnerabilityAssessmentRuleBaseline",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1/vulnerabilityAssessments/default/rules/rule1/baselines/baseline1",
+		ResourceTypes:      []string{"azurerm_mssql_database_vulnerability_assessment_rule_baseline"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DatabaseVulnerabilityAssessmentRuleBaselineID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
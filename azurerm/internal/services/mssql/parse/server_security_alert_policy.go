@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerSecurityAlertPolicyId struct {
@@ -73,3 +74,19 @@ func ServerSecurityAlertPolicyID(input string) (*ServerSecurityAlertPolicyId, er
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "mssql",
+		TypeName:           "ServerSecurityAlertPolicy",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/securityAlertPolicies/Default",
+		ResourceTypes:      []string{"azurerm_mssql_server_security_alert_policy"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ServerSecurityAlertPolicyID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
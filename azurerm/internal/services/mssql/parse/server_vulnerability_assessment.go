@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerVulnerabilityAssessmentId struct {
@@ -73,3 +74,19 @@ func ServerVulnerabilityAssessmentID(input string) (*ServerVulnerabilityAssessme
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "mssql",
+		TypeName:           "ServerVulnerabilityAssessment",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/vulnerabilityAssessments/default",
+		ResourceTypes:      []string{"azurerm_mssql_server_vulnerability_assessment"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ServerVulnerabilityAssessmentID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
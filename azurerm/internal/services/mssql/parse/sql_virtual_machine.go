@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SqlVirtualMachineId struct {
@@ -67,3 +68,19 @@ func SqlVirtualMachineID(input string) (*SqlVirtualMachineId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "mssql",
+		TypeName:           "SqlVirtualMachine",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.SqlVirtualMachine/sqlVirtualMachines/virtualMachine1",
+		ResourceTypes:      []string{"azurerm_mssql_virtual_machine"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SqlVirtualMachineID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -525,7 +525,7 @@ func resourceMySqlServerCreate(d *schema.ResourceData, meta interface{}) error {
 		Location:   &location,
 		Properties: props,
 		Sku:        sku,
-		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:       meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, server)
@@ -608,7 +608,7 @@ func resourceMySqlServerUpdate(d *schema.ResourceData, meta interface{}) error {
 			Version:                    mysql.ServerVersion(d.Get("version").(string)),
 		},
 		Sku:  sku,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.Name, properties)
@@ -726,7 +726,7 @@ func resourceMySqlServerRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceMySqlServerDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ConfigurationId struct {
@@ -73,3 +74,19 @@ func ConfigurationID(input string) (*ConfigurationId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "mysql",
+		TypeName:           "Configuration",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/configurations/config1",
+		ResourceTypes:      []string{"azurerm_mysql_configuration"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ConfigurationID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
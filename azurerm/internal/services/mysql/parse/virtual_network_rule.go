@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkRuleId struct {
@@ -73,3 +74,19 @@ func VirtualNetworkRuleID(input string) (*VirtualNetworkRuleId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "mysql",
+		TypeName:           "VirtualNetworkRule",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/virtualNetworkRules/virtualNetworkRule1",
+		ResourceTypes:      []string{"azurerm_mysql_virtual_network_rule"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := VirtualNetworkRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
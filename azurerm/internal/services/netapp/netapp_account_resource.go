@@ -134,7 +134,7 @@ func resourceNetAppAccountCreateUpdate(d *schema.ResourceData, meta interface{})
 		AccountProperties: &netapp.AccountProperties{
 			ActiveDirectories: expandNetAppActiveDirectories(activeDirectories),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, accountParameters, resourceGroup, name)
@@ -183,7 +183,7 @@ func resourceNetAppAccountRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceNetAppAccountDelete(d *schema.ResourceData, meta interface{}) error {
@@ -112,7 +112,7 @@ func resourceNetAppPoolCreateUpdate(d *schema.ResourceData, meta interface{}) er
 			ServiceLevel: netapp.ServiceLevel(serviceLevel),
 			Size:         utils.Int64(sizeInBytes),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, capacityPoolParameters, resourceGroup, accountName, name)
@@ -173,7 +173,7 @@ func resourceNetAppPoolRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("size_in_tb", int(sizeInTB))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceNetAppPoolDelete(d *schema.ResourceData, meta interface{}) error {
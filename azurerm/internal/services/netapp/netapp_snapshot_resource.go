@@ -14,7 +14,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/netapp/parse"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -110,7 +109,7 @@ func resourceNetAppSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 
-	if tags.Expand(d.Get("tags").(map[string]interface{})) != nil {
+	if len(d.Get("tags").(map[string]interface{})) > 0 {
 		log.Printf("[WARN] Tags are not supported on snaphots anymore, ignoring values.")
 	}
 
@@ -173,7 +172,7 @@ func resourceNetAppSnapshotRead(d *schema.ResourceData, meta interface{}) error
 func resourceNetAppSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Snapshot resource in Azure changed its type to proxied resource, therefore
 	// tags are not supported anymore, ignoring any tags.
-	if tags.Expand(d.Get("tags").(map[string]interface{})) == nil {
+	if len(d.Get("tags").(map[string]interface{})) > 0 {
 		log.Printf("[WARN] Tags are not supported on snaphots anymore, no update will happen in a snapshot at this time.")
 	}
 
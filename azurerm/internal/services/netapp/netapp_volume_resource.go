@@ -293,7 +293,7 @@ func resourceNetAppVolumeCreateUpdate(d *schema.ResourceData, meta interface{})
 			VolumeType:     utils.String(volumeType),
 			DataProtection: dataProtectionReplication,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, parameters, resourceGroup, accountName, poolName, name)
@@ -395,7 +395,7 @@ func resourceNetAppVolumeRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceNetAppVolumeDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CapacityPoolId struct {
@@ -73,3 +74,19 @@ func CapacityPoolID(input string) (*CapacityPoolId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "netapp",
+		TypeName:           "CapacityPool",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NetApp/netAppAccounts/account1/capacityPools/pool1",
+		ResourceTypes:      []string{"azurerm_netapp_pool"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := CapacityPoolID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
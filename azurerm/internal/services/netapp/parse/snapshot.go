@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SnapshotId struct {
@@ -85,3 +86,19 @@ func SnapshotID(input string) (*SnapshotId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "netapp",
+		TypeName:           "Snapshot",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NetApp/netAppAccounts/account1/capacityPools/pool1/volumes/volume1/snapshots/snapshot1",
+		ResourceTypes:      []string{"azurerm_netapp_snapshot"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SnapshotID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VolumeId struct {
@@ -79,3 +80,19 @@ func VolumeID(input string) (*VolumeId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "netapp",
+		TypeName:           "Volume",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NetApp/netAppAccounts/account1/capacityPools/pool1/volumes/volume1",
+		ResourceTypes:      []string{"azurerm_netapp_volume"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := VolumeID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
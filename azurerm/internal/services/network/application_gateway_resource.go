@@ -1381,7 +1381,7 @@ func resourceApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interfa
 		Location: utils.String(location),
 		Zones:    azure.ExpandZones(d.Get("zones").([]interface{})),
 
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 		ApplicationGatewayPropertiesFormat: &network.ApplicationGatewayPropertiesFormat{
 			AutoscaleConfiguration:        expandApplicationGatewayAutoscaleConfiguration(d),
 			AuthenticationCertificates:    expandApplicationGatewayAuthenticationCertificates(d.Get("authentication_certificate").([]interface{})),
@@ -1633,7 +1633,7 @@ func resourceApplicationGatewayRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	return tags.FlattenAndSet(d, applicationGateway.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, applicationGateway.Tags)
 }
 
 func resourceApplicationGatewayDelete(d *schema.ResourceData, meta interface{}) error {
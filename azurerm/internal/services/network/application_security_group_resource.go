@@ -75,7 +75,7 @@ func resourceApplicationSecurityGroupCreateUpdate(d *schema.ResourceData, meta i
 
 	securityGroup := network.ApplicationSecurityGroup{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, securityGroup)
 	if err != nil {
@@ -126,7 +126,7 @@ func resourceApplicationSecurityGroupRead(d *schema.ResourceData, meta interface
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceApplicationSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -94,7 +94,7 @@ func resourceIpGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error
 		IPGroupPropertiesFormat: &network.IPGroupPropertiesFormat{
 			IPAddresses: utils.ExpandStringSlice(ipAddresses),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, sg)
@@ -153,7 +153,7 @@ func resourceIpGroupRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceIpGroupDelete(d *schema.ResourceData, meta interface{}) error {
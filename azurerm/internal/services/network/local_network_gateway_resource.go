@@ -124,7 +124,7 @@ func resourceLocalNetworkGatewayCreateUpdate(d *schema.ResourceData, meta interf
 			LocalNetworkAddressSpace: &network.AddressSpace{},
 			BgpSettings:              expandLocalNetworkGatewayBGPSettings(d),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	ipAddress := d.Get("gateway_address").(string)
@@ -210,7 +210,7 @@ func resourceLocalNetworkGatewayRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceLocalNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) error {
@@ -139,7 +139,7 @@ func resourceNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 		Sku: &network.NatGatewaySku{
 			Name: network.NatGatewaySkuName(skuName),
 		},
-		Tags:  tags.Expand(t),
+		Tags:  meta.(*clients.Client).Tags.Expand(t),
 		Zones: utils.ExpandStringSlice(zones),
 	}
 
@@ -224,9 +224,9 @@ func resourceNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 		parameters.NatGatewayPropertiesFormat.PublicIPPrefixes = expandNetworkSubResourceID(publicIpPrefixIds)
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		t := d.Get("tags").(map[string]interface{})
-		parameters.Tags = tags.Expand(t)
+		parameters.Tags = meta.(*clients.Client).Tags.Expand(t)
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
@@ -288,7 +288,7 @@ func resourceNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting `zones`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
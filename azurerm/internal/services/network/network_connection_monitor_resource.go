@@ -465,7 +465,7 @@ func resourceNetworkConnectionMonitorCreateUpdate(d *schema.ResourceData, meta i
 
 	properties := network.ConnectionMonitor{
 		Location: utils.String(location),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 		ConnectionMonitorParameters: &network.ConnectionMonitorParameters{
 			Endpoints:          expandNetworkConnectionMonitorEndpoint(d.Get("endpoint").(*schema.Set).List()),
 			Outputs:            expandNetworkConnectionMonitorOutput(d.Get("output_workspace_resource_ids").(*schema.Set).List()),
@@ -552,7 +552,7 @@ func resourceNetworkConnectionMonitorRead(d *schema.ResourceData, meta interface
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceNetworkConnectionMonitorDelete(d *schema.ResourceData, meta interface{}) error {
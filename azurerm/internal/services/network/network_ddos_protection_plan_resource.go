@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -86,7 +87,7 @@ func resourceNetworkDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	vnetsToLock, err := expandNetworkDDoSProtectionPlanVnetNames(d)
+	vnetsToLock, err := expandNetworkDDoSProtectionPlanVirtualNetworkIDs(d)
 	if err != nil {
 		return fmt.Errorf("Error extracting names of Virtual Network: %+v", err)
 	}
@@ -94,12 +95,12 @@ func resourceNetworkDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta
 	locks.ByName(name, azureNetworkDDoSProtectionPlanResourceName)
 	defer locks.UnlockByName(name, azureNetworkDDoSProtectionPlanResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	locks.MultipleByResourceID(vnetsToLock...)
+	defer locks.UnlockMultipleByResourceID(vnetsToLock...)
 
 	parameters := network.DdosProtectionPlan{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
@@ -161,7 +162,7 @@ func resourceNetworkDDoSProtectionPlanRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	return tags.FlattenAndSet(d, plan.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, plan.Tags)
 }
 
 func resourceNetworkDDoSProtectionPlanDelete(d *schema.ResourceData, meta interface{}) error {
@@ -187,7 +188,7 @@ func resourceNetworkDDoSProtectionPlanDelete(d *schema.ResourceData, meta interf
 		return fmt.Errorf("Error retrieving DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	vnetsToLock, err := extractVnetNames(d)
+	vnetsToLock, err := expandNetworkDDoSProtectionPlanVirtualNetworkIDs(d)
 	if err != nil {
 		return fmt.Errorf("Error extracting names of Virtual Network: %+v", err)
 	}
@@ -195,8 +196,8 @@ func resourceNetworkDDoSProtectionPlanDelete(d *schema.ResourceData, meta interf
 	locks.ByName(name, azureNetworkDDoSProtectionPlanResourceName)
 	defer locks.UnlockByName(name, azureNetworkDDoSProtectionPlanResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	locks.MultipleByResourceID(vnetsToLock...)
+	defer locks.UnlockMultipleByResourceID(vnetsToLock...)
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -210,24 +211,20 @@ func resourceNetworkDDoSProtectionPlanDelete(d *schema.ResourceData, meta interf
 	return err
 }
 
-func expandNetworkDDoSProtectionPlanVnetNames(d *schema.ResourceData) (*[]string, error) {
+func expandNetworkDDoSProtectionPlanVirtualNetworkIDs(d *schema.ResourceData) ([]locks.ResourceID, error) {
 	vnetIDs := d.Get("virtual_network_ids").([]interface{})
-	vnetNames := make([]string, 0)
+	output := make([]locks.ResourceID, 0)
 
 	for _, vnetID := range vnetIDs {
-		vnetResourceID, err := azure.ParseAzureResourceID(vnetID.(string))
+		id, err := parse.VirtualNetworkID(vnetID.(string))
 		if err != nil {
 			return nil, err
 		}
 
-		vnetName := vnetResourceID.Path["virtualNetworks"]
-
-		if !utils.SliceContainsValue(vnetNames, vnetName) {
-			vnetNames = append(vnetNames, vnetName)
-		}
+		output = append(output, *id)
 	}
 
-	return &vnetNames, nil
+	return output, nil
 }
 
 func flattenNetworkDDoSProtectionPlanVirtualNetworkIDs(input *[]network.SubResource) []string {
@@ -245,23 +242,3 @@ func flattenNetworkDDoSProtectionPlanVirtualNetworkIDs(input *[]network.SubResou
 
 	return vnetIDs
 }
-
-func extractVnetNames(d *schema.ResourceData) (*[]string, error) {
-	vnetIDs := d.Get("virtual_network_ids").([]interface{})
-	vnetNames := make([]string, 0)
-
-	for _, vnetID := range vnetIDs {
-		vnetResourceID, err := azure.ParseAzureResourceID(vnetID.(string))
-		if err != nil {
-			return nil, err
-		}
-
-		vnetName := vnetResourceID.Path["virtualNetworks"]
-
-		if !utils.SliceContainsValue(vnetNames, vnetName) {
-			vnetNames = append(vnetNames, vnetName)
-		}
-	}
-
-	return &vnetNames, nil
-}
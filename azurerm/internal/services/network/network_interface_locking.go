@@ -4,35 +4,29 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 type networkInterfaceIPConfigurationLockingDetails struct {
-	subnetNamesToLock         []string
-	virtualNetworkNamesToLock []string
+	// idsToLock contains the Virtual Networks and Subnets used by the IP Configurations
+	idsToLock []locks.ResourceID
 }
 
 func (details networkInterfaceIPConfigurationLockingDetails) lock() {
-	locks.MultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.MultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+	locks.MultipleByResourceID(details.idsToLock...)
 }
 
 func (details networkInterfaceIPConfigurationLockingDetails) unlock() {
-	locks.UnlockMultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.UnlockMultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+	locks.UnlockMultipleByResourceID(details.idsToLock...)
 }
 
 func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPConfiguration) (*networkInterfaceIPConfigurationLockingDetails, error) {
+	idsToLock := make([]locks.ResourceID, 0)
 	if input == nil {
 		return &networkInterfaceIPConfigurationLockingDetails{
-			subnetNamesToLock:         []string{},
-			virtualNetworkNamesToLock: []string{},
+			idsToLock: idsToLock,
 		}, nil
 	}
 
-	subnetNamesToLock := make([]string, 0)
-	virtualNetworkNamesToLock := make([]string, 0)
-
 	for _, config := range *input {
 		if config.Subnet == nil || config.Subnet.ID == nil {
 			continue
@@ -43,20 +37,12 @@ func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPCon
 			return nil, err
 		}
 
-		virtualNetworkName := id.VirtualNetworkName
-		subnetName := id.Name
-
-		if !utils.SliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
-			virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
-		}
-
-		if !utils.SliceContainsValue(subnetNamesToLock, subnetName) {
-			subnetNamesToLock = append(subnetNamesToLock, subnetName)
-		}
+		// duplicates are ignored when locking
+		virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+		idsToLock = append(idsToLock, virtualNetworkId, *id)
 	}
 
 	return &networkInterfaceIPConfigurationLockingDetails{
-		subnetNamesToLock:         subnetNamesToLock,
-		virtualNetworkNamesToLock: virtualNetworkNamesToLock,
+		idsToLock: idsToLock,
 	}, nil
 }
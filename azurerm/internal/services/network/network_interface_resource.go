@@ -256,7 +256,7 @@ func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) er
 		Name:                      utils.String(id.Name),
 		Location:                  utils.String(location),
 		InterfacePropertiesFormat: &properties,
-		Tags:                      tags.Expand(t),
+		Tags:                      meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, iface)
@@ -351,9 +351,9 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 		update.InterfacePropertiesFormat.IPConfigurations = existing.InterfacePropertiesFormat.IPConfigurations
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		tagsRaw := d.Get("tags").(map[string]interface{})
-		update.Tags = tags.Expand(tagsRaw)
+		update.Tags = meta.(*clients.Client).Tags.Expand(tagsRaw)
 	} else {
 		update.Tags = existing.Tags
 	}
@@ -464,7 +464,7 @@ func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
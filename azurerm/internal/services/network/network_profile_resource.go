@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -120,23 +121,20 @@ func resourceNetworkProfileCreateUpdate(d *schema.ResourceData, meta interface{}
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	subnetsToLock, vnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetNames(d)
+	idsToLock, err := expandNetworkProfileVirtualNetworkSubnetIDs(d)
 	if err != nil {
-		return fmt.Errorf("Error extracting names of Subnet and Virtual Network: %+v", err)
+		return fmt.Errorf("Error extracting ID's of Subnet and Virtual Network: %+v", err)
 	}
 
 	locks.ByName(name, azureNetworkProfileResourceName)
 	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	locks.MultipleByResourceID(idsToLock...)
+	defer locks.UnlockMultipleByResourceID(idsToLock...)
 
 	parameters := network.Profile{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		ProfilePropertiesFormat: &network.ProfilePropertiesFormat{
 			ContainerNetworkInterfaceConfigurations: expandNetworkProfileContainerNetworkInterface(d),
 		},
@@ -201,7 +199,7 @@ func resourceNetworkProfileRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return tags.FlattenAndSet(d, profile.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, profile.Tags)
 }
 
 func resourceNetworkProfileDelete(d *schema.ResourceData, meta interface{}) error {
@@ -227,19 +225,16 @@ func resourceNetworkProfileDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error retrieving Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	subnetsToLock, vnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetNames(d)
+	idsToLock, err := expandNetworkProfileVirtualNetworkSubnetIDs(d)
 	if err != nil {
-		return fmt.Errorf("Error extracting names of Subnet and Virtual Network: %+v", err)
+		return fmt.Errorf("Error extracting ID's of Subnet and Virtual Network: %+v", err)
 	}
 
 	locks.ByName(name, azureNetworkProfileResourceName)
 	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	locks.MultipleByResourceID(idsToLock...)
+	defer locks.UnlockMultipleByResourceID(idsToLock...)
 
 	if _, err = client.Delete(ctx, resourceGroup, name); err != nil {
 		return fmt.Errorf("Error deleting Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -288,10 +283,10 @@ func expandNetworkProfileContainerNetworkInterface(d *schema.ResourceData) *[]ne
 	return &retCNIConfigs
 }
 
-func expandNetworkProfileVirtualNetworkSubnetNames(d *schema.ResourceData) (*[]string, *[]string, error) {
+// expandNetworkProfileVirtualNetworkSubnetIDs returns the ID's of the Virtual Networks and Subnets used by the Network Profile
+func expandNetworkProfileVirtualNetworkSubnetIDs(d *schema.ResourceData) ([]locks.ResourceID, error) {
 	cniConfigs := d.Get("container_network_interface").([]interface{})
-	subnetNames := make([]string, 0)
-	vnetNames := make([]string, 0)
+	output := make([]locks.ResourceID, 0)
 
 	for _, cniConfig := range cniConfigs {
 		nciData := cniConfig.(map[string]interface{})
@@ -301,25 +296,18 @@ func expandNetworkProfileVirtualNetworkSubnetNames(d *schema.ResourceData) (*[]s
 			ipData := ipConfig.(map[string]interface{})
 			subnetID := ipData["subnet_id"].(string)
 
-			subnetResourceID, err := azure.ParseAzureResourceID(subnetID)
+			id, err := parse.SubnetID(subnetID)
 			if err != nil {
-				return nil, nil, err
-			}
-
-			subnetName := subnetResourceID.Path["subnets"]
-			vnetName := subnetResourceID.Path["virtualNetworks"]
-
-			if !utils.SliceContainsValue(subnetNames, subnetName) {
-				subnetNames = append(subnetNames, subnetName)
+				return nil, err
 			}
 
-			if !utils.SliceContainsValue(vnetNames, vnetName) {
-				vnetNames = append(vnetNames, vnetName)
-			}
+			// duplicates are ignored when locking
+			virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+			output = append(output, virtualNetworkId, *id)
 		}
 	}
 
-	return &subnetNames, &vnetNames, nil
+	return output, nil
 }
 
 func flattenNetworkProfileContainerNetworkInterface(input *[]network.ContainerNetworkInterfaceConfiguration) []interface{} {
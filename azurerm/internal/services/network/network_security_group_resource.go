@@ -217,7 +217,7 @@ func resourceNetworkSecurityGroupCreateUpdate(d *schema.ResourceData, meta inter
 		SecurityGroupPropertiesFormat: &network.SecurityGroupPropertiesFormat{
 			SecurityRules: &sgRules,
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, sg)
@@ -274,7 +274,7 @@ func resourceNetworkSecurityGroupRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceNetworkSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -76,7 +76,7 @@ func resourceNetworkWatcherCreateUpdate(d *schema.ResourceData, meta interface{}
 
 	watcher := network.Watcher{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, watcher); err != nil {
@@ -121,7 +121,7 @@ func resourceNetworkWatcherRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceNetworkWatcherDelete(d *schema.ResourceData, meta interface{}) error {
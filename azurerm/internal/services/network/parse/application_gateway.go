@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApplicationGatewayId struct {
@@ -67,3 +68,19 @@ func ApplicationGatewayID(input string) (*ApplicationGatewayId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "ApplicationGateway",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationGateways/applicationGateway1",
+		ResourceTypes:      []string{"azurerm_application_gateway"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ApplicationGatewayID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
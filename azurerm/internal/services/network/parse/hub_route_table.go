@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HubRouteTableId struct {
@@ -73,3 +74,19 @@ func HubRouteTableID(input string) (*HubRouteTableId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "HubRouteTable",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/hubRouteTables/routeTable1",
+		ResourceTypes:      []string{"azurerm_virtual_hub_route_table"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := HubRouteTableID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
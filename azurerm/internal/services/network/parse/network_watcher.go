@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NetworkWatcherId struct {
@@ -67,3 +68,19 @@ func NetworkWatcherID(input string) (*NetworkWatcherId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "NetworkWatcher",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1",
+		ResourceTypes:      []string{"azurerm_network_watcher"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := NetworkWatcherID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
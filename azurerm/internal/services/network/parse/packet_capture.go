@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PacketCaptureId struct {
@@ -73,3 +74,19 @@ func PacketCaptureID(input string) (*PacketCaptureId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "PacketCapture",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1/packetCaptures/capture1",
+		ResourceTypes:      []string{"azurerm_network_packet_capture"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := PacketCaptureID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PrivateDnsZoneConfigId struct {
@@ -79,3 +80,18 @@ func PrivateDnsZoneConfigID(input string) (*PrivateDnsZoneConfigId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "PrivateDnsZoneConfig",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateEndpoints/endpoint1/privateDnsZoneGroups/privateDnsZoneGroup1/privateDnsZoneConfigs/privateDnsZoneConfig1",
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := PrivateDnsZoneConfigID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
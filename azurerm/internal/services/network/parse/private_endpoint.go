@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PrivateEndpointId struct {
@@ -67,3 +68,19 @@ func PrivateEndpointID(input string) (*PrivateEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "PrivateEndpoint",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateEndpoints/endpoint1",
+		ResourceTypes:      []string{"azurerm_private_endpoint"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := PrivateEndpointID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PublicIpAddressId struct {
@@ -67,3 +68,19 @@ func PublicIpAddressID(input string) (*PublicIpAddressId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "PublicIpAddress",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/publicIPAddresses/publicIpAddress1",
+		ResourceTypes:      []string{"azurerm_public_ip"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := PublicIpAddressID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RouteId struct {
@@ -73,3 +74,19 @@ func RouteID(input string) (*RouteId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "Route",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeTables/routeTable1/routes/route1",
+		ResourceTypes:      []string{"azurerm_route"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := RouteID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
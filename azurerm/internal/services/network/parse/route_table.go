@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RouteTableId struct {
@@ -67,3 +68,19 @@ func RouteTableID(input string) (*RouteTableId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "RouteTable",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeTables/routeTable1",
+		ResourceTypes:      []string{"azurerm_route_table"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := RouteTableID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubnetServiceEndpointStoragePolicyId struct {
@@ -67,3 +68,19 @@ func SubnetServiceEndpointStoragePolicyID(input string) (*SubnetServiceEndpointS
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "SubnetServiceEndpointStoragePolicy",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/serviceEndpointPolicies/policy1",
+		ResourceTypes:      []string{"azurerm_subnet_service_endpoint_storage_policy"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SubnetServiceEndpointStoragePolicyID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualHubId struct {
@@ -67,3 +68,19 @@ func VirtualHubID(input string) (*VirtualHubId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "VirtualHub",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1",
+		ResourceTypes:      []string{"azurerm_virtual_hub"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := VirtualHubID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
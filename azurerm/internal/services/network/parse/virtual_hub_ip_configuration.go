@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualHubIpConfigurationId struct {
@@ -73,3 +74,19 @@ func VirtualHubIpConfigurationID(input string) (*VirtualHubIpConfigurationId, er
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "VirtualHubIpConfiguration",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/ipConfigurations/ipConfiguration1",
+		ResourceTypes:      []string{"azurerm_virtual_hub_ip"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := VirtualHubIpConfigurationID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkId struct {
@@ -111,3 +112,19 @@ func VirtualNetworkIDInsensitively(input string) (*VirtualNetworkId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "VirtualNetwork",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1",
+		ResourceTypes:      []string{"azurerm_virtual_network"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := VirtualNetworkID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
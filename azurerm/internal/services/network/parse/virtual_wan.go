@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualWanId struct {
@@ -67,3 +68,19 @@ func VirtualWanID(input string) (*VirtualWanId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "network",
+		TypeName:           "VirtualWan",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualWans/virtualWan1",
+		ResourceTypes:      []string{"azurerm_virtual_wan"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := VirtualWanID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -248,7 +248,7 @@ func resourcePrivateEndpointCreate(d *schema.ResourceData, meta interface{}) err
 				ID: utils.String(subnetId),
 			},
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
@@ -308,7 +308,7 @@ func resourcePrivateEndpointUpdate(d *schema.ResourceData, meta interface{}) err
 				ID: utils.String(subnetId),
 			},
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
@@ -451,7 +451,7 @@ func resourcePrivateEndpointRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("setting `private_dns_zone_group`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourcePrivateEndpointDelete(d *schema.ResourceData, meta interface{}) error {
@@ -98,7 +98,7 @@ func resourcePublicIpPrefixCreateUpdate(d *schema.ResourceData, meta interface{}
 		PublicIPPrefixPropertiesFormat: &network.PublicIPPrefixPropertiesFormat{
 			PrefixLength: utils.Int32(int32(prefix_length)),
 		},
-		Tags:  tags.Expand(t),
+		Tags:  meta.(*clients.Client).Tags.Expand(t),
 		Zones: zones,
 	}
 
@@ -162,7 +162,7 @@ func resourcePublicIpPrefixRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("ip_prefix", props.IPPrefix)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourcePublicIpPrefixDelete(d *schema.ResourceData, meta interface{}) error {
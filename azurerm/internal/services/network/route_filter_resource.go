@@ -128,7 +128,7 @@ func resourceRouteFilterCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		RouteFilterPropertiesFormat: &network.RouteFilterPropertiesFormat{
 			Rules: expandRouteFilterRules(d),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, routeSet)
@@ -185,7 +185,7 @@ func resourceRouteFilterRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceRouteFilterDelete(d *schema.ResourceData, meta interface{}) error {
@@ -144,7 +144,7 @@ func resourceRouteTableCreateUpdate(d *schema.ResourceData, meta interface{}) er
 			Routes:                     expandRouteTableRoutes(d),
 			DisableBgpRoutePropagation: utils.Bool(d.Get("disable_bgp_route_propagation").(bool)),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, routeSet)
@@ -206,7 +206,7 @@ func resourceRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
@@ -75,10 +75,9 @@ func resourceSubnetNatGatewayAssociationCreate(d *schema.ResourceData, meta inte
 
 	locks.ByName(gatewayName, natGatewayResourceName)
 	defer locks.UnlockByName(gatewayName, natGatewayResourceName)
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
+	virtualNetworkId := parse.NewVirtualNetworkID(parsedSubnetId.SubscriptionId, resourceGroup, virtualNetworkName)
+	locks.MultipleByResourceID(virtualNetworkId, *parsedSubnetId)
+	defer locks.UnlockMultipleByResourceID(virtualNetworkId, *parsedSubnetId)
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -198,8 +197,9 @@ func resourceSubnetNatGatewayAssociationDelete(d *schema.ResourceData, meta inte
 	gatewayName := parsedGatewayId.Path["natGateways"]
 	locks.ByName(gatewayName, natGatewayResourceName)
 	defer locks.UnlockByName(gatewayName, natGatewayResourceName)
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, resourceGroup, virtualNetworkName)
+	locks.ByResourceID(virtualNetworkId)
+	defer locks.UnlockByResourceID(virtualNetworkId)
 
 	// ensure we get the latest state
 	subnet, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
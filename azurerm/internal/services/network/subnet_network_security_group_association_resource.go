@@ -60,7 +60,7 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *schema.ResourceData,
 	subnetId := d.Get("subnet_id").(string)
 	networkSecurityGroupId := d.Get("network_security_group_id").(string)
 
-	parsedSubnetId, err := azure.ParseAzureResourceID(subnetId)
+	parsedSubnetId, err := parse.SubnetID(subnetId)
 	if err != nil {
 		return err
 	}
@@ -73,15 +73,13 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *schema.ResourceData,
 	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
 	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
 
-	subnetName := parsedSubnetId.Path["subnets"]
-	virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
+	subnetName := parsedSubnetId.Name
+	virtualNetworkName := parsedSubnetId.VirtualNetworkName
 	resourceGroup := parsedSubnetId.ResourceGroup
 
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
-
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	virtualNetworkId := parse.NewVirtualNetworkID(parsedSubnetId.SubscriptionId, resourceGroup, virtualNetworkName)
+	locks.MultipleByResourceID(virtualNetworkId, *parsedSubnetId)
+	defer locks.UnlockMultipleByResourceID(virtualNetworkId, *parsedSubnetId)
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -208,11 +206,10 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *schema.ResourceData,
 	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
 	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
 
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
+	virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionID, resourceGroup, virtualNetworkName)
+	subnetId := parse.NewSubnetID(id.SubscriptionID, resourceGroup, virtualNetworkName, subnetName)
+	locks.MultipleByResourceID(virtualNetworkId, subnetId)
+	defer locks.UnlockMultipleByResourceID(virtualNetworkId, subnetId)
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
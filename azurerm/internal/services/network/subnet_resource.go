@@ -21,8 +21,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-var SubnetResourceName = "azurerm_subnet"
-
 func resourceSubnet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSubnetCreate,
@@ -198,8 +196,9 @@ func resourceSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		return tf.ImportAsExistsError("azurerm_subnet", id.ID())
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+	locks.ByResourceID(virtualNetworkId)
+	defer locks.UnlockByResourceID(virtualNetworkId)
 
 	properties := network.SubnetPropertiesFormat{}
 	if value, ok := d.GetOk("address_prefixes"); ok {
@@ -397,11 +396,9 @@ func resourceSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+	locks.MultipleByResourceID(virtualNetworkId, *id)
+	defer locks.UnlockMultipleByResourceID(virtualNetworkId, *id)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name)
 	if err != nil {
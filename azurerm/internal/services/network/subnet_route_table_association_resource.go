@@ -77,8 +77,9 @@ func resourceSubnetRouteTableAssociationCreate(d *schema.ResourceData, meta inte
 	virtualNetworkName := parsedSubnetId.VirtualNetworkName
 	resourceGroup := parsedSubnetId.ResourceGroup
 
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	virtualNetworkId := parse.NewVirtualNetworkID(parsedSubnetId.SubscriptionId, resourceGroup, virtualNetworkName)
+	locks.ByResourceID(virtualNetworkId)
+	defer locks.UnlockByResourceID(virtualNetworkId)
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -205,8 +206,9 @@ func resourceSubnetRouteTableAssociationDelete(d *schema.ResourceData, meta inte
 	locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
 	defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
 
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	virtualNetworkId := parse.NewVirtualNetworkID(id.SubscriptionId, resourceGroup, virtualNetworkName)
+	locks.ByResourceID(virtualNetworkId)
+	defer locks.UnlockByResourceID(virtualNetworkId)
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
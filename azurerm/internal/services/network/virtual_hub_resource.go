@@ -141,7 +141,7 @@ func resourceVirtualHubCreateUpdate(d *schema.ResourceData, meta interface{}) er
 		VirtualHubProperties: &network.VirtualHubProperties{
 			RouteTable: expandVirtualHubRoute(route),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if v, ok := d.GetOk("address_prefix"); ok {
@@ -235,7 +235,7 @@ func resourceVirtualHubRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("virtual_wan_id", virtualWanId)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVirtualHubDelete(d *schema.ResourceData, meta interface{}) error {
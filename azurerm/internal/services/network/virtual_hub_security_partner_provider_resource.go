@@ -97,7 +97,7 @@ func resourceVirtualHubSecurityPartnerProviderCreate(d *schema.ResourceData, met
 		SecurityPartnerProviderPropertiesFormat: &network.SecurityPartnerProviderPropertiesFormat{
 			SecurityProviderName: network.SecurityProviderName(d.Get("security_provider_name").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("virtual_hub_id"); ok {
@@ -161,7 +161,7 @@ func resourceVirtualHubSecurityPartnerProviderRead(d *schema.ResourceData, meta
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVirtualHubSecurityPartnerProviderUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -176,8 +176,8 @@ func resourceVirtualHubSecurityPartnerProviderUpdate(d *schema.ResourceData, met
 
 	parameters := network.TagsObject{}
 
-	if d.HasChange("tags") {
-		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		parameters.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.UpdateTags(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
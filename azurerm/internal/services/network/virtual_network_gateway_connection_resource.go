@@ -324,7 +324,7 @@ func resourceVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceData,
 	connection := network.VirtualNetworkGatewayConnection{
 		Name:     &name,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		VirtualNetworkGatewayConnectionPropertiesFormat: properties,
 	}
 
@@ -444,7 +444,7 @@ func resourceVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta in
 		return fmt.Errorf("Error setting `traffic_selector_policy`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVirtualNetworkGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
@@ -24,8 +24,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-var VirtualNetworkResourceName = "azurerm_virtual_network"
-
 func resourceVirtualNetwork() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVirtualNetworkCreateUpdate,
@@ -181,7 +179,7 @@ func resourceVirtualNetworkCreateUpdate(d *schema.ResourceData, meta interface{}
 		Name:                           utils.String(id.Name),
 		Location:                       utils.String(location),
 		VirtualNetworkPropertiesFormat: vnetProperties,
-		Tags:                           tags.Expand(t),
+		Tags:                           meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	networkSecurityGroupNames := make([]string, 0)
@@ -202,6 +200,9 @@ func resourceVirtualNetworkCreateUpdate(d *schema.ResourceData, meta interface{}
 	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
+	locks.ByResourceID(id)
+	defer locks.UnlockByResourceID(id)
+
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, vnet)
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
@@ -273,7 +274,7 @@ func resourceVirtualNetworkRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("vm_protection_enabled", props.EnableVMProtection)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) error {
@@ -291,8 +292,11 @@ func resourceVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error parsing Network Security Group ID's: %+v", err)
 	}
 
-	locks.MultipleByName(&nsgNames, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&nsgNames, VirtualNetworkResourceName)
+	locks.MultipleByName(&nsgNames, networkSecurityGroupResourceName)
+	defer locks.UnlockMultipleByName(&nsgNames, networkSecurityGroupResourceName)
+
+	locks.ByResourceID(id)
+	defer locks.UnlockByResourceID(id)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
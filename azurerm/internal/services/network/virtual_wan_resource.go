@@ -121,7 +121,7 @@ func resourceVirtualWanCreateUpdate(d *schema.ResourceData, meta interface{}) er
 
 	wan := network.VirtualWAN{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		VirtualWanProperties: &network.VirtualWanProperties{
 			DisableVpnEncryption:           utils.Bool(disableVpnEncryption),
 			AllowBranchToBranchTraffic:     utils.Bool(allowBranchToBranchTraffic),
@@ -188,7 +188,7 @@ func resourceVirtualWanRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("type", props.Type)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVirtualWanDelete(d *schema.ResourceData, meta interface{}) error {
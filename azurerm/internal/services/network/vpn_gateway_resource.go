@@ -216,7 +216,7 @@ func resourceVPNGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 			},
 			VpnGatewayScaleUnit: utils.Int32(int32(scaleUnit)),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
@@ -280,8 +280,8 @@ func resourceVPNGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("scale_unit") {
 		existing.VpnGatewayScaleUnit = utils.Int32(int32(d.Get("scale_unit").(int)))
 	}
-	if d.HasChange("tags") {
-		existing.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		existing.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	bgpSettingsRaw := d.Get("bgp_settings").([]interface{})
@@ -357,7 +357,7 @@ func resourceVPNGatewayRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("virtual_hub_id", virtualHubId)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVPNGatewayDelete(d *schema.ResourceData, meta interface{}) error {
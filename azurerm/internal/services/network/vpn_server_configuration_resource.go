@@ -493,7 +493,7 @@ func resourceVPNServerConfigurationCreateUpdate(d *schema.ResourceData, meta int
 	parameters := network.VpnServerConfiguration{
 		Location:                         utils.String(location),
 		VpnServerConfigurationProperties: &props,
-		Tags:                             tags.Expand(t),
+		Tags:                             meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
@@ -593,7 +593,7 @@ func resourceVPNServerConfigurationRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVPNServerConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
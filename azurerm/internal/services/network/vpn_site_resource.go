@@ -177,7 +177,7 @@ func resourceVpnSiteCreateUpdate(d *schema.ResourceData, meta interface{}) error
 			AddressSpace:     expandVpnSiteAddressSpace(d.Get("address_cidrs").(*schema.Set).List()),
 			VpnSiteLinks:     expandVpnSiteLinks(d.Get("link").([]interface{})),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, param)
@@ -250,7 +250,7 @@ func resourceVpnSiteRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVpnSiteDelete(d *schema.ResourceData, meta interface{}) error {
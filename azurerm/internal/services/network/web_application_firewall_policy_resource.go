@@ -320,7 +320,7 @@ func resourceWebApplicationFirewallPolicyCreateUpdate(d *schema.ResourceData, me
 			PolicySettings: expandWebApplicationFirewallPolicyPolicySettings(policySettings),
 			ManagedRules:   expandWebApplicationFirewallPolicyManagedRulesDefinition(managedRules),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
@@ -381,7 +381,7 @@ func resourceWebApplicationFirewallPolicyRead(d *schema.ResourceData, meta inter
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceWebApplicationFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
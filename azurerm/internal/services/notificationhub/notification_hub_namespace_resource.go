@@ -126,7 +126,7 @@ func resourceNotificationHubNamespaceCreateUpdate(d *schema.ResourceData, meta i
 			NamespaceType: notificationhubs.NamespaceType(namespaceType),
 			Enabled:       utils.Bool(enabled),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
 		return fmt.Errorf("Error creating/updating Notification Hub Namespace %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -205,7 +205,7 @@ func resourceNotificationHubNamespaceRead(d *schema.ResourceData, meta interface
 		d.Set("servicebus_endpoint", props.ServiceBusEndpoint)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceNotificationHubNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
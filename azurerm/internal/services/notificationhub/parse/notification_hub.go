@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NotificationHubId struct {
@@ -73,3 +74,19 @@ func NotificationHubID(input string) (*NotificationHubId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "notificationhub",
+		TypeName:           "NotificationHub",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NotificationHubs/namespaces/namespace1/notificationHubs/hub1",
+		ResourceTypes:      []string{"azurerm_notification_hub"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := NotificationHubID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NotificationHubAuthorizationRuleId struct {
@@ -79,3 +80,19 @@ func NotificationHubAuthorizationRuleID(input string) (*NotificationHubAuthoriza
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "notificationhub",
+		TypeName:           "NotificationHubAuthorizationRule",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NotificationHubs/namespaces/namespace1/notificationHubs/hub1/AuthorizationRules/authorizationRule1",
+		ResourceTypes:      []string{"azurerm_notification_hub_authorization_rule"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := NotificationHubAuthorizationRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DashboardId struct {
@@ -67,3 +68,19 @@ func DashboardID(input string) (*DashboardId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "portal",
+		TypeName:           "Dashboard",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Portal/dashboards/dashboard1",
+		ResourceTypes:      []string{"azurerm_dashboard"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DashboardID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
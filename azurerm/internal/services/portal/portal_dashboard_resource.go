@@ -73,7 +73,7 @@ func resourceDashboardCreateUpdate(d *schema.ResourceData, meta interface{}) err
 
 	dashboard := portal.Dashboard{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	var dashboardProperties portal.DashboardProperties
@@ -123,7 +123,7 @@ func resourceDashboardRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("dashboard_properties", string(props))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceDashboardDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EmbeddedId struct {
@@ -67,3 +68,19 @@ func EmbeddedID(input string) (*EmbeddedId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "powerbi",
+		TypeName:           "Embedded",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.PowerBIDedicated/capacities/capacity1",
+		ResourceTypes:      []string{"azurerm_powerbi_embedded"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := EmbeddedID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -111,7 +111,7 @@ func resourcePowerBIEmbeddedCreate(d *schema.ResourceData, meta interface{}) err
 		Sku: &powerbidedicated.ResourceSku{
 			Name: utils.String(skuName),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, parameters)
@@ -171,7 +171,7 @@ func resourcePowerBIEmbeddedRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("sku_name", skuName)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourcePowerBIEmbeddedUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -194,7 +194,7 @@ func resourcePowerBIEmbeddedUpdate(d *schema.ResourceData, meta interface{}) err
 		Sku: &powerbidedicated.ResourceSku{
 			Name: utils.String(skuName),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.Update(ctx, resourceGroup, name, parameters)
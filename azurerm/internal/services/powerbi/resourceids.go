@@ -1,3 +1,3 @@
 package powerbi
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Embedded -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.PowerBIDedicated/capacities/capacity1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Embedded -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.PowerBIDedicated/capacities/capacity1 -resource-types=azurerm_powerbi_embedded
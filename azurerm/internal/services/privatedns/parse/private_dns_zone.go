@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PrivateDnsZoneId struct {
@@ -67,3 +68,19 @@ func PrivateDnsZoneID(input string) (*PrivateDnsZoneId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "privatedns",
+		TypeName:           "PrivateDnsZone",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateDnsZones/privateDnsZone1",
+		ResourceTypes:      []string{"azurerm_private_dns_zone"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := PrivateDnsZoneID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkLinkId struct {
@@ -73,3 +74,19 @@ func VirtualNetworkLinkID(input string) (*VirtualNetworkLinkId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "privatedns",
+		TypeName:           "VirtualNetworkLink",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateDnsZones/privateDnsZone1/virtualNetworkLinks/virtualNetworkLink1",
+		ResourceTypes:      []string{"azurerm_private_dns_zone_virtual_network_link"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := VirtualNetworkLinkID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
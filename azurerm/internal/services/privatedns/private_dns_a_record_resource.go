@@ -100,7 +100,7 @@ func resourcePrivateDnsARecordCreateUpdate(d *schema.ResourceData, meta interfac
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.AName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata: tags.Expand(t),
+			Metadata: meta.(*clients.Client).Tags.Expand(t),
 			TTL:      &ttl,
 			ARecords: expandAzureRmPrivateDnsARecords(d),
 		},
@@ -146,7 +146,7 @@ func resourcePrivateDnsARecordRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Metadata)
 }
 
 func resourcePrivateDnsARecordDelete(d *schema.ResourceData, meta interface{}) error {
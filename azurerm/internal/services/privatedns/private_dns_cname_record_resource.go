@@ -104,7 +104,7 @@ func resourcePrivateDnsCNameRecordCreateUpdate(d *schema.ResourceData, meta inte
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.CNAMEName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata: tags.Expand(t),
+			Metadata: meta.(*clients.Client).Tags.Expand(t),
 			TTL:      &ttl,
 			CnameRecord: &privatedns.CnameRecord{
 				Cname: &record,
@@ -155,7 +155,7 @@ func resourcePrivateDnsCNameRecordRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Metadata)
 }
 
 func resourcePrivateDnsCNameRecordDelete(d *schema.ResourceData, meta interface{}) error {
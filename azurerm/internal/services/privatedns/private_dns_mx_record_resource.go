@@ -119,7 +119,7 @@ func resourcePrivateDnsMxRecordCreateUpdate(d *schema.ResourceData, meta interfa
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.MXName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata:  tags.Expand(t),
+			Metadata:  meta.(*clients.Client).Tags.Expand(t),
 			TTL:       &ttl,
 			MxRecords: expandAzureRmPrivateDnsMxRecords(d),
 		},
@@ -164,7 +164,7 @@ func resourcePrivateDnsMxRecordRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Metadata)
 }
 
 func resourcePrivateDnsMxRecordDelete(d *schema.ResourceData, meta interface{}) error {
@@ -129,7 +129,7 @@ func resourcePrivateDnsSrvRecordCreateUpdate(d *schema.ResourceData, meta interf
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.SRVName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   meta.(*clients.Client).Tags.Expand(t),
 			TTL:        &ttl,
 			SrvRecords: expandAzureRmPrivateDnsSrvRecords(d),
 		},
@@ -173,7 +173,7 @@ func resourcePrivateDnsSrvRecordRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Metadata)
 }
 
 func resourcePrivateDnsSrvRecordDelete(d *schema.ResourceData, meta interface{}) error {
@@ -109,7 +109,7 @@ func resourcePrivateDnsTxtRecordCreateUpdate(d *schema.ResourceData, meta interf
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.TXTName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata:   tags.Expand(d.Get("tags").(map[string]interface{})),
+			Metadata:   meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 			TTL:        utils.Int64(int64(d.Get("ttl").(int))),
 			TxtRecords: expandAzureRmPrivateDnsTxtRecords(d),
 		},
@@ -153,7 +153,7 @@ func resourcePrivateDnsTxtRecordRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("setting `record`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Metadata)
 }
 
 func resourcePrivateDnsTxtRecordDelete(d *schema.ResourceData, meta interface{}) error {
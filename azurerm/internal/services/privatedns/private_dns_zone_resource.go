@@ -173,7 +173,7 @@ func resourcePrivateDnsZoneCreateUpdate(d *schema.ResourceData, meta interface{}
 
 	parameters := privatedns.PrivateZone{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	etag := ""
@@ -251,7 +251,7 @@ func resourcePrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("setting `soa_record`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourcePrivateDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
@@ -106,7 +106,7 @@ func resourcePrivateDnsZoneVirtualNetworkLinkCreateUpdate(d *schema.ResourceData
 
 	parameters := privatedns.VirtualNetworkLink{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		VirtualNetworkLinkProperties: &privatedns.VirtualNetworkLinkProperties{
 			VirtualNetwork: &privatedns.SubResource{
 				ID: &vNetID,
@@ -162,7 +162,7 @@ func resourcePrivateDnsZoneVirtualNetworkLinkRead(d *schema.ResourceData, meta i
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourcePrivateDnsZoneVirtualNetworkLinkDelete(d *schema.ResourceData, meta interface{}) error {
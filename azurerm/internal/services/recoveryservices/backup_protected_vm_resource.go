@@ -106,7 +106,7 @@ func resourceRecoveryServicesBackupProtectedVMCreateUpdate(d *schema.ResourceDat
 	}
 
 	item := backup.ProtectedItemResource{
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 		Properties: &backup.AzureIaaSComputeVMProtectedItem{
 			PolicyID:          &policyId,
 			ProtectedItemType: backup.ProtectedItemTypeMicrosoftClassicComputevirtualMachines,
@@ -172,7 +172,7 @@ func resourceRecoveryServicesBackupProtectedVMRead(d *schema.ResourceData, meta
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceRecoveryServicesBackupProtectedVMDelete(d *schema.ResourceData, meta interface{}) error {
@@ -126,7 +126,7 @@ func resourceRecoveryServicesVaultCreateUpdate(d *schema.ResourceData, meta inte
 
 	vault := recoveryservices.Vault{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		Identity: expandValutIdentity(d.Get("identity").([]interface{})),
 		Sku: &recoveryservices.Sku{
 			Name: recoveryservices.SkuName(d.Get("sku").(string)),
@@ -240,7 +240,7 @@ func resourceRecoveryServicesVaultRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceRecoveryServicesVaultDelete(d *schema.ResourceData, meta interface{}) error {
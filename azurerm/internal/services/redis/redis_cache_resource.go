@@ -18,7 +18,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	networkParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/redis/parse"
@@ -300,7 +299,7 @@ func resourceRedisCacheCreate(d *schema.ResourceData, meta interface{}) error {
 	sku := redis.SkuName(d.Get("sku_name").(string))
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := meta.(*clients.Client).Tags.Expand(t)
 
 	id := parse.NewCacheID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	existing, err := client.Get(ctx, id.ResourceGroup, id.RediName)
@@ -355,11 +354,9 @@ func resourceRedisCacheCreate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
-		locks.ByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-		defer locks.UnlockByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-
-		locks.ByName(parsed.Name, network.SubnetResourceName)
-		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
+		virtualNetworkId := networkParse.NewVirtualNetworkID(parsed.SubscriptionId, parsed.ResourceGroup, parsed.VirtualNetworkName)
+		locks.MultipleByResourceID(virtualNetworkId, *parsed)
+		defer locks.UnlockMultipleByResourceID(virtualNetworkId, *parsed)
 
 		parameters.SubnetID = utils.String(v.(string))
 	}
@@ -420,7 +417,7 @@ func resourceRedisCacheUpdate(d *schema.ResourceData, meta interface{}) error {
 	sku := redis.SkuName(d.Get("sku_name").(string))
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := meta.(*clients.Client).Tags.Expand(t)
 
 	parameters := redis.UpdateParameters{
 		UpdateProperties: &redis.UpdateProperties{
@@ -581,7 +578,7 @@ func resourceRedisCacheRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("secondary_connection_string", getRedisConnectionString(*props.HostName, *props.SslPort, *keysResp.SecondaryKey, enableSslPort))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceRedisCacheDelete(d *schema.ResourceData, meta interface{}) error {
@@ -608,11 +605,9 @@ func resourceRedisCacheDelete(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
-		locks.ByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-		defer locks.UnlockByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-
-		locks.ByName(parsed.Name, network.SubnetResourceName)
-		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
+		virtualNetworkId := networkParse.NewVirtualNetworkID(parsed.SubscriptionId, parsed.ResourceGroup, parsed.VirtualNetworkName)
+		locks.MultipleByResourceID(virtualNetworkId, *parsed)
+		defer locks.UnlockMultipleByResourceID(virtualNetworkId, *parsed)
 	}
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.RediName)
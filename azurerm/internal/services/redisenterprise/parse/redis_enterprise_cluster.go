@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RedisEnterpriseClusterId struct {
@@ -67,3 +68,19 @@ func RedisEnterpriseClusterID(input string) (*RedisEnterpriseClusterId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "redisenterprise",
+		TypeName:           "RedisEnterpriseCluster",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Cache/redisEnterprise/cluster1",
+		ResourceTypes:      []string{"azurerm_redis_enterprise_cluster"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := RedisEnterpriseClusterID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
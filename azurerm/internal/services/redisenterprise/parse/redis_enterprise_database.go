@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RedisEnterpriseDatabaseId struct {
@@ -73,3 +74,19 @@ func RedisEnterpriseDatabaseID(input string) (*RedisEnterpriseDatabaseId, error)
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "redisenterprise",
+		TypeName:           "RedisEnterpriseDatabase",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Cache/redisEnterprise/cluster1/databases/database1",
+		ResourceTypes:      []string{"azurerm_redis_enterprise_database"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := RedisEnterpriseDatabaseID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
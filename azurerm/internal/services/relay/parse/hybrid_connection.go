@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HybridConnectionId struct {
@@ -73,3 +74,19 @@ func HybridConnectionID(input string) (*HybridConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "relay",
+		TypeName:           "HybridConnection",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Relay/namespaces/namespace1/hybridConnections/hybridConnection1",
+		ResourceTypes:      []string{"azurerm_relay_hybrid_connection"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := HybridConnectionID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -105,7 +105,7 @@ func resourceRelayNamespaceCreateUpdate(d *schema.ResourceData, meta interface{}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := meta.(*clients.Client).Tags.Expand(t)
 
 	resourceId := parse.NewNamespaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
@@ -186,7 +186,7 @@ func resourceRelayNamespaceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("secondary_connection_string", keysResp.SecondaryConnectionString)
 	d.Set("secondary_key", keysResp.SecondaryKey)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceRelayNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
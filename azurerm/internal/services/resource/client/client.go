@@ -9,6 +9,7 @@ import (
 
 type Client struct {
 	DeploymentsClient *resources.DeploymentsClient
+	GenericClient     *GenericClient
 	GroupsClient      *resources.GroupsClient
 	LocksClient       *locks.ManagementLocksClient
 	ProvidersClient   *providers.ProvidersClient
@@ -19,6 +20,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	deploymentsClient := resources.NewDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&deploymentsClient.Client, o.ResourceManagerAuthorizer)
 
+	genericClient := NewGenericClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&genericClient.Client, o.ResourceManagerAuthorizer)
+
 	groupsClient := resources.NewGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -33,6 +37,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&resourcesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
+		GenericClient:     &genericClient,
 		GroupsClient:      &groupsClient,
 		DeploymentsClient: &deploymentsClient,
 		LocksClient:       &locksClient,
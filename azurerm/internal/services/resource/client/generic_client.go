@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// GenericClient sends requests for any Resource Type (using any API Version) to Azure Resource Manager,
+// where the request and response bodies are arbitrary JSON - used where the Resource Type (or API Version)
+// isn't otherwise supported by the Provider
+type GenericClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+func NewGenericClientWithBaseURI(baseURI string) GenericClient {
+	return GenericClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: baseURI,
+	}
+}
+
+// CreateOrUpdate creates (or updates) the Resource with the specified ID and waits for this to complete
+func (c GenericClient) CreateOrUpdate(ctx context.Context, resourceId, apiVersion string, body interface{}) error {
+	resp, err := c.send(ctx, http.MethodPut, resourceId, apiVersion, body, http.StatusOK, http.StatusCreated, http.StatusAccepted)
+	if err != nil {
+		return err
+	}
+
+	resp, err = c.waitForCompletion(ctx, resp)
+	if err != nil {
+		return err
+	}
+
+	return autorest.Respond(resp, autorest.ByClosing())
+}
+
+// Get retrieves the Resource with the specified ID - the response is returned so that callers can check
+// whether the Resource exists using `utils.ResponseWasNotFound`
+func (c GenericClient) Get(ctx context.Context, resourceId, apiVersion string) (result interface{}, response autorest.Response, err error) {
+	resp, err := c.send(ctx, http.MethodGet, resourceId, apiVersion, nil, http.StatusOK)
+	if resp != nil {
+		response = autorest.Response{Response: resp}
+	}
+	if err != nil {
+		return nil, response, err
+	}
+
+	result, err = unmarshalResponseBody(resp)
+	return result, response, err
+}
+
+// Delete deletes the Resource with the specified ID and waits for this to complete
+func (c GenericClient) Delete(ctx context.Context, resourceId, apiVersion string) (response autorest.Response, err error) {
+	resp, err := c.send(ctx, http.MethodDelete, resourceId, apiVersion, nil, http.StatusOK, http.StatusAccepted, http.StatusNoContent)
+	if resp != nil {
+		response = autorest.Response{Response: resp}
+	}
+	if err != nil {
+		return response, err
+	}
+
+	resp, err = c.waitForCompletion(ctx, resp)
+	if err != nil {
+		return response, err
+	}
+
+	return response, autorest.Respond(resp, autorest.ByClosing())
+}
+
+// Action performs the Action (for example `listKeys`) on the Resource with the specified ID, waits for this
+// to complete and returns the response body (or nil if there isn't one)
+func (c GenericClient) Action(ctx context.Context, resourceId, action, apiVersion string, body interface{}) (interface{}, error) {
+	resp, err := c.send(ctx, http.MethodPost, fmt.Sprintf("%s/%s", resourceId, action), apiVersion, body, http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = c.waitForCompletion(ctx, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalResponseBody(resp)
+}
+
+func (c GenericClient) send(ctx context.Context, method, path, apiVersion string, body interface{}, expectedStatusCodes ...int) (*http.Response, error) {
+	decorators := []autorest.PrepareDecorator{
+		autorest.WithMethod(method),
+		autorest.WithBaseURL(c.BaseURI),
+		autorest.WithPath(path),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}),
+	}
+	if body != nil {
+		decorators = append(decorators, autorest.AsContentType("application/json; charset=utf-8"), autorest.WithJSON(body))
+	}
+
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx), decorators...)
+	if err != nil {
+		return nil, fmt.Errorf("preparing %s request for %q: %+v", method, path, err)
+	}
+
+	resp, err := c.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return resp, fmt.Errorf("sending %s request for %q: %+v", method, path, err)
+	}
+
+	if err := autorest.Respond(resp, azure.WithErrorUnlessStatusCode(expectedStatusCodes...)); err != nil {
+		return resp, fmt.Errorf("%s %q: %+v", method, path, err)
+	}
+
+	return resp, nil
+}
+
+// waitForCompletion waits for a long-running operation to complete (if the response is for a long-running
+// operation) and returns the final response
+func (c GenericClient) waitForCompletion(ctx context.Context, resp *http.Response) (*http.Response, error) {
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusAccepted {
+		return resp, nil
+	}
+	if resp.Header.Get("Azure-AsyncOperation") == "" && resp.Header.Get("Location") == "" {
+		return resp, nil
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return nil, fmt.Errorf("building the long-running operation for %q: %+v", resp.Request.URL.Path, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, c.Client); err != nil {
+		return nil, fmt.Errorf("waiting for the long-running operation for %q: %+v", resp.Request.URL.Path, err)
+	}
+
+	if resp.Request.Method != http.MethodPost {
+		return future.Response(), nil
+	}
+
+	// the result of an Action is only available once the long-running operation has completed
+	result, err := future.GetResult(c.Client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the result of the long-running operation for %q: %+v", resp.Request.URL.Path, err)
+	}
+	return result, nil
+}
+
+func unmarshalResponseBody(resp *http.Response) (interface{}, error) {
+	if resp == nil || resp.Body == nil {
+		return nil, nil
+	}
+	defer resp.Body.Close()
+
+	contents, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %+v", err)
+	}
+	if len(contents) == 0 {
+		return nil, nil
+	}
+
+	var output interface{}
+	if err := json.Unmarshal(contents, &output); err != nil {
+		return nil, fmt.Errorf("parsing response body: %+v", err)
+	}
+	return output, nil
+}
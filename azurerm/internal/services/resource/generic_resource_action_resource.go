@@ -0,0 +1,121 @@
+package resource
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceGenericResourceAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGenericResourceActionCreate,
+		Read:   resourceGenericResourceActionRead,
+		Delete: resourceGenericResourceActionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GenericResourceTypeWithAPIVersion,
+			},
+
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GenericResourceID,
+			},
+
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc:    utils.NormalizeJson,
+			},
+
+			"output": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+				// NOTE: Actions such as `listKeys` return secrets, so this is marked as Sensitive
+			},
+		},
+	}
+}
+
+func resourceGenericResourceActionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.GenericClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceType, apiVersion, err := parse.GenericResourceTypeWithAPIVersion(d.Get("type").(string))
+	if err != nil {
+		return err
+	}
+
+	id, err := parse.GenericResourceID(d.Get("resource_id").(string))
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(id.ResourceType, resourceType) {
+		return fmt.Errorf("`resource_id` must be the ID of a %s but got a %s (%q)", resourceType, id.ResourceType, id.ID())
+	}
+
+	var body interface{}
+	if v := d.Get("body").(string); v != "" {
+		body, err = expandGenericResourceBody(v)
+		if err != nil {
+			return fmt.Errorf("expanding `body`: %+v", err)
+		}
+	}
+
+	action := d.Get("action").(string)
+	resp, err := client.Action(ctx, id.ID(), action, apiVersion, body)
+	if err != nil {
+		return fmt.Errorf("performing Action %q on %s %q: %+v", action, id.ResourceType, id.ID(), err)
+	}
+
+	output, err := flattenTemplateDeploymentBody(resp)
+	if err != nil {
+		return fmt.Errorf("flattening `output`: %+v", err)
+	}
+	d.Set("output", output)
+
+	d.SetId(fmt.Sprintf("%s/%s", id.ID(), action))
+
+	return resourceGenericResourceActionRead(d, meta)
+}
+
+func resourceGenericResourceActionRead(_ *schema.ResourceData, _ interface{}) error {
+	// Actions are performed once when this resource is created, and the output is only available from the
+	// response - so there's nothing to read here
+	return nil
+}
+
+func resourceGenericResourceActionDelete(_ *schema.ResourceData, _ interface{}) error {
+	// Actions can't be undone, so this only removes the resource from the state
+	return nil
+}
@@ -0,0 +1,49 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type GenericResourceActionResource struct {
+}
+
+func TestAccGenericResourceAction_listKeys(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_action", "test")
+	r := GenericResourceActionResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.listKeys(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("output").Exists(),
+			),
+		},
+	})
+}
+
+func (GenericResourceActionResource) Exists(_ context.Context, _ *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	// Actions only exist within the state, since they're performed once when the resource is created
+	return utils.Bool(state.ID != ""), nil
+}
+
+func (GenericResourceActionResource) listKeys(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_action" "test" {
+  type        = "Microsoft.Storage/storageAccounts@%s"
+  resource_id = azurerm_resource.test.id
+  action      = "listKeys"
+}
+`, GenericResourceResource{}.basic(data, "Hot"), genericResourceTestAPIVersion)
+}
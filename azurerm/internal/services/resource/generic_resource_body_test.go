@@ -0,0 +1,142 @@
+package resource
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestProjectGenericResourceBody(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Expected string
+		Actual   string
+		Output   string
+	}{
+		{
+			Name:     "unchanged",
+			Expected: `{"properties": {"enabled": true}}`,
+			Actual:   `{"properties": {"enabled": true}}`,
+			Output:   `{"properties": {"enabled": true}}`,
+		},
+		{
+			Name:     "additional properties returned from Azure are ignored",
+			Expected: `{"location": "westeurope", "properties": {"enabled": true}}`,
+			Actual:   `{"id": "/some/id", "location": "westeurope", "properties": {"enabled": true, "provisioningState": "Succeeded"}}`,
+			Output:   `{"location": "westeurope", "properties": {"enabled": true}}`,
+		},
+		{
+			Name:     "changed values are detected",
+			Expected: `{"properties": {"enabled": true, "tier": "Basic"}}`,
+			Actual:   `{"properties": {"enabled": false, "tier": "Basic"}}`,
+			Output:   `{"properties": {"enabled": false, "tier": "Basic"}}`,
+		},
+		{
+			Name:     "values which aren't returned are taken from the configuration",
+			Expected: `{"properties": {"password": "secret", "username": "admin"}}`,
+			Actual:   `{"properties": {"username": "admin"}}`,
+			Output:   `{"properties": {"password": "secret", "username": "admin"}}`,
+		},
+		{
+			Name:     "lists of the same length are projected",
+			Expected: `{"properties": {"rules": [{"name": "first"}]}}`,
+			Actual:   `{"properties": {"rules": [{"name": "first", "priority": 100}]}}`,
+			Output:   `{"properties": {"rules": [{"name": "first"}]}}`,
+		},
+		{
+			Name:     "lists of a different length are returned as-is",
+			Expected: `{"properties": {"rules": [{"name": "first"}]}}`,
+			Actual:   `{"properties": {"rules": [{"name": "first"}, {"name": "second"}]}}`,
+			Output:   `{"properties": {"rules": [{"name": "first"}, {"name": "second"}]}}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := projectGenericResourceBody(unmarshalTestJson(t, v.Expected), unmarshalTestJson(t, v.Actual))
+		if expected := unmarshalTestJson(t, v.Output); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("Expected %+v but got %+v", expected, actual)
+		}
+	}
+}
+
+func TestWithoutGenericResourceReadOnlyFields(t *testing.T) {
+	input := unmarshalTestJson(t, `{"id": "/some/id", "name": "example", "type": "Microsoft.Foo/bars", "etag": "abc", "systemData": {}, "location": "westeurope", "properties": {"enabled": true, "provisioningState": "Succeeded"}}`)
+	expected := unmarshalTestJson(t, `{"location": "westeurope", "properties": {"enabled": true}}`)
+
+	if actual := withoutGenericResourceReadOnlyFields(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func unmarshalTestJson(t *testing.T, input string) interface{} {
+	var output interface{}
+	if err := json.Unmarshal([]byte(input), &output); err != nil {
+		t.Fatalf("parsing %q: %+v", input, err)
+	}
+	return output
+}
+
+func TestGenericResourceTypeChangeForcesNew(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Existing    string
+		New         string
+		RequiresNew bool
+	}{
+		{
+			Name:        "API Version changed",
+			Existing:    "Microsoft.Storage/storageAccounts@2019-06-01",
+			New:         "Microsoft.Storage/storageAccounts@2021-01-01",
+			RequiresNew: false,
+		},
+		{
+			Name:        "Resource Type casing changed",
+			Existing:    "Microsoft.Storage/storageAccounts@2021-01-01",
+			New:         "Microsoft.Storage/StorageAccounts@2021-01-01",
+			RequiresNew: false,
+		},
+		{
+			Name:        "Resource Type changed",
+			Existing:    "Microsoft.Storage/storageAccounts@2021-01-01",
+			New:         "Microsoft.Network/virtualNetworks@2021-01-01",
+			RequiresNew: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		resource := resourceGenericResource()
+		state := &terraform.InstanceState{
+			ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example",
+			Attributes: map[string]string{
+				"id":        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example",
+				"type":      v.Existing,
+				"parent_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+				"name":      "example",
+				"body":      `{"location":"westeurope"}`,
+			},
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"type":      v.New,
+			"parent_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			"name":      "example",
+			"body":      `{"location":"westeurope"}`,
+		})
+
+		diff, err := resource.Diff(state, config, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if diff == nil {
+			t.Fatalf("expected a diff but didn't get one")
+		}
+		if diff.RequiresNew() != v.RequiresNew {
+			t.Fatalf("expected RequiresNew to be %t but got %t", v.RequiresNew, diff.RequiresNew())
+		}
+	}
+}
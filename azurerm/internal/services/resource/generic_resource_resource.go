@@ -0,0 +1,350 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// genericResourceReadOnlyFields are the top-level fields returned by Azure Resource Manager which can't be
+// specified when creating or updating a Resource - and as such are omitted from the `body` when importing
+var genericResourceReadOnlyFields = []string{
+	"etag",
+	"id",
+	"name",
+	"systemData",
+	"type",
+}
+
+func resourceGenericResource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGenericResourceCreate,
+		Read:   resourceGenericResourceRead,
+		Update: resourceGenericResourceUpdate,
+		Delete: resourceGenericResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGenericResourceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				// NOTE: changing the API Version can be done in-place, see the CustomizeDiff below
+				ValidateFunc: validate.GenericResourceTypeWithAPIVersion,
+			},
+
+			"parent_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GenericResourceParentID,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc:    utils.NormalizeJson,
+			},
+
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+				// NOTE: this is the entire Resource as returned from Azure Resource Manager, which allows users
+				// to access read-only properties (and properties set by Azure) using `jsondecode`
+			},
+		},
+
+		CustomizeDiff: customdiff.ForceNewIfChange("type", func(old, new, meta interface{}) bool {
+			// only a change to the Resource Type requires recreation, the API Version can be changed in-place
+			oldType, _, err := parse.GenericResourceTypeWithAPIVersion(old.(string))
+			if err != nil {
+				return true
+			}
+			newType, _, err := parse.GenericResourceTypeWithAPIVersion(new.(string))
+			if err != nil {
+				return true
+			}
+			return !strings.EqualFold(oldType, newType)
+		}),
+	}
+}
+
+func resourceGenericResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.GenericClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceType, apiVersion, err := parse.GenericResourceTypeWithAPIVersion(d.Get("type").(string))
+	if err != nil {
+		return err
+	}
+
+	id, err := parse.NewGenericResourceID(d.Get("parent_id").(string), resourceType, d.Get("name").(string))
+	if err != nil {
+		return err
+	}
+
+	_, existing, err := client.Get(ctx, id.ID(), apiVersion)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing) {
+			return fmt.Errorf("checking for presence of existing %s %q: %+v", id.ResourceType, id.ID(), err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing) {
+		return tf.ImportAsExistsError("azurerm_resource", id.ID())
+	}
+
+	body, err := expandGenericResourceBody(d.Get("body").(string))
+	if err != nil {
+		return fmt.Errorf("expanding `body`: %+v", err)
+	}
+
+	if err := client.CreateOrUpdate(ctx, id.ID(), apiVersion, body); err != nil {
+		return fmt.Errorf("creating %s %q: %+v", id.ResourceType, id.ID(), err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceGenericResourceRead(d, meta)
+}
+
+func resourceGenericResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.GenericClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GenericResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, apiVersion, err := parse.GenericResourceTypeWithAPIVersion(d.Get("type").(string))
+	if err != nil {
+		return err
+	}
+
+	body, err := expandGenericResourceBody(d.Get("body").(string))
+	if err != nil {
+		return fmt.Errorf("expanding `body`: %+v", err)
+	}
+
+	if err := client.CreateOrUpdate(ctx, id.ID(), apiVersion, body); err != nil {
+		return fmt.Errorf("updating %s %q: %+v", id.ResourceType, id.ID(), err)
+	}
+
+	return resourceGenericResourceRead(d, meta)
+}
+
+func resourceGenericResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.GenericClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GenericResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, apiVersion, err := parse.GenericResourceTypeWithAPIVersion(d.Get("type").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, response, err := client.Get(ctx, id.ID(), apiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(response) {
+			log.Printf("[DEBUG] %s %q was not found - removing from state", id.ResourceType, id.ID())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s %q: %+v", id.ResourceType, id.ID(), err)
+	}
+
+	output, err := flattenTemplateDeploymentBody(resp)
+	if err != nil {
+		return fmt.Errorf("flattening `output`: %+v", err)
+	}
+	d.Set("output", output)
+
+	// Azure Resource Manager returns both the properties which were specified and those which were defaulted,
+	// so to detect drift only the properties which were specified (within `body`) are compared - when importing
+	// there's nothing to compare against so everything except the read-only fields is used
+	var existingBody interface{}
+	if v := d.Get("body").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &existingBody); err != nil {
+			return fmt.Errorf("parsing `body`: %+v", err)
+		}
+	}
+
+	var body interface{}
+	if existingBody != nil {
+		body = projectGenericResourceBody(existingBody, resp)
+	} else {
+		body = withoutGenericResourceReadOnlyFields(resp)
+	}
+
+	flattenedBody, err := flattenTemplateDeploymentBody(body)
+	if err != nil {
+		return fmt.Errorf("flattening `body`: %+v", err)
+	}
+	d.Set("body", flattenedBody)
+
+	d.Set("parent_id", id.ParentId)
+	d.Set("name", id.Name)
+
+	return nil
+}
+
+func resourceGenericResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.GenericClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GenericResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, apiVersion, err := parse.GenericResourceTypeWithAPIVersion(d.Get("type").(string))
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ID(), apiVersion); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s %q: %+v", id.ResourceType, id.ID(), err)
+		}
+	}
+
+	return nil
+}
+
+// resourceGenericResourceImport imports a Resource using the ID in the format `{resourceId}?api-version={apiVersion}`
+// since the API Version to use can't be determined from the Resource ID alone
+func resourceGenericResourceImport(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "?api-version=")
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("expected an ID in the format `{resourceId}?api-version={apiVersion}` but got %q", d.Id())
+	}
+
+	id, err := parse.GenericResourceID(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", parts[0], err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("type", fmt.Sprintf("%s@%s", id.ResourceType, parts[1]))
+	d.Set("parent_id", id.ParentId)
+	d.Set("name", id.Name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandGenericResourceBody(input string) (interface{}, error) {
+	var output interface{}
+	if err := json.Unmarshal([]byte(input), &output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// projectGenericResourceBody returns the values from `actual` using the shape of `expected` - such that
+// properties not specified by the user are ignored, whilst any values which have changed are returned.
+// Values which are specified but not returned (for example, secrets) are taken from `expected`.
+func projectGenericResourceBody(expected interface{}, actual interface{}) interface{} {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return actual
+		}
+
+		output := make(map[string]interface{})
+		for k, v := range e {
+			actualValue, exists := a[k]
+			if !exists {
+				output[k] = v
+				continue
+			}
+
+			output[k] = projectGenericResourceBody(v, actualValue)
+		}
+		return output
+
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(e) {
+			return actual
+		}
+
+		output := make([]interface{}, 0)
+		for i, v := range e {
+			output = append(output, projectGenericResourceBody(v, a[i]))
+		}
+		return output
+	}
+
+	if actual == nil {
+		return expected
+	}
+	return actual
+}
+
+func withoutGenericResourceReadOnlyFields(input interface{}) interface{} {
+	v, ok := input.(map[string]interface{})
+	if !ok {
+		return input
+	}
+
+	output := make(map[string]interface{})
+	for key, value := range v {
+		output[key] = value
+	}
+
+	for _, field := range genericResourceReadOnlyFields {
+		delete(output, field)
+	}
+
+	if properties, ok := output["properties"].(map[string]interface{}); ok {
+		filtered := make(map[string]interface{})
+		for key, value := range properties {
+			if key == "provisioningState" {
+				continue
+			}
+			filtered[key] = value
+		}
+		output["properties"] = filtered
+	}
+
+	return output
+}
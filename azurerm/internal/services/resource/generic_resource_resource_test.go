@@ -0,0 +1,184 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const genericResourceTestAPIVersion = "2021-01-01"
+
+type GenericResourceResource struct {
+}
+
+func TestAccGenericResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource", "test")
+	r := GenericResourceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, "Hot"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("output").Exists(),
+			),
+		},
+		r.importStep(data),
+	})
+}
+
+func TestAccGenericResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource", "test")
+	r := GenericResourceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, "Hot"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccGenericResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource", "test")
+	r := GenericResourceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, "Hot"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		r.importStep(data),
+		{
+			Config: r.basic(data, "Cool"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		r.importStep(data),
+	})
+}
+
+func TestAccGenericResource_nested(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource", "container")
+	r := GenericResourceResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.nested(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		r.importStep(data),
+	})
+}
+
+func (GenericResourceResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.GenericResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, _, err := clients.Resource.GenericClient.Get(ctx, id.ID(), genericResourceTestAPIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s %q: %+v", id.ResourceType, id.ID(), err)
+	}
+
+	return utils.Bool(resp != nil), nil
+}
+
+// importStep imports the resource using the ID in the format `{resourceId}?api-version={apiVersion}`
+// rather than the ID within the state, since the API Version can't be determined from the Resource ID
+func (GenericResourceResource) importStep(data acceptance.TestData) resource.TestStep {
+	step := data.ImportStep("body")
+	step.ImportStateIdFunc = func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return "", fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+
+		return fmt.Sprintf("%s?api-version=%s", rs.Primary.ID, genericResourceTestAPIVersion), nil
+	}
+	return step
+}
+
+func (GenericResourceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-generic-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r GenericResourceResource) basic(data acceptance.TestData, accessTier string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource" "test" {
+  type      = "Microsoft.Storage/storageAccounts@%s"
+  parent_id = azurerm_resource_group.test.id
+  name      = "acctestsa%s"
+
+  body = jsonencode({
+    location = azurerm_resource_group.test.location
+    kind     = "StorageV2"
+    sku = {
+      name = "Standard_LRS"
+    }
+    properties = {
+      accessTier = "%s"
+    }
+  })
+}
+`, r.template(data), genericResourceTestAPIVersion, data.RandomString, accessTier)
+}
+
+func (r GenericResourceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource" "import" {
+  type      = azurerm_resource.test.type
+  parent_id = azurerm_resource.test.parent_id
+  name      = azurerm_resource.test.name
+  body      = azurerm_resource.test.body
+}
+`, r.basic(data, "Hot"))
+}
+
+func (r GenericResourceResource) nested(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource" "container" {
+  type      = "Microsoft.Storage/storageAccounts/blobServices/containers@%s"
+  parent_id = "${azurerm_resource.test.id}/blobServices/default"
+  name      = "acctestcontainer"
+
+  body = jsonencode({
+    properties = {
+      publicAccess = "None"
+    }
+  })
+}
+`, r.basic(data, "Hot"), genericResourceTestAPIVersion)
+}
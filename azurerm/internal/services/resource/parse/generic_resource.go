@@ -0,0 +1,104 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GenericResourceId is the ID of any Azure Resource, where the Resource Type is `{Namespace}/{Type}[/{Type}..]`
+type GenericResourceId struct {
+	ParentId     string
+	ResourceType string
+	Name         string
+}
+
+// NewGenericResourceID returns the ID of the Resource of the specified type and name within the Parent, which
+// is either a scope (e.g. a Resource Group) or - for nested Resource Types - the parent Resource
+func NewGenericResourceID(parentId, resourceType, name string) (*GenericResourceId, error) {
+	segments := strings.Split(resourceType, "/")
+	if len(segments) > 2 {
+		parentType := strings.Join(segments[0:len(segments)-1], "/")
+		parent, err := GenericResourceID(parentId)
+		if err != nil || !strings.EqualFold(parent.ResourceType, parentType) {
+			return nil, fmt.Errorf("the parent of a Resource of type %q must be a Resource of type %q but got %q", resourceType, parentType, parentId)
+		}
+	}
+
+	return &GenericResourceId{
+		ParentId:     strings.TrimSuffix(parentId, "/"),
+		ResourceType: resourceType,
+		Name:         name,
+	}, nil
+}
+
+func (id GenericResourceId) ID() string {
+	segments := strings.Split(id.ResourceType, "/")
+	if len(segments) > 2 {
+		// nested Resources are within their parent Resource
+		return fmt.Sprintf("%s/%s/%s", id.ParentId, segments[len(segments)-1], id.Name)
+	}
+
+	fmtString := "%s/providers/%s/%s"
+	return fmt.Sprintf(fmtString, id.ParentId, id.ResourceType, id.Name)
+}
+
+// GenericResourceID parses the ID of any Azure Resource into a GenericResourceId struct
+func GenericResourceID(input string) (*GenericResourceId, error) {
+	index := strings.LastIndex(strings.ToLower(input), "/providers/")
+	if !strings.HasPrefix(input, "/") || index == -1 {
+		return nil, fmt.Errorf("ID was missing the 'providers' element")
+	}
+
+	scope := input[0:index]
+	if scope == "" {
+		return nil, fmt.Errorf("ID was missing the scope prior to the 'providers' element")
+	}
+
+	// {Namespace}/{Type}/{Name}[/{Type}/{Name}..]
+	segments := strings.Split(input[index+len("/providers/"):], "/")
+	if len(segments) < 3 || len(segments)%2 != 1 {
+		return nil, fmt.Errorf("ID was expected to contain `{Namespace}/{Type}/{Name}` after the 'providers' element but got %q", input)
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("ID contained an empty segment: %q", input)
+		}
+	}
+
+	types := []string{segments[0]}
+	for i := 1; i < len(segments)-1; i += 2 {
+		types = append(types, segments[i])
+	}
+
+	parentId := scope
+	if len(segments) > 3 {
+		parentId = strings.Join(append([]string{scope, "providers"}, segments[0:len(segments)-2]...), "/")
+	}
+
+	return &GenericResourceId{
+		ParentId:     parentId,
+		ResourceType: strings.Join(types, "/"),
+		Name:         segments[len(segments)-1],
+	}, nil
+}
+
+// GenericResourceTypeWithAPIVersion parses a Resource Type with an API Version, in the format
+// `{Namespace}/{Type}[/{Type}..]@{APIVersion}` - for example `Microsoft.Foo/bars@2021-01-01`
+func GenericResourceTypeWithAPIVersion(input string) (resourceType string, apiVersion string, err error) {
+	parts := strings.Split(input, "@")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected the format `{Namespace}/{Type}@{APIVersion}` but got %q", input)
+	}
+
+	segments := strings.Split(parts[0], "/")
+	if len(segments) < 2 {
+		return "", "", fmt.Errorf("expected the Resource Type to be in the format `{Namespace}/{Type}` but got %q", parts[0])
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			return "", "", fmt.Errorf("the Resource Type %q contained an empty segment", parts[0])
+		}
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,201 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = GenericResourceId{}
+
+func TestGenericResourceIDFormatter(t *testing.T) {
+	testData := []struct {
+		ParentId     string
+		ResourceType string
+		Name         string
+		Expected     string
+		Error        bool
+	}{
+		{
+			ParentId:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			ResourceType: "Microsoft.Foo/bars",
+			Name:         "bar1",
+			Expected:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1",
+		},
+		{
+			// nested Resource
+			ParentId:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1",
+			ResourceType: "Microsoft.Foo/bars/bazs",
+			Name:         "baz1",
+			Expected:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1/bazs/baz1",
+		},
+		{
+			// extension Resource
+			ParentId:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1",
+			ResourceType: "Microsoft.Authorization/locks",
+			Name:         "lock1",
+			Expected:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1/providers/Microsoft.Authorization/locks/lock1",
+		},
+		{
+			// nested Resource within the wrong parent
+			ParentId:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			ResourceType: "Microsoft.Foo/bars/bazs",
+			Name:         "baz1",
+			Error:        true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q..", v.ParentId, v.ResourceType)
+
+		id, err := NewGenericResourceID(v.ParentId, v.ResourceType, v.Name)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual := id.ID(); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestGenericResourceID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *GenericResourceId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing providers
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Error: true,
+		},
+		{
+			// missing name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Foo/bars",
+			Error: true,
+		},
+		{
+			// missing name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Foo/bars/",
+			Error: true,
+		},
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1",
+			Expected: &GenericResourceId{
+				ParentId:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				ResourceType: "Microsoft.Foo/bars",
+				Name:         "bar1",
+			},
+		},
+		{
+			// nested
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1/bazs/baz1",
+			Expected: &GenericResourceId{
+				ParentId:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1",
+				ResourceType: "Microsoft.Foo/bars/bazs",
+				Name:         "baz1",
+			},
+		},
+		{
+			// extension
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &GenericResourceId{
+				ParentId:     "/subscriptions/12345678-1234-9876-4563-123456789012",
+				ResourceType: "Microsoft.Authorization/locks",
+				Name:         "lock1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := GenericResourceID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip as %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
+
+func TestGenericResourceTypeWithAPIVersion(t *testing.T) {
+	testData := []struct {
+		Input        string
+		Error        bool
+		ResourceType string
+		APIVersion   string
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "Microsoft.Foo/bars",
+			Error: true,
+		},
+		{
+			Input: "Microsoft.Foo@2021-01-01",
+			Error: true,
+		},
+		{
+			Input: "Microsoft.Foo/bars@",
+			Error: true,
+		},
+		{
+			Input:        "Microsoft.Foo/bars@2021-01-01",
+			ResourceType: "Microsoft.Foo/bars",
+			APIVersion:   "2021-01-01",
+		},
+		{
+			Input:        "Microsoft.Foo/bars/bazs@2021-01-01-preview",
+			ResourceType: "Microsoft.Foo/bars/bazs",
+			APIVersion:   "2021-01-01-preview",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		resourceType, apiVersion, err := GenericResourceTypeWithAPIVersion(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if resourceType != v.ResourceType || apiVersion != v.APIVersion {
+			t.Fatalf("Expected %q / %q but got %q / %q", v.ResourceType, v.APIVersion, resourceType, apiVersion)
+		}
+	}
+}
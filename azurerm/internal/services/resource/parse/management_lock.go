@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type ManagementLockId struct {
+	Scope    resourceid.Scope
+	LockName string
+}
+
+func NewManagementLockID(scope resourceid.Scope, lockName string) ManagementLockId {
+	return ManagementLockId{
+		Scope:    scope,
+		LockName: lockName,
+	}
+}
+
+func (id ManagementLockId) String() string {
+	segments := []string{
+		fmt.Sprintf("Lock Name %q", id.LockName),
+		fmt.Sprintf("Scope %s", id.Scope),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Management Lock", segmentsStr)
+}
+
+func (id ManagementLockId) ID() string {
+	fmtString := "%s/providers/Microsoft.Authorization/locks/%s"
+	return fmt.Sprintf(fmtString, id.Scope.ID(), id.LockName)
+}
+
+// ManagementLockID parses a ManagementLock ID into an ManagementLockId struct
+func ManagementLockID(input string) (*ManagementLockId, error) {
+	id, err := resourceid.ParseScopedResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ManagementLockId{
+		Scope: id.Scope,
+	}
+
+	if resourceId.LockName, err = id.PopSegment("locks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "resource",
+		TypeName:           "ManagementLock",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/locks/lock1",
+		ResourceTypes:      []string{"azurerm_management_lock"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ManagementLockID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
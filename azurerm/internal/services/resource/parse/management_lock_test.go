@@ -0,0 +1,102 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = ManagementLockId{}
+
+func TestManagementLockIDFormatter(t *testing.T) {
+	actual := NewManagementLockID(resourceid.NewResourceGroupScope("12345678-1234-9876-4563-123456789012", "resGroup1"), "lock1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/locks/lock1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestManagementLockID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ManagementLockId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing LockName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/",
+			Error: true,
+		},
+
+		{
+			// missing value for LockName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/locks/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &ManagementLockId{
+				Scope:    resourceid.NewResourceGroupScope("12345678-1234-9876-4563-123456789012", "resGroup1"),
+				LockName: "lock1",
+			},
+		},
+
+		{
+			// valid within a Subscription
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &ManagementLockId{
+				Scope:    resourceid.NewSubscriptionScope("12345678-1234-9876-4563-123456789012"),
+				LockName: "lock1",
+			},
+		},
+
+		{
+			// valid within a Resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &ManagementLockId{
+				Scope:    resourceid.Scope{Type: resourceid.ScopeTypeResource, ResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1"},
+				LockName: "lock1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.AUTHORIZATION/LOCKS/LOCK1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagementLockID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope.ID() != v.Expected.Scope.ID() {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope.ID(), actual.Scope.ID())
+		}
+		if actual.LockName != v.Expected.LockName {
+			t.Fatalf("Expected %q but got %q for LockName", v.Expected.LockName, actual.LockName)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ResourceGroupId struct {
@@ -60,3 +61,19 @@ func ResourceGroupID(input string) (*ResourceGroupId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "resource",
+		TypeName:           "ResourceGroup",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+		ResourceTypes:      []string{"azurerm_resource_group"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ResourceGroupID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ResourceGroupTemplateDeploymentId struct {
@@ -67,3 +68,19 @@ func ResourceGroupTemplateDeploymentID(input string) (*ResourceGroupTemplateDepl
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "resource",
+		TypeName:           "ResourceGroupTemplateDeployment",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Resources/deployments/deploy1",
+		ResourceTypes:      []string{"azurerm_resource_group_template_deployment"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ResourceGroupTemplateDeploymentID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
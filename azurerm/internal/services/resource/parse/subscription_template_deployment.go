@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubscriptionTemplateDeploymentId struct {
@@ -59,3 +60,19 @@ func SubscriptionTemplateDeploymentID(input string) (*SubscriptionTemplateDeploy
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "resource",
+		TypeName:           "SubscriptionTemplateDeployment",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Resources/deployments/deploy1",
+		ResourceTypes:      []string{"azurerm_subscription_template_deployment"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SubscriptionTemplateDeploymentID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -33,6 +33,8 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"azurerm_management_lock":                    resourceManagementLock(),
+		"azurerm_resource":                           resourceGenericResource(),
+		"azurerm_resource_action":                    resourceGenericResourceAction(),
 		"azurerm_resource_group":                     resourceResourceGroup(),
 		"azurerm_resource_group_template_deployment": resourceGroupTemplateDeploymentResource(),
 		"azurerm_subscription_template_deployment":   subscriptionTemplateDeploymentResource(),
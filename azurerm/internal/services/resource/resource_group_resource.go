@@ -1,8 +1,11 @@
 package resource
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
@@ -19,6 +22,15 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var (
+	// resourceGroupNestedResourcesAttempts is the number of times the Resources within a Resource Group are
+	// listed when checking whether the Resource Group contains any Resources prior to deleting it
+	resourceGroupNestedResourcesAttempts = 3
+
+	// resourceGroupNestedResourcesInterval is the interval between listing the Resources within a Resource Group
+	resourceGroupNestedResourcesInterval = 10 * time.Second
+)
+
 func resourceResourceGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceResourceGroupCreateUpdate,
@@ -71,7 +83,7 @@ func resourceResourceGroupCreateUpdate(d *schema.ResourceData, meta interface{})
 
 	parameters := resources.Group{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, name, parameters); err != nil {
@@ -111,7 +123,7 @@ func resourceResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("name", resp.Name)
 	d.Set("location", location.NormalizeNilable(resp.Location))
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -124,6 +136,18 @@ func resourceResourceGroupDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if meta.(*clients.Client).Features.ResourceGroup.PreventDeletionIfContainsResources {
+		resourcesClient := meta.(*clients.Client).Resource.ResourcesClient
+		nestedResourceIds, err := resourceGroupNestedResourceIDs(ctx, resourcesClient, id.ResourceGroup)
+		if err != nil {
+			return err
+		}
+
+		if len(nestedResourceIds) > 0 {
+			return resourceGroupContainsItemsError(id.ResourceGroup, nestedResourceIds)
+		}
+	}
+
 	deleteFuture, err := client.Delete(ctx, id.ResourceGroup)
 	if err != nil {
 		if response.WasNotFound(deleteFuture.Response()) {
@@ -144,3 +168,73 @@ func resourceResourceGroupDelete(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+// resourceGroupNestedResourceIDs returns the IDs of the Resources within the Resource Group - since the list of
+// Resources is eventually consistent, Resources which have just been deleted can still be returned, as such this
+// is checked a few times before returning the remaining Resources
+func resourceGroupNestedResourceIDs(ctx context.Context, client *resources.Client, resourceGroup string) ([]string, error) {
+	var nestedResourceIds []string
+	for attempt := 0; attempt < resourceGroupNestedResourcesAttempts; attempt++ {
+		if attempt > 0 {
+			log.Printf("[DEBUG] Resource Group %q still contains %d Resources - checking again in %s", resourceGroup, len(nestedResourceIds), resourceGroupNestedResourcesInterval)
+			select {
+			case <-time.After(resourceGroupNestedResourcesInterval):
+			case <-ctx.Done():
+				return nil, fmt.Errorf("waiting to list the Resources within Resource Group %q: %+v", resourceGroup, ctx.Err())
+			}
+		}
+
+		nestedResourceIds = make([]string, 0)
+		results, err := client.ListByResourceGroupComplete(ctx, resourceGroup, "", "", utils.Int32(500))
+		if err != nil {
+			return nil, fmt.Errorf("listing Resources within Resource Group %q: %+v", resourceGroup, err)
+		}
+		for results.NotDone() {
+			if v := results.Value(); v.ID != nil {
+				nestedResourceIds = append(nestedResourceIds, *v.ID)
+			}
+
+			if err := results.NextWithContext(ctx); err != nil {
+				return nil, fmt.Errorf("listing Resources within Resource Group %q: %+v", resourceGroup, err)
+			}
+		}
+
+		if len(nestedResourceIds) == 0 {
+			break
+		}
+	}
+
+	return nestedResourceIds, nil
+}
+
+func resourceGroupContainsItemsError(name string, nestedResourceIds []string) error {
+	sort.Strings(nestedResourceIds)
+	formattedResourceUris := make([]string, 0)
+	for _, id := range nestedResourceIds {
+		formattedResourceUris = append(formattedResourceUris, fmt.Sprintf("* `%s`", id))
+	}
+
+	return fmt.Errorf(`deleting Resource Group %[1]q: the Resource Group still contains Resources.
+
+Terraform is configured to check for Resources within the Resource Group when deleting the Resource
+Group - and this Resource Group contains the following Resources which aren't managed by Terraform:
+
+%[2]s
+
+This feature is intended to avoid the unintentional destruction of nested Resources provisioned through
+some other means (for example, an ARM Template Deployment) - as such you must either remove these Resources,
+or disable this behaviour using the feature flag %[3]s within the %[4]s block when
+configuring the Provider, for example:
+
+provider "azurerm" {
+  features {
+    resource_group {
+      prevent_deletion_if_contains_resources = false
+    }
+  }
+}
+
+When that feature flag is set, Terraform will skip checking for any Resources within the Resource Group and
+delete this using the Azure API directly (which will clear up any nested resources).
+`, name, strings.Join(formattedResourceUris, "\n"), "`prevent_deletion_if_contains_resources`", "`features`")
+}
@@ -122,7 +122,7 @@ func resourceGroupTemplateDeploymentResourceCreate(d *schema.ResourceData, meta
 			Mode:         resources.DeploymentMode(d.Get("deployment_mode").(string)),
 			Template:     template,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("parameters_content"); ok && v != "" {
@@ -215,8 +215,8 @@ func resourceGroupTemplateDeploymentResourceUpdate(d *schema.ResourceData, meta
 		deployment.Properties.Template = exportedTemplate.Template
 	}
 
-	if d.HasChange("tags") {
-		deployment.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		deployment.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	log.Printf("[DEBUG] Running validation of Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
@@ -292,7 +292,7 @@ func resourceGroupTemplateDeploymentResourceRead(d *schema.ResourceData, meta in
 	}
 	d.Set("template_content", flattenedTemplate)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceGroupTemplateDeploymentResourceDelete(d *schema.ResourceData, meta interface{}) error {
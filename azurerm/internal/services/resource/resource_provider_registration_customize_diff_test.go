@@ -0,0 +1,82 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+)
+
+func TestResourceProviderRegistrationCustomizeDiff(t *testing.T) {
+	testData := []struct {
+		Name                     string
+		ResourceProvider         string
+		SkipProviderRegistration bool
+		ProvidersToRegister      map[string]struct{}
+		ExpectError              bool
+	}{
+		{
+			Name:             "Automatically Registered",
+			ResourceProvider: "Microsoft.Compute",
+			ExpectError:      true,
+		},
+		{
+			Name:             "Not Automatically Registered",
+			ResourceProvider: "Microsoft.BlockchainTokens",
+			ExpectError:      false,
+		},
+		{
+			Name:                     "Skip Provider Registration",
+			ResourceProvider:         "Microsoft.Compute",
+			SkipProviderRegistration: true,
+			ExpectError:              false,
+		},
+		{
+			Name:             "Opted Out of Registration",
+			ResourceProvider: "Microsoft.Compute",
+			ProvidersToRegister: map[string]struct{}{
+				"Microsoft.Network": {},
+			},
+			ExpectError: false,
+		},
+		{
+			Name:             "Opted In to Registration",
+			ResourceProvider: "Microsoft.Compute",
+			ProvidersToRegister: map[string]struct{}{
+				"Microsoft.Compute": {},
+			},
+			ExpectError: true,
+		},
+	}
+
+	wrapper := sdk.NewResourceWrapper(ResourceProviderRegistrationResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		client := &clients.Client{
+			Account: &clients.ResourceManagerAccount{
+				SkipResourceProviderRegistration: v.SkipProviderRegistration,
+				ResourceProvidersToRegister:      v.ProvidersToRegister,
+			},
+			StopContext: context.TODO(),
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": v.ResourceProvider,
+		})
+
+		_, err := resource.Diff(nil, config, client)
+		if v.ExpectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
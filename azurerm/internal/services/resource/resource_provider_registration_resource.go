@@ -20,6 +20,7 @@ import (
 
 var _ sdk.Resource = ResourceProviderRegistrationResource{}
 var _ sdk.ResourceWithCustomImporter = ResourceProviderRegistrationResource{}
+var _ sdk.ResourceWithCustomizeDiff = ResourceProviderRegistrationResource{}
 
 type ResourceProviderRegistrationResource struct{}
 
@@ -102,8 +103,7 @@ func (r ResourceProviderRegistrationResource) Create() sdk.ResourceFunc {
 			}
 			log.Printf("[DEBUG] Registered Resource Provider %q.", resourceId.ResourceProvider)
 
-			metadata.SetID(resourceId)
-			return nil
+			return metadata.SetID(resourceId)
 		},
 		Timeout: 30 * time.Minute,
 	}
@@ -223,17 +223,44 @@ func (r ResourceProviderRegistrationResource) CustomImporter() sdk.ResourceRunFu
 	}
 }
 
+func (r ResourceProviderRegistrationResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var obj ResourceProviderRegistrationModel
+			if err := metadata.Decode(&obj); err != nil {
+				return err
+			}
+
+			// the name isn't known during the plan when it's interpolated from another resource,
+			// in which case this is checked during the apply instead
+			if obj.Name == "" {
+				return nil
+			}
+
+			return r.checkIfManagedByTerraform(obj.Name, metadata.Client.Account)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
 func (r ResourceProviderRegistrationResource) checkIfManagedByTerraform(name string, account *clients.ResourceManagerAccount) error {
 	if account.SkipResourceProviderRegistration {
 		return nil
 	}
 
-	for resourceProvider := range resourceproviders.Required() {
+	resourceProvidersToRegister := account.ResourceProvidersToRegister
+	if resourceProvidersToRegister == nil {
+		resourceProvidersToRegister = resourceproviders.Required()
+	}
+
+	for resourceProvider := range resourceProvidersToRegister {
 		if resourceProvider == name {
 			fmtStr := `The Resource Provider %q is automatically registered by Terraform.
 
 To manage this Resource Provider Registration with Terraform you need to opt-out
-of Automatic Resource Provider Registration (by setting 'skip_provider_registration'
+of Automatic Resource Provider Registration for this Resource Provider (either by
+using a set of 'resource_provider_registrations' which doesn't include it and removing
+it from 'resource_providers_to_register', or by setting 'skip_provider_registration'
 to 'true' in the Provider block) to avoid conflicting with Terraform.`
 			return fmt.Errorf(fmtStr, name)
 		}
@@ -1,7 +1,8 @@
 package resource
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ResourceGroup -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ResourceGroupTemplateDeployment -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Resources/deployments/deploy1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SubscriptionTemplateDeployment -id=/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Resources/deployments/deploy1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ManagementLock -id={scope}/providers/Microsoft.Authorization/locks/lock1 -resource-types=azurerm_management_lock
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ResourceGroup -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1 -resource-types=azurerm_resource_group
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ResourceGroupTemplateDeployment -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Resources/deployments/deploy1 -resource-types=azurerm_resource_group_template_deployment
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SubscriptionTemplateDeployment -id=/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Resources/deployments/deploy1 -resource-types=azurerm_subscription_template_deployment
 
 // ResourceProvider is manually maintained since the generator doesn't support outputting this information at this time
@@ -113,7 +113,7 @@ func subscriptionTemplateDeploymentResourceCreate(d *schema.ResourceData, meta i
 			Mode:         resources.Incremental,
 			Template:     template,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("parameters_content"); ok && v != "" {
@@ -203,8 +203,8 @@ func subscriptionTemplateDeploymentResourceUpdate(d *schema.ResourceData, meta i
 		deployment.Properties.Template = exportedTemplate.Template
 	}
 
-	if d.HasChange("tags") {
-		deployment.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		deployment.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	log.Printf("[DEBUG] Running validation of Subscription Template Deployment %q..", id.DeploymentName)
@@ -279,7 +279,7 @@ func subscriptionTemplateDeploymentResourceRead(d *schema.ResourceData, meta int
 	}
 	d.Set("template_content", flattenedTemplate)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func subscriptionTemplateDeploymentResourceDelete(d *schema.ResourceData, meta interface{}) error {
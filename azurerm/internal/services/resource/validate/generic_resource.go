@@ -0,0 +1,64 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+)
+
+// GenericResourceID validates that the specified ID is the ID of an Azure Resource
+func GenericResourceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := parse.GenericResourceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a resource id: %v", k, err))
+		return
+	}
+
+	return warnings, errors
+}
+
+// GenericResourceParentID validates that the specified ID is a valid scope for an Azure Resource, for
+// example a Subscription, Resource Group or another Resource
+func GenericResourceParentID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !strings.HasPrefix(v, "/") || strings.HasSuffix(v, "/") {
+		errors = append(errors, fmt.Errorf("%q must start with (and not end with) a `/` but got %q", k, v))
+		return
+	}
+
+	for _, segment := range strings.Split(strings.TrimPrefix(v, "/"), "/") {
+		if segment == "" {
+			errors = append(errors, fmt.Errorf("%q must not contain any empty segments but got %q", k, v))
+			return
+		}
+	}
+
+	return warnings, errors
+}
+
+// GenericResourceTypeWithAPIVersion validates that the specified value is a Resource Type with an API Version,
+// for example `Microsoft.Foo/bars@2021-01-01`
+func GenericResourceTypeWithAPIVersion(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, _, err := parse.GenericResourceTypeWithAPIVersion(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is invalid: %+v", k, err))
+	}
+
+	return warnings, errors
+}
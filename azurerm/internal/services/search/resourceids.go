@@ -1,3 +1,3 @@
 package search
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SearchService -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Search/searchServices/service1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SearchService -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Search/searchServices/service1 -resource-types=azurerm_search_service
@@ -197,7 +197,7 @@ func resourceSearchServiceCreateUpdate(d *schema.ResourceData, meta interface{})
 			},
 		},
 		Identity: expandSearchServiceIdentity(d.Get("identity").([]interface{})),
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if v, ok := d.GetOk("replica_count"); ok {
@@ -291,7 +291,7 @@ func resourceSearchServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `identity`: %s", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceSearchServiceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -271,7 +271,7 @@ func resourceIotSecuritySolutionCreateUpdate(d *schema.ResourceData, meta interf
 			RecommendationsConfiguration: expandIotSecuritySolutionRecommendation(d.Get("recommendations_enabled").([]interface{})),
 			UnmaskedIPLoggingStatus:      unmaskedIPLoggingStatus,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	logAnalyticsWorkspaceId := d.Get("log_analytics_workspace_id").(string)
@@ -341,7 +341,7 @@ func resourceIotSecuritySolutionRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceIotSecuritySolutionDelete(d *schema.ResourceData, meta interface{}) error {
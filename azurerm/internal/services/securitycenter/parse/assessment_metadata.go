@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AssessmentMetadataId struct {
@@ -59,3 +60,19 @@ func AssessmentMetadataID(input string) (*AssessmentMetadataId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "securitycenter",
+		TypeName:           "AssessmentMetadata",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/assessmentMetadata/metadata1",
+		ResourceTypes:      []string{"azurerm_security_center_assessment_metadata", "azurerm_security_center_assessment_policy"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := AssessmentMetadataID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type IotSecuritySolutionId struct {
@@ -67,3 +68,19 @@ func IotSecuritySolutionID(input string) (*IotSecuritySolutionId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "securitycenter",
+		TypeName:           "IotSecuritySolution",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Security/IoTSecuritySolutions/solution1",
+		ResourceTypes:      []string{"azurerm_iot_security_solution"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := IotSecuritySolutionID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
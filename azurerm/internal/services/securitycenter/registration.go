@@ -2,6 +2,7 @@ package securitycenter
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
 )
 
 type Registration struct{}
@@ -29,7 +30,6 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_advanced_threat_protection":                      resourceAdvancedThreatProtection(),
 		"azurerm_iot_security_device_group":                       resourceIotSecurityDeviceGroup(),
 		"azurerm_iot_security_solution":                           resourceIotSecuritySolution(),
-		"azurerm_security_center_assessment":                      resourceSecurityCenterAssessment(),
 		"azurerm_security_center_assessment_metadata":             resourceArmSecurityCenterAssessmentMetadata(),
 		"azurerm_security_center_assessment_policy":               resourceArmSecurityCenterAssessmentPolicy(),
 		"azurerm_security_center_contact":                         resourceSecurityCenterContact(),
@@ -41,3 +41,20 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azurerm_security_center_server_vulnerability_assessment": resourceServerVulnerabilityAssessment(),
 	}
 }
+
+// PackagePath is the relative path to this package
+func (r Registration) PackagePath() string {
+	return "TODO: do we need this?"
+}
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		SecurityCenterAssessmentResource{},
+	}
+}
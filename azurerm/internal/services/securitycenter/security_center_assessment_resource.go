@@ -1,223 +1,240 @@
 package securitycenter
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v3.0/security"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/securitycenter/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/securitycenter/validate"
-	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-func resourceSecurityCenterAssessment() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceSecurityCenterAssessmentCreateUpdate,
-		Read:   resourceSecurityCenterAssessmentRead,
-		Update: resourceSecurityCenterAssessmentCreateUpdate,
-		Delete: resourceSecurityCenterAssessmentDelete,
-
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(30 * time.Minute),
-			Read:   schema.DefaultTimeout(5 * time.Minute),
-			Update: schema.DefaultTimeout(30 * time.Minute),
-			Delete: schema.DefaultTimeout(30 * time.Minute),
-		},
+var _ sdk.Resource = SecurityCenterAssessmentResource{}
+var _ sdk.ResourceWithUpdate = SecurityCenterAssessmentResource{}
+var _ sdk.ResourceWithGeneratedSchema = SecurityCenterAssessmentResource{}
 
-		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
-			_, err := parse.AssessmentID(id)
-			return err
-		}),
-
-		Schema: map[string]*schema.Schema{
-			"assessment_policy_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validate.AssessmentMetadataID,
-			},
+type SecurityCenterAssessmentResource struct{}
 
-			"target_resource_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
-			},
+type SecurityCenterAssessmentModel struct {
+	AssessmentPolicyId string                                `tfschema:"assessment_policy_id,required,forcenew"`
+	TargetResourceId   string                                `tfschema:"target_resource_id,required,forcenew"`
+	Status             []SecurityCenterAssessmentStatusModel `tfschema:"status,required,maxitems:1"`
+	AdditionalData     map[string]string                     `tfschema:"additional_data,optional"`
+}
 
-			"status": {
-				Type:     schema.TypeList,
-				Required: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"code": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(security.Healthy),
-								string(security.NotApplicable),
-								string(security.Unhealthy),
-							}, false),
-						},
-
-						"cause": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"description": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-					},
-				},
-			},
+type SecurityCenterAssessmentStatusModel struct {
+	Code        string `tfschema:"code,required"`
+	Cause       string `tfschema:"cause,optional"`
+	Description string `tfschema:"description,optional"`
+}
 
-			"additional_data": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
-		},
-	}
+func (r SecurityCenterAssessmentResource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{}
 }
 
-func resourceSecurityCenterAssessmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AssessmentsClient
-	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
-	defer cancel()
+func (r SecurityCenterAssessmentResource) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{}
+}
 
-	metadataID, err := parse.AssessmentMetadataID(d.Get("assessment_policy_id").(string))
-	if err != nil {
-		return err
+func (r SecurityCenterAssessmentResource) ValidateFuncs() map[string]schema.SchemaValidateFunc {
+	return map[string]schema.SchemaValidateFunc{
+		"assessment_policy_id": validate.AssessmentMetadataID,
+		"target_resource_id":   azure.ValidateResourceID,
+		"status.code": validation.StringInSlice([]string{
+			string(security.Healthy),
+			string(security.NotApplicable),
+			string(security.Unhealthy),
+		}, false),
+		"status.cause":       validation.StringIsNotEmpty,
+		"status.description": validation.StringIsNotEmpty,
 	}
+}
+
+func (r SecurityCenterAssessmentResource) ModelObject() interface{} {
+	return SecurityCenterAssessmentModel{}
+}
+
+func (r SecurityCenterAssessmentResource) ResourceType() string {
+	return "azurerm_security_center_assessment"
+}
+
+func (r SecurityCenterAssessmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SecurityCenter.AssessmentsClient
+
+			var model SecurityCenterAssessmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
 
-	id := parse.NewAssessmentID(d.Get("target_resource_id").(string), metadataID.AssessmentMetadataName)
-	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.TargetResourceID, id.Name, "")
-		if err != nil {
+			metadataId, err := parse.AssessmentMetadataID(model.AssessmentPolicyId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewAssessmentID(model.TargetResourceId, metadataId.AssessmentMetadataName)
+			existing, err := client.Get(ctx, id.TargetResourceID, id.Name, "")
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Security Center Assessment %q (Target Resource ID %q): %+v", id.Name, id.TargetResourceID, err)
+			}
 			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for present of existing Security Center Assessments %q : %+v", id.ID(), err)
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
-		}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_security_center_assessment", id.ID())
-		}
-	}
+			metadata.Logger.Infof("creating Security Center Assessment %q..", id.Name)
+			if _, err := client.CreateOrUpdate(ctx, id.TargetResourceID, id.Name, expandSecurityCenterAssessment(model)); err != nil {
+				return fmt.Errorf("creating Security Center Assessment %q (Target Resource ID %q): %+v", id.Name, id.TargetResourceID, err)
+			}
 
-	assessment := security.Assessment{
-		AssessmentProperties: &security.AssessmentProperties{
-			AdditionalData: utils.ExpandMapStringPtrString(d.Get("additional_data").(map[string]interface{})),
-			ResourceDetails: &security.AzureResourceDetails{
-				Source: security.SourceAzure,
-			},
-			Status: expandSecurityCenterAssessmentStatus(d.Get("status").([]interface{})),
+			return metadata.SetID(id)
 		},
+		Timeout: 30 * time.Minute,
 	}
+}
 
-	if _, err := client.CreateOrUpdate(ctx, id.TargetResourceID, id.Name, assessment); err != nil {
-		return fmt.Errorf("creating/updating Security Center Assessment %q (target resource id %q) : %+v", id.Name, id.TargetResourceID, err)
-	}
+func (r SecurityCenterAssessmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SecurityCenter.AssessmentsClient
+			id, err := parse.AssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
 
-	d.SetId(id.ID())
+			var model SecurityCenterAssessmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			metadata.Logger.Infof("updating Security Center Assessment %q..", id.Name)
+			if _, err := client.CreateOrUpdate(ctx, id.TargetResourceID, id.Name, expandSecurityCenterAssessment(model)); err != nil {
+				return fmt.Errorf("updating Security Center Assessment %q (Target Resource ID %q): %+v", id.Name, id.TargetResourceID, err)
+			}
 
-	return resourceSecurityCenterAssessmentRead(d, meta)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
 }
 
-func resourceSecurityCenterAssessmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AssessmentsClient
-	subscriptionID := meta.(*clients.Client).Account.SubscriptionId
-	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
-	defer cancel()
+func (r SecurityCenterAssessmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SecurityCenter.AssessmentsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id, err := parse.AssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
 
-	id, err := parse.AssessmentID(d.Id())
-	if err != nil {
-		return err
-	}
+			resp, err := client.Get(ctx, id.TargetResourceID, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving Security Center Assessment %q (Target Resource ID %q): %+v", id.Name, id.TargetResourceID, err)
+			}
 
-	resp, err := client.Get(ctx, id.TargetResourceID, id.Name, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			log.Printf("[INFO] security Center Assessment %q does not exist - removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
-		return fmt.Errorf("retrieving Security Center Assessment %q (target resource id %q) : %+v", id.Name, id.TargetResourceID, err)
-	}
+			model := SecurityCenterAssessmentModel{
+				AssessmentPolicyId: parse.NewAssessmentMetadataID(subscriptionId, id.Name).ID(),
+				TargetResourceId:   id.TargetResourceID,
+			}
 
-	d.Set("assessment_policy_id", parse.NewAssessmentMetadataID(subscriptionID, id.Name).ID())
-	d.Set("target_resource_id", id.TargetResourceID)
-	if props := resp.AssessmentProperties; props != nil {
-		d.Set("additional_data", utils.FlattenMapStringPtrString(props.AdditionalData))
-		if err := d.Set("status", flattenSecurityCenterAssessmentStatus(props.Status)); err != nil {
-			return fmt.Errorf("setting `status`: %s", err)
-		}
-	}
+			if props := resp.AssessmentProperties; props != nil {
+				model.AdditionalData = flattenSecurityCenterAssessmentAdditionalData(props.AdditionalData)
+				model.Status = flattenSecurityCenterAssessmentStatus(props.Status)
+			}
 
-	return nil
+			return metadata.Encode(&model)
+		},
+		Timeout: 5 * time.Minute,
+	}
 }
 
-func resourceSecurityCenterAssessmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).SecurityCenter.AssessmentsClient
-	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
-	defer cancel()
+func (r SecurityCenterAssessmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SecurityCenter.AssessmentsClient
+			id, err := parse.AssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting Security Center Assessment %q..", id.Name)
+			if _, err := client.Delete(ctx, id.TargetResourceID, id.Name); err != nil {
+				return fmt.Errorf("deleting Security Center Assessment %q (Target Resource ID %q): %+v", id.Name, id.TargetResourceID, err)
+			}
 
-	id, err := parse.AssessmentID(d.Id())
-	if err != nil {
-		return err
+			return nil
+		},
+		Timeout: 30 * time.Minute,
 	}
+}
 
-	if _, err := client.Delete(ctx, id.TargetResourceID, id.Name); err != nil {
-		return fmt.Errorf("deleting Security Center Assessment %q (target resource id %q) : %+v", id.Name, id.TargetResourceID, err)
+func (r SecurityCenterAssessmentResource) IDValidationFunc() schema.SchemaValidateFunc {
+	return validate.AssessmentID
+}
+
+func expandSecurityCenterAssessment(input SecurityCenterAssessmentModel) security.Assessment {
+	additionalData := make(map[string]*string, len(input.AdditionalData))
+	for k, v := range input.AdditionalData {
+		additionalData[k] = utils.String(v)
 	}
 
-	return nil
+	return security.Assessment{
+		AssessmentProperties: &security.AssessmentProperties{
+			AdditionalData: additionalData,
+			ResourceDetails: &security.AzureResourceDetails{
+				Source: security.SourceAzure,
+			},
+			Status: expandSecurityCenterAssessmentStatus(input.Status),
+		},
+	}
 }
 
-func expandSecurityCenterAssessmentStatus(input []interface{}) *security.AssessmentStatus {
-	if len(input) == 0 || input[0] == nil {
+func expandSecurityCenterAssessmentStatus(input []SecurityCenterAssessmentStatusModel) *security.AssessmentStatus {
+	if len(input) == 0 {
 		return nil
 	}
 
-	v := input[0].(map[string]interface{})
+	v := input[0]
 	return &security.AssessmentStatus{
-		Code:        security.AssessmentStatusCode(v["code"].(string)),
-		Cause:       utils.String(v["cause"].(string)),
-		Description: utils.String(v["description"].(string)),
+		Code:        security.AssessmentStatusCode(v.Code),
+		Cause:       utils.String(v.Cause),
+		Description: utils.String(v.Description),
 	}
 }
 
-func flattenSecurityCenterAssessmentStatus(input *security.AssessmentStatus) []interface{} {
+func flattenSecurityCenterAssessmentStatus(input *security.AssessmentStatus) []SecurityCenterAssessmentStatusModel {
 	if input == nil {
-		return []interface{}{}
+		return []SecurityCenterAssessmentStatusModel{}
 	}
 
-	var cause, description string
-	if input.Cause != nil {
-		cause = *input.Cause
-	}
-	if input.Description != nil {
-		description = *input.Description
+	return []SecurityCenterAssessmentStatusModel{
+		{
+			Code:        string(input.Code),
+			Cause:       utils.NormalizeNilableString(input.Cause),
+			Description: utils.NormalizeNilableString(input.Description),
+		},
 	}
+}
 
-	return []interface{}{
-		map[string]interface{}{
-			"code":        string(input.Code),
-			"cause":       cause,
-			"description": description,
-		},
+func flattenSecurityCenterAssessmentAdditionalData(input map[string]*string) map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		if v == nil {
+			continue
+		}
+
+		output[k] = *v
 	}
+
+	return output
 }
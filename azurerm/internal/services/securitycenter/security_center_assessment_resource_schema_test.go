@@ -0,0 +1,46 @@
+package securitycenter
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+)
+
+func TestSecurityCenterAssessmentGeneratedSchema(t *testing.T) {
+	wrapper := sdk.NewResourceWrapper(SecurityCenterAssessmentResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("validating Resource: %+v", err)
+	}
+
+	for _, key := range []string{"assessment_policy_id", "target_resource_id"} {
+		v := resource.Schema[key]
+		if v == nil || v.Type != schema.TypeString || !v.Required || !v.ForceNew || v.ValidateFunc == nil {
+			t.Fatalf("expected %q to be a Required, ForceNew String with a ValidateFunc but got %+v", key, v)
+		}
+	}
+
+	status := resource.Schema["status"]
+	if status == nil || status.Type != schema.TypeList || !status.Required || status.MaxItems != 1 {
+		t.Fatalf("expected `status` to be a Required List with a single item but got %+v", status)
+	}
+	nested := status.Elem.(*schema.Resource).Schema
+	if v := nested["code"]; v == nil || !v.Required || v.ValidateFunc == nil {
+		t.Fatalf("expected `status.code` to be Required with a ValidateFunc but got %+v", v)
+	}
+	for _, key := range []string{"cause", "description"} {
+		if v := nested[key]; v == nil || !v.Optional || v.ValidateFunc == nil {
+			t.Fatalf("expected `status.%s` to be Optional with a ValidateFunc but got %+v", key, v)
+		}
+	}
+
+	additionalData := resource.Schema["additional_data"]
+	if additionalData == nil || additionalData.Type != schema.TypeMap || !additionalData.Optional {
+		t.Fatalf("expected `additional_data` to be an Optional Map but got %+v", additionalData)
+	}
+}
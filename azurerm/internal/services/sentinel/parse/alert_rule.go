@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AlertRuleId struct {
@@ -73,3 +74,19 @@ func AlertRuleID(input string) (*AlertRuleId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "sentinel",
+		TypeName:           "AlertRule",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/providers/Microsoft.SecurityInsights/alertRules/rule1",
+		ResourceTypes:      []string{"azurerm_sentinel_alert_rule_fusion", "azurerm_sentinel_alert_rule_ms_security_incident", "azurerm_sentinel_alert_rule_scheduled"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := AlertRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DataConnectorId struct {
@@ -73,3 +74,19 @@ func DataConnectorID(input string) (*DataConnectorId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "sentinel",
+		TypeName:           "DataConnector",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/providers/Microsoft.SecurityInsights/dataConnectors/dc1",
+		ResourceTypes:      []string{"azurerm_sentinel_data_connector_aws_cloud_trail", "azurerm_sentinel_data_connector_azure_active_directory", "azurerm_sentinel_data_connector_azure_security_center", "azurerm_sentinel_data_connector_office_365", "azurerm_sentinel_data_connector_threat_intelligence"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := DataConnectorID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SentinelAlertRuleTemplateId struct {
@@ -73,3 +74,18 @@ func SentinelAlertRuleTemplateID(input string) (*SentinelAlertRuleTemplateId, er
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "sentinel",
+		TypeName:           "SentinelAlertRuleTemplate",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/providers/Microsoft.SecurityInsights/AlertRuleTemplates/template1",
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SentinelAlertRuleTemplateID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NamespaceAuthorizationRuleId struct {
@@ -73,3 +74,19 @@ func NamespaceAuthorizationRuleID(input string) (*NamespaceAuthorizationRuleId,
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "servicebus",
+		TypeName:           "NamespaceAuthorizationRule",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/AuthorizationRules/authorizationRule1",
+		ResourceTypes:      []string{"azurerm_servicebus_namespace_authorization_rule"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := NamespaceAuthorizationRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NamespaceNetworkRuleSetId struct {
@@ -73,3 +74,19 @@ func NamespaceNetworkRuleSetID(input string) (*NamespaceNetworkRuleSetId, error)
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "servicebus",
+		TypeName:           "NamespaceNetworkRuleSet",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/networkrulesets/networkRuleSet1",
+		ResourceTypes:      []string{"azurerm_servicebus_namespace_network_rule_set"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := NamespaceNetworkRuleSetID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type QueueId struct {
@@ -73,3 +74,19 @@ func QueueID(input string) (*QueueId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "servicebus",
+		TypeName:           "Queue",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/queues/queue1",
+		ResourceTypes:      []string{"azurerm_servicebus_queue"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := QueueID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
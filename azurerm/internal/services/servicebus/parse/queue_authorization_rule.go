@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type QueueAuthorizationRuleId struct {
@@ -79,3 +80,19 @@ func QueueAuthorizationRuleID(input string) (*QueueAuthorizationRuleId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "servicebus",
+		TypeName:           "QueueAuthorizationRule",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/queues/queue1/authorizationRules/authorizationRule1",
+		ResourceTypes:      []string{"azurerm_servicebus_queue_authorization_rule"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := QueueAuthorizationRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
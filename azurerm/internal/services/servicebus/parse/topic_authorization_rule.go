@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type TopicAuthorizationRuleId struct {
@@ -79,3 +80,19 @@ func TopicAuthorizationRuleID(input string) (*TopicAuthorizationRuleId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "servicebus",
+		TypeName:           "TopicAuthorizationRule",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/authorizationRules/authorizationRule1",
+		ResourceTypes:      []string{"azurerm_servicebus_topic_authorization_rule"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := TopicAuthorizationRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
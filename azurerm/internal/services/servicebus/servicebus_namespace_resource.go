@@ -151,7 +151,7 @@ func resourceServiceBusNamespaceCreateUpdate(d *schema.ResourceData, meta interf
 		SBNamespaceProperties: &servicebus.SBNamespaceProperties{
 			ZoneRedundant: utils.Bool(d.Get("zone_redundant").(bool)),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if capacity := d.Get("capacity"); capacity != nil {
@@ -220,7 +220,7 @@ func resourceServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("default_secondary_key", keys.SecondaryKey)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceServiceBusNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NetworkId struct {
@@ -67,3 +68,19 @@ func NetworkID(input string) (*NetworkId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "servicefabricmesh",
+		TypeName:           "Network",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/networks/network1",
+		ResourceTypes:      []string{"azurerm_service_fabric_mesh_local_network"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := NetworkID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SecretId struct {
@@ -67,3 +68,19 @@ func SecretID(input string) (*SecretId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "servicefabricmesh",
+		TypeName:           "Secret",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/secrets/secret1",
+		ResourceTypes:      []string{"azurerm_service_fabric_mesh_secret"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SecretID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
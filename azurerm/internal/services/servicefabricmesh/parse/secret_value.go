@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SecretValueId struct {
@@ -73,3 +74,19 @@ func SecretValueID(input string) (*SecretValueId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "servicefabricmesh",
+		TypeName:           "SecretValue",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/secrets/secret1/values/value1",
+		ResourceTypes:      []string{"azurerm_service_fabric_mesh_secret_value"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SecretValueID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -1,6 +1,6 @@
 package servicefabricmesh
 
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Application -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/applications/application1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Network -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/networks/network1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Secret -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/secrets/secret1
-//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SecretValue -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/secrets/secret1/values/value1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Application -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/applications/application1 -resource-types=azurerm_service_fabric_mesh_application
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Network -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/networks/network1 -resource-types=azurerm_service_fabric_mesh_local_network
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Secret -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/secrets/secret1 -resource-types=azurerm_service_fabric_mesh_secret
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SecretValue -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/secrets/secret1/values/value1 -resource-types=azurerm_service_fabric_mesh_secret_value
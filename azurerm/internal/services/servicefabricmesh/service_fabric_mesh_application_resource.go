@@ -175,7 +175,7 @@ func resourceServiceFabricMeshApplicationCreateUpdate(d *schema.ResourceData, me
 			Services: expandServiceFabricMeshApplicationServices(d.Get("service").(*schema.Set).List()),
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if _, err := client.Create(ctx, resourceGroup, name, parameters); err != nil {
@@ -246,7 +246,7 @@ func resourceServiceFabricMeshApplicationRead(d *schema.ResourceData, meta inter
 	if err := d.Set("service", flattenServiceFabricMeshApplicationServices(serviceResp.Values())); err != nil {
 		return fmt.Errorf("setting `service`: %+v", err)
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceServiceFabricMeshApplicationDelete(d *schema.ResourceData, meta interface{}) error {
@@ -100,7 +100,7 @@ func resourceServiceFabricMeshLocalNetworkCreateUpdate(d *schema.ResourceData, m
 			NetworkAddressPrefix: utils.String(d.Get("network_address_prefix").(string)),
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if _, err := client.Create(ctx, resourceGroup, name, parameters); err != nil {
@@ -153,7 +153,7 @@ func resourceServiceFabricMeshLocalNetworkRead(d *schema.ResourceData, meta inte
 	d.Set("network_address_prefix", props.NetworkAddressPrefix)
 	d.Set("description", props.Description)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceServiceFabricMeshLocalNetworkDelete(d *schema.ResourceData, meta interface{}) error {
@@ -101,7 +101,7 @@ func resourceServiceFabricMeshSecretCreateUpdate(d *schema.ResourceData, meta in
 			Kind:        servicefabricmesh.KindInlinedValue,
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if _, err := client.Create(ctx, resourceGroup, name, parameters); err != nil {
@@ -154,7 +154,7 @@ func resourceServiceFabricMeshSecretRead(d *schema.ResourceData, meta interface{
 	d.Set("content_type", props.ContentType)
 	d.Set("description", props.Description)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceServiceFabricMeshSecretDelete(d *schema.ResourceData, meta interface{}) error {
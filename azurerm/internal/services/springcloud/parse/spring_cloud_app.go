@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudAppId struct {
@@ -73,3 +74,19 @@ func SpringCloudAppID(input string) (*SpringCloudAppId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "springcloud",
+		TypeName:           "SpringCloudApp",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1/apps/app1",
+		ResourceTypes:      []string{"azurerm_spring_cloud_active_deployment", "azurerm_spring_cloud_app"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SpringCloudAppID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudDeploymentId struct {
@@ -79,3 +80,19 @@ func SpringCloudDeploymentID(input string) (*SpringCloudDeploymentId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "springcloud",
+		TypeName:           "SpringCloudDeployment",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1/apps/app1/deployments/deploy1",
+		ResourceTypes:      []string{"azurerm_spring_cloud_java_deployment"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SpringCloudDeploymentID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
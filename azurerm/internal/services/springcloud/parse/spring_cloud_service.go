@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudServiceId struct {
@@ -67,3 +68,19 @@ func SpringCloudServiceID(input string) (*SpringCloudServiceId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "springcloud",
+		TypeName:           "SpringCloudService",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1",
+		ResourceTypes:      []string{"azurerm_spring_cloud_service"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SpringCloudServiceID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -254,7 +254,7 @@ func resourceSpringCloudServiceCreate(d *schema.ResourceData, meta interface{})
 		Sku: &appplatform.Sku{
 			Name: utils.String(d.Get("sku_name").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	gitProperty, err := expandSpringCloudConfigServerGitProperty(d.Get("config_server_git_setting").([]interface{}))
@@ -307,12 +307,12 @@ func resourceSpringCloudServiceUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		model := appplatform.ServiceResource{
 			Sku: &appplatform.Sku{
 				Name: utils.String(d.Get("sku_name").(string)),
 			},
-			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+			Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 		}
 
 		future, err := client.Update(ctx, id.ResourceGroup, id.SpringName, model)
@@ -414,7 +414,7 @@ func resourceSpringCloudServiceRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceSpringCloudServiceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AzureActiveDirectoryAdministratorId struct {
@@ -73,3 +74,19 @@ func AzureActiveDirectoryAdministratorID(input string) (*AzureActiveDirectoryAdm
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "sql",
+		TypeName:           "AzureActiveDirectoryAdministrator",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.SQL/servers/server1/administrators/activeDirectory",
+		ResourceTypes:      []string{"azurerm_sql_active_directory_administrator"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := AzureActiveDirectoryAdministratorID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -398,7 +398,7 @@ func resourceSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) e
 			CreateMode:    sql.CreateMode(createMode),
 			ZoneRedundant: utils.Bool(zoneRedundant),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if v, ok := d.GetOk("source_database_id"); ok {
@@ -611,7 +611,7 @@ func resourceSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("failure in setting `extended_auditing_policy`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
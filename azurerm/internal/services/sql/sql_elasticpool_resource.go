@@ -128,7 +128,7 @@ func resourceSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interface{}
 		Name:                  &name,
 		Location:              &location,
 		ElasticPoolProperties: getArmSqlElasticPoolProperties(d),
-		Tags:                  tags.Expand(t),
+		Tags:                  meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resGroup, serverName, name, elasticPool)
@@ -215,7 +215,7 @@ func resourceSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("pool_size", storageMb)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceSqlElasticPoolDelete(d *schema.ResourceData, meta interface{}) error {
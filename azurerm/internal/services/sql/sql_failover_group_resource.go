@@ -167,7 +167,7 @@ func resourceSqlFailoverGroupCreateUpdate(d *schema.ResourceData, meta interface
 			ReadWriteEndpoint: expandSqlFailoverGroupReadWritePolicy(d),
 			PartnerServers:    expandSqlFailoverGroupPartnerServers(d),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if r, ok := d.Get("databases").(*schema.Set); ok && r.Len() > 0 {
@@ -245,7 +245,7 @@ func resourceSqlFailoverGroupRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceSqlFailoverGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EncryptionScopeId struct {
@@ -73,3 +74,19 @@ func EncryptionScopeID(input string) (*EncryptionScopeId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "storage",
+		TypeName:           "EncryptionScope",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/encryptionScopes/encryptionScope1",
+		ResourceTypes:      []string{"azurerm_storage_encryption_scope"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := EncryptionScopeID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
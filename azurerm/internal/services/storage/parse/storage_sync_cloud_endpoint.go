@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageSyncCloudEndpointId struct {
@@ -79,3 +80,19 @@ func StorageSyncCloudEndpointID(input string) (*StorageSyncCloudEndpointId, erro
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "storage",
+		TypeName:           "StorageSyncCloudEndpoint",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StorageSync/storageSyncServices/storageSyncService1/syncGroups/syncGroup1/cloudEndpoints/cloudEndpoint1",
+		ResourceTypes:      []string{"azurerm_storage_sync_cloud_endpoint"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := StorageSyncCloudEndpointID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
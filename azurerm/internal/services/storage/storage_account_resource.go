@@ -19,7 +19,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
+	networkParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
@@ -656,7 +656,7 @@ func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) erro
 		Sku: &storage.Sku{
 			Name: storage.SkuName(storageType),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 		Kind: storage.Kind(accountKind),
 		AccountPropertiesCreateParameters: &storage.AccountPropertiesCreateParameters{
 			EnableHTTPSTrafficOnly: &enableHTTPSTrafficOnly,
@@ -880,11 +880,11 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if d.HasChange("tags") {
+	if tags.HasChange(d) {
 		t := d.Get("tags").(map[string]interface{})
 
 		opts := storage.AccountUpdateParameters{
-			Tags: tags.Expand(t),
+			Tags: meta.(*clients.Client).Tags.Expand(t),
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
@@ -1301,7 +1301,7 @@ func resourceStorageAccountRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error setting `static_website `for AzureRM Storage Account %q: %+v", name, err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
@@ -1330,7 +1330,7 @@ func resourceStorageAccountDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	// the networking api's only allow a single change to be made to a network layout at once, so let's lock to handle that
-	virtualNetworkNames := make([]string, 0)
+	virtualNetworkIds := make([]locks.ResourceID, 0)
 	if props := read.AccountProperties; props != nil {
 		if rules := props.NetworkRuleSet; rules != nil {
 			if vnr := rules.VirtualNetworkRules; vnr != nil {
@@ -1339,25 +1339,21 @@ func resourceStorageAccountDelete(d *schema.ResourceData, meta interface{}) erro
 						continue
 					}
 
-					id, err2 := azure.ParseAzureResourceID(*v.VirtualNetworkResourceID)
+					// the Virtual Network Resource ID is the ID of a Subnet
+					id, err2 := networkParse.SubnetIDInsensitively(*v.VirtualNetworkResourceID)
 					if err2 != nil {
 						return err2
 					}
 
-					networkName := id.Path["virtualNetworks"]
-					for _, virtualNetworkName := range virtualNetworkNames {
-						if networkName == virtualNetworkName {
-							continue
-						}
-					}
-					virtualNetworkNames = append(virtualNetworkNames, networkName)
+					// duplicates are ignored when locking
+					virtualNetworkIds = append(virtualNetworkIds, networkParse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName))
 				}
 			}
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	locks.MultipleByResourceID(virtualNetworkIds...)
+	defer locks.UnlockMultipleByResourceID(virtualNetworkIds...)
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type OutputId struct {
@@ -73,3 +74,19 @@ func OutputID(input string) (*OutputId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "streamanalytics",
+		TypeName:           "Output",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/streamingJob1/outputs/output1",
+		ResourceTypes:      []string{"azurerm_stream_analytics_output_blob", "azurerm_stream_analytics_output_eventhub", "azurerm_stream_analytics_output_mssql", "azurerm_stream_analytics_output_servicebus_queue", "azurerm_stream_analytics_output_servicebus_topic"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := OutputID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StreamInputId struct {
@@ -73,3 +74,19 @@ func StreamInputID(input string) (*StreamInputId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "streamanalytics",
+		TypeName:           "StreamInput",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/streamingJob1/inputs/streamInput1",
+		ResourceTypes:      []string{"azurerm_stream_analytics_reference_input_blob", "azurerm_stream_analytics_stream_input_blob", "azurerm_stream_analytics_stream_input_eventhub", "azurerm_stream_analytics_stream_input_iothub"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := StreamInputID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StreamingJobId struct {
@@ -67,3 +68,19 @@ func StreamingJobID(input string) (*StreamingJobId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "streamanalytics",
+		TypeName:           "StreamingJob",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/streamingJob1",
+		ResourceTypes:      []string{"azurerm_stream_analytics_job"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := StreamingJobID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
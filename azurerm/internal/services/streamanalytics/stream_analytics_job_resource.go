@@ -184,7 +184,7 @@ func resourceStreamAnalyticsJobCreateUpdate(d *schema.ResourceData, meta interfa
 			EventsOutOfOrderPolicy:             streamanalytics.EventsOutOfOrderPolicy(eventsOutOfOrderPolicy),
 			OutputErrorPolicy:                  streamanalytics.OutputErrorPolicy(outputErrorPolicy),
 		},
-		Tags: tags.Expand(t),
+		Tags: meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if dataLocale, ok := d.GetOk("data_locale"); ok {
@@ -283,7 +283,7 @@ func resourceStreamAnalyticsJobRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceStreamAnalyticsJobDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ManagedPrivateEndpointId struct {
@@ -79,3 +80,19 @@ func ManagedPrivateEndpointID(input string) (*ManagedPrivateEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "synapse",
+		TypeName:           "ManagedPrivateEndpoint",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/managedVirtualNetworks/default/managedPrivateEndpoints/endpoint1",
+		ResourceTypes:      []string{"azurerm_synapse_managed_private_endpoint"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := ManagedPrivateEndpointID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -200,7 +200,7 @@ func resourceSynapseSqlPoolCreate(d *schema.ResourceData, meta interface{}) erro
 		Sku: &synapse.Sku{
 			Name: utils.String(d.Get("sku_name").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	switch mode {
@@ -282,12 +282,12 @@ func resourceSynapseSqlPoolUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if d.HasChanges("sku_name", "tags") {
+	if d.HasChanges("sku_name", "tags", "tags_all") {
 		sqlPoolInfo := synapse.SQLPoolPatchInfo{
 			Sku: &synapse.Sku{
 				Name: utils.String(d.Get("sku_name").(string)),
 			},
-			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+			Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 		}
 
 		if _, err := sqlClient.Update(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, sqlPoolInfo); err != nil {
@@ -361,7 +361,7 @@ func resourceSynapseSqlPoolRead(d *schema.ResourceData, meta interface{}) error
 	// whole "restore" block is not returned. to avoid conflict, so set it from the old state
 	d.Set("restore", d.Get("restore").([]interface{}))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceSynapseSqlPoolDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AzureEndpointId struct {
@@ -73,3 +74,19 @@ func AzureEndpointID(input string) (*AzureEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "trafficmanager",
+		TypeName:           "AzureEndpoint",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/trafficManagerProfile1/azureEndpoints/azureEndpoint1",
+		ResourceTypes:      []string{"azurerm_traffic_manager_endpoint"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := AzureEndpointID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
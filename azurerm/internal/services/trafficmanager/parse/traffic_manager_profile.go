@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type TrafficManagerProfileId struct {
@@ -67,3 +68,19 @@ func TrafficManagerProfileID(input string) (*TrafficManagerProfileId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "trafficmanager",
+		TypeName:           "TrafficManagerProfile",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/trafficManagerProfile1",
+		ResourceTypes:      []string{"azurerm_traffic_manager_profile"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := TrafficManagerProfileID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
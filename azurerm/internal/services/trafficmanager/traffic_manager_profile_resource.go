@@ -220,7 +220,7 @@ func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interfa
 			DNSConfig:            expandArmTrafficManagerDNSConfig(d),
 			MonitorConfig:        expandArmTrafficManagerMonitorConfig(d),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if maxReturn, ok := d.GetOk("max_return"); ok {
@@ -289,7 +289,7 @@ func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface
 			d.Set("fqdn", dns.Fqdn)
 		}
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceArmTrafficManagerProfileUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -305,8 +305,8 @@ func resourceArmTrafficManagerProfileUpdate(d *schema.ResourceData, meta interfa
 	update := trafficmanager.Profile{
 		ProfileProperties: &trafficmanager.ProfileProperties{},
 	}
-	if d.HasChange("tags") {
-		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		update.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	if d.HasChange("profile_status") {
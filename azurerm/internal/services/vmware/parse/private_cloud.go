@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PrivateCloudId struct {
@@ -67,3 +68,19 @@ func PrivateCloudID(input string) (*PrivateCloudId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "vmware",
+		TypeName:           "PrivateCloud",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AVS/privateClouds/privateCloud1",
+		ResourceTypes:      []string{"azurerm_vmware_private_cloud"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := PrivateCloudID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -230,7 +230,7 @@ func resourceVmwarePrivateCloudCreate(d *schema.ResourceData, meta interface{})
 			NsxtPassword:    utils.String(d.Get("nsxt_password").(string)),
 			VcenterPassword: utils.String(d.Get("vcenter_password").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, privateCloud)
@@ -293,7 +293,7 @@ func resourceVmwarePrivateCloudRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("sku_name", sku.Name)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceVmwarePrivateCloudUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -328,8 +328,8 @@ func resourceVmwarePrivateCloudUpdate(d *schema.ResourceData, meta interface{})
 		privateCloudUpdate.PrivateCloudUpdateProperties.Internet = internet
 	}
 
-	if d.HasChange("tags") {
-		privateCloudUpdate.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if tags.HasChange(d) {
+		privateCloudUpdate.Tags = meta.(*clients.Client).Tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.Name, privateCloudUpdate)
@@ -221,7 +221,7 @@ func resourceAppServiceCertificateOrderCreateUpdate(d *schema.ResourceData, meta
 	certificateOrder := web.AppServiceCertificateOrder{
 		AppServiceCertificateOrderProperties: &properties,
 		Location:                             utils.String(location),
-		Tags:                                 tags.Expand(t),
+		Tags:                                 meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, certificateOrder)
@@ -310,7 +310,7 @@ func resourceAppServiceCertificateOrderRead(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceAppServiceCertificateOrderDelete(d *schema.ResourceData, meta interface{}) error {
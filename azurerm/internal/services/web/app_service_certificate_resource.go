@@ -164,7 +164,7 @@ func resourceAppServiceCertificateCreateUpdate(d *schema.ResourceData, meta inte
 			Password: utils.String(password),
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 	}
 
 	if len(hostingEnvironmentProfileId) > 0 {
@@ -259,7 +259,7 @@ func resourceAppServiceCertificateRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceAppServiceCertificateDelete(d *schema.ResourceData, meta interface{}) error {
@@ -174,7 +174,7 @@ func resourceAppServicePlanCreateUpdate(d *schema.ResourceData, meta interface{}
 		Location:                 &location,
 		Kind:                     &kind,
 		Sku:                      &sku,
-		Tags:                     tags.Expand(t),
+		Tags:                     meta.(*clients.Client).Tags.Expand(t),
 		AppServicePlanProperties: properties,
 	}
 
@@ -291,7 +291,7 @@ func resourceAppServicePlanRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error setting `sku`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceAppServicePlanDelete(d *schema.ResourceData, meta interface{}) error {
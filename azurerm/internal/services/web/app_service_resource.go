@@ -265,7 +265,7 @@ func resourceAppServiceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	siteEnvelope := web.Site{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID: utils.String(appServicePlanId),
 			Enabled:      utils.Bool(enabled),
@@ -381,7 +381,7 @@ func resourceAppServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	siteEnvelope := web.Site{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID: utils.String(appServicePlanId),
 			Enabled:      utils.Bool(enabled),
@@ -733,7 +733,7 @@ func resourceAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting `identity`: %s", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceAppServiceDelete(d *schema.ResourceData, meta interface{}) error {
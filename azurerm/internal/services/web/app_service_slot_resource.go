@@ -199,7 +199,7 @@ func resourceAppServiceSlotCreateUpdate(d *schema.ResourceData, meta interface{}
 	}
 	siteEnvelope := web.Site{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID:          utils.String(appServicePlanId),
 			Enabled:               utils.Bool(enabled),
@@ -261,7 +261,7 @@ func resourceAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	siteEnvelope := web.Site{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID: utils.String(appServicePlanId),
 			Enabled:      utils.Bool(enabled),
@@ -498,7 +498,7 @@ func resourceAppServiceSlotRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error setting `site_config`: %s", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceAppServiceSlotDelete(d *schema.ResourceData, meta interface{}) error {
@@ -9,7 +9,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	subnetParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/parse"
@@ -73,7 +72,6 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate(d *schema.R
 
 	resourceGroup := appID.ResourceGroup
 	name := appID.SiteName
-	subnetName := subnetID.Name
 	virtualNetworkName := subnetID.VirtualNetworkName
 	slotName := d.Get("slot_name").(string)
 
@@ -90,11 +88,9 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate(d *schema.R
 		}
 	}
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	virtualNetworkId := subnetParse.NewVirtualNetworkID(subnetID.SubscriptionId, subnetID.ResourceGroup, virtualNetworkName)
+	locks.MultipleByResourceID(virtualNetworkId, *subnetID)
+	defer locks.UnlockMultipleByResourceID(virtualNetworkId, *subnetID)
 
 	appServiceExists, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -194,14 +190,11 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionDelete(d *schema.Resourc
 	if err != nil {
 		return fmt.Errorf("Error parsing Subnet Resource ID %q", subnetID)
 	}
-	subnetName := subnetID.Name
 	virtualNetworkName := subnetID.VirtualNetworkName
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	virtualNetworkId := subnetParse.NewVirtualNetworkID(subnetID.SubscriptionId, subnetID.ResourceGroup, virtualNetworkName)
+	locks.MultipleByResourceID(virtualNetworkId, *subnetID)
+	defer locks.UnlockMultipleByResourceID(virtualNetworkId, *subnetID)
 
 	read, err := client.GetSwiftVirtualNetworkConnectionSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
 	if err != nil {
@@ -10,7 +10,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	subnetParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
@@ -67,7 +66,6 @@ func resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *schema.Resou
 
 	resourceGroup := appID.ResourceGroup
 	name := appID.SiteName
-	subnetName := subnetID.Name
 	virtualNetworkName := subnetID.VirtualNetworkName
 
 	if d.IsNewResource() {
@@ -83,11 +81,9 @@ func resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *schema.Resou
 		}
 	}
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	virtualNetworkId := subnetParse.NewVirtualNetworkID(subnetID.SubscriptionId, subnetID.ResourceGroup, virtualNetworkName)
+	locks.MultipleByResourceID(virtualNetworkId, *subnetID)
+	defer locks.UnlockMultipleByResourceID(virtualNetworkId, *subnetID)
 
 	exists, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -170,14 +166,11 @@ func resourceAppServiceVirtualNetworkSwiftConnectionDelete(d *schema.ResourceDat
 	if err != nil {
 		return fmt.Errorf("Error parsing Subnet Resource ID %q", subnetID)
 	}
-	subnetName := subnetID.Name
 	virtualNetworkName := subnetID.VirtualNetworkName
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	virtualNetworkId := subnetParse.NewVirtualNetworkID(subnetID.SubscriptionId, subnetID.ResourceGroup, virtualNetworkName)
+	locks.MultipleByResourceID(virtualNetworkId, *subnetID)
+	defer locks.UnlockMultipleByResourceID(virtualNetworkId, *subnetID)
 
 	read, err := client.GetSwiftVirtualNetworkConnection(ctx, id.ResourceGroup, id.SiteName)
 	if err != nil {
@@ -310,7 +310,7 @@ func resourceFunctionAppCreate(d *schema.ResourceData, meta interface{}) error {
 	siteEnvelope := web.Site{
 		Kind:     &kind,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID:          utils.String(appServicePlanID),
 			Enabled:               utils.Bool(enabled),
@@ -423,7 +423,7 @@ func resourceFunctionAppUpdate(d *schema.ResourceData, meta interface{}) error {
 	siteEnvelope := web.Site{
 		Kind:     &kind,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     meta.(*clients.Client).Tags.Expand(t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID:          utils.String(appServicePlanID),
 			Enabled:               utils.Bool(enabled),
@@ -702,7 +702,7 @@ func resourceFunctionAppRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return meta.(*clients.Client).Tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceFunctionAppDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AppServicePlanId struct {
@@ -67,3 +68,19 @@ func AppServicePlanID(input string) (*AppServicePlanId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "web",
+		TypeName:           "AppServicePlan",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/serverfarms/farm1",
+		ResourceTypes:      []string{"azurerm_app_service_plan"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := AppServicePlanID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
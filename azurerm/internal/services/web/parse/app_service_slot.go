@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AppServiceSlotId struct {
@@ -73,3 +74,19 @@ func AppServiceSlotID(input string) (*AppServiceSlotId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "web",
+		TypeName:           "AppServiceSlot",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1",
+		ResourceTypes:      []string{"azurerm_app_service_slot"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := AppServiceSlotID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FunctionAppId struct {
@@ -67,3 +68,19 @@ func FunctionAppID(input string) (*FunctionAppId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "web",
+		TypeName:           "FunctionApp",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1",
+		ResourceTypes:      []string{"azurerm_function_app"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := FunctionAppID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HostnameBindingId struct {
@@ -73,3 +74,19 @@ func HostnameBindingID(input string) (*HostnameBindingId, error) {
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "web",
+		TypeName:           "HostnameBinding",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/mygroup1/providers/Microsoft.Web/sites/site1/hostNameBindings/binding1",
+		ResourceTypes:      []string{"azurerm_app_service_custom_hostname_binding"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := HostnameBindingID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
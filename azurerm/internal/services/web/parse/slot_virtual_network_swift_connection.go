@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SlotVirtualNetworkSwiftConnectionId struct {
@@ -79,3 +80,19 @@ func SlotVirtualNetworkSwiftConnectionID(input string) (*SlotVirtualNetworkSwift
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "web",
+		TypeName:           "SlotVirtualNetworkSwiftConnection",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/config/virtualNetwork",
+		ResourceTypes:      []string{"azurerm_app_service_slot_virtual_network_swift_connection"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := SlotVirtualNetworkSwiftConnectionID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkSwiftConnectionId struct {
@@ -73,3 +74,19 @@ func VirtualNetworkSwiftConnectionID(input string) (*VirtualNetworkSwiftConnecti
 
 	return &resourceId, nil
 }
+
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "web",
+		TypeName:           "VirtualNetworkSwiftConnection",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/config/virtualNetwork",
+		ResourceTypes:      []string{"azurerm_app_service_virtual_network_swift_connection"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := VirtualNetworkSwiftConnectionID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
@@ -0,0 +1,83 @@
+package snapshot
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: the files within ./data are generated - manual changes will be lost. To refresh these,
+// run the generator in ./azurerm/internal/tools/generator-validation-snapshot
+
+// Version is the version of the format of the Snapshot files, which is incremented when the format changes
+const Version = 1
+
+// Snapshot is a point-in-time copy of the Azure Locations and Resource Providers available within
+// an Azure Environment, which is bundled with the Provider and used for Enhanced Validation when
+// these can't be (or shouldn't be) retrieved from Azure
+type Snapshot struct {
+	// Version is the version of the format of this Snapshot
+	Version int `json:"version"`
+
+	// Environment is the name of the Azure Environment, e.g. `AzurePublicCloud`
+	Environment string `json:"environment"`
+
+	// GeneratedOn is the date on which this Snapshot was generated, in the format `2006-01-02`
+	GeneratedOn string `json:"generatedOn"`
+
+	// Locations are the (normalized) names of the Azure Locations available within this Azure Environment
+	Locations []string `json:"locations"`
+
+	// ResourceProviders are the namespaces of the Resource Providers available within this Azure Environment
+	ResourceProviders []string `json:"resourceProviders"`
+}
+
+//go:embed data/*.json
+var files embed.FS
+
+var fileNames = map[string]string{
+	azure.PublicCloud.Name:       "public.json",
+	azure.USGovernmentCloud.Name: "usgovernment.json",
+	azure.ChinaCloud.Name:        "china.json",
+	azure.GermanCloud.Name:       "german.json",
+}
+
+// FileName returns the name of the Snapshot file for the specified Azure Environment, and whether
+// a Snapshot is available for this Azure Environment
+func FileName(environmentName string) (string, bool) {
+	for name, fileName := range fileNames {
+		if strings.EqualFold(name, environmentName) {
+			return fileName, true
+		}
+	}
+
+	return "", false
+}
+
+// ForEnvironment returns the Snapshot bundled with the Provider for the specified Azure Environment
+func ForEnvironment(environmentName string) (*Snapshot, error) {
+	fileName, ok := FileName(environmentName)
+	if !ok {
+		return nil, fmt.Errorf("a Snapshot is not available for the Azure Environment %q", environmentName)
+	}
+
+	contents, err := files.ReadFile(path.Join("data", fileName))
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", fileName, err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(contents, &snapshot); err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", fileName, err)
+	}
+
+	if snapshot.Version != Version {
+		return nil, fmt.Errorf("the Snapshot %q is version %d but version %d is required - please regenerate it", fileName, snapshot.Version, Version)
+	}
+
+	return &snapshot, nil
+}
@@ -0,0 +1,49 @@
+package snapshot
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestForEnvironment(t *testing.T) {
+	for environmentName := range fileNames {
+		t.Logf("[DEBUG] Testing %q..", environmentName)
+
+		snapshot, err := ForEnvironment(environmentName)
+		if err != nil {
+			t.Fatalf("loading Snapshot: %+v", err)
+		}
+
+		if snapshot.Environment != environmentName {
+			t.Fatalf("expected the Environment to be %q but got %q", environmentName, snapshot.Environment)
+		}
+		if len(snapshot.Locations) == 0 {
+			t.Fatalf("expected some Locations but got none")
+		}
+		if len(snapshot.ResourceProviders) == 0 {
+			t.Fatalf("expected some Resource Providers but got none")
+		}
+		if !sort.StringsAreSorted(snapshot.Locations) || !sort.StringsAreSorted(snapshot.ResourceProviders) {
+			t.Fatalf("expected the Locations and Resource Providers to be sorted")
+		}
+	}
+}
+
+func TestForEnvironmentCaseInsensitive(t *testing.T) {
+	snapshot, err := ForEnvironment("azurepubliccloud")
+	if err != nil {
+		t.Fatalf("loading Snapshot: %+v", err)
+	}
+
+	if snapshot.Environment != azure.PublicCloud.Name {
+		t.Fatalf("expected the Environment to be %q but got %q", azure.PublicCloud.Name, snapshot.Environment)
+	}
+}
+
+func TestForEnvironmentUnsupported(t *testing.T) {
+	if _, err := ForEnvironment("AzureStackCloud"); err == nil {
+		t.Fatalf("expected an error for an unsupported Environment but didn't get one")
+	}
+}
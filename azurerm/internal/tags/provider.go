@@ -0,0 +1,161 @@
+package tags
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ProviderConfig is the configuration for Tags defined within the Provider block, which applies
+// to every Resource which supports Tags. This is available on the Client, since each Provider
+// block (including any aliases) can define different Default/Ignored Tags.
+type ProviderConfig struct {
+	// DefaultTags are merged into the Tags sent to Azure for every Resource, unless a Tag with
+	// the same key is defined on the Resource itself
+	DefaultTags map[string]string
+
+	// IgnoreKeys are the keys of Tags which should be ignored when reading Resources (for example
+	// because they're managed outside of Terraform)
+	IgnoreKeys []string
+
+	// IgnoreKeyPrefixes are the prefixes of the keys of Tags which should be ignored when reading
+	// Resources (for example because they're managed outside of Terraform)
+	IgnoreKeyPrefixes []string
+}
+
+// PlanAll plans the new value for `tags_all` (all of the Tags, including the Default Tags) during CustomizeDiff,
+// so that changes to the Default Tags are applied to existing Resources. Where changes to the Tags can't be
+// applied in-place (`forceNew`) changes to `tags_all` require the Resource to be recreated.
+func (c ProviderConfig) PlanAll(d *schema.ResourceDiff, forceNew bool) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	configured := d.Get("tags").(map[string]interface{})
+	_, expected := c.Flatten(configured, c.Expand(configured))
+	if reflect.DeepEqual(d.Get("tags_all"), expected) {
+		return nil
+	}
+
+	if err := d.SetNew("tags_all", expected); err != nil {
+		return err
+	}
+
+	if forceNew && d.Id() != "" {
+		return d.ForceNew("tags_all")
+	}
+
+	return nil
+}
+
+// Expand returns the Tags which should be sent to Azure for a Resource, which are the Tags defined
+// on the Resource merged with the Default Tags
+func (c ProviderConfig) Expand(tagsMap map[string]interface{}) map[string]*string {
+	output := Expand(tagsMap)
+
+	for k, v := range c.DefaultTags {
+		if _, exists := lookupKey(tagsMap, k); exists {
+			continue
+		}
+
+		value := v
+		output[k] = &value
+	}
+
+	return output
+}
+
+// Flatten returns the Tags which should be set into the `tags` and `tags_all` fields for a Resource,
+// from the Tags returned from Azure (tagMap) and the Tags defined on the Resource (tagsMap).
+//
+// Both exclude any ignored Tags - however `tags` also excludes any Default Tags which haven't been
+// defined on the Resource (and have the default value), so that these don't show as a diff.
+func (c ProviderConfig) Flatten(tagsMap map[string]interface{}, tagMap map[string]*string) (map[string]interface{}, map[string]interface{}) {
+	all := make(map[string]interface{}, len(tagMap))
+	for k, v := range Flatten(tagMap) {
+		if c.ignored(k) {
+			continue
+		}
+
+		all[k] = v
+	}
+
+	output := make(map[string]interface{}, len(all))
+	for k, v := range all {
+		if _, configured := lookupKey(tagsMap, k); !configured {
+			if defaultValue, isDefault := lookupDefault(c.DefaultTags, k); isDefault && defaultValue == v {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+
+	return output, all
+}
+
+// FlattenAndSet sets the Tags returned from Azure into the State. For Resources which support the `default_tags`
+// defined in the Provider block (those with a `tags_all` field) `tags` only contains the Tags defined on the
+// Resource, whilst `tags_all` contains all of the Tags - both of which exclude any `ignore_tags`
+func (c ProviderConfig) FlattenAndSet(d *schema.ResourceData, tagMap map[string]*string) error {
+	// reading a field which isn't defined in the Schema returns nil, rather than the zero value
+	if d.Get("tags_all") == nil {
+		return FlattenAndSet(d, tagMap)
+	}
+
+	configured, _ := d.Get("tags").(map[string]interface{})
+	flattened, all := c.Flatten(configured, tagMap)
+	if err := d.Set("tags", flattened); err != nil {
+		return fmt.Errorf("Error setting `tags`: %s", err)
+	}
+	if err := d.Set("tags_all", all); err != nil {
+		return fmt.Errorf("Error setting `tags_all`: %s", err)
+	}
+
+	return nil
+}
+
+// HasChange returns whether the Tags for a Resource have changed - which includes changes to the Default
+// Tags defined in the Provider block, which are planned into `tags_all`
+func HasChange(d *schema.ResourceData) bool {
+	return d.HasChange("tags") || d.HasChange("tags_all")
+}
+
+func (c ProviderConfig) ignored(key string) bool {
+	for _, v := range c.IgnoreKeys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range c.IgnoreKeyPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// keys for Tags are case-insensitive in Azure
+func lookupKey(tagsMap map[string]interface{}, key string) (interface{}, bool) {
+	for k, v := range tagsMap {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+func lookupDefault(defaultTags map[string]string, key string) (string, bool) {
+	for k, v := range defaultTags {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,297 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestProviderConfigExpand(t *testing.T) {
+	config := ProviderConfig{
+		DefaultTags: map[string]string{
+			"cost-center": "1234",
+			"env":         "production",
+		},
+	}
+
+	expanded := config.Expand(map[string]interface{}{
+		"ENV":  "staging",
+		"team": "networking",
+	})
+
+	actual := Flatten(expanded)
+	expected := map[string]interface{}{
+		"cost-center": "1234",
+		"ENV":         "staging",
+		"team":        "networking",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestProviderConfigFlatten(t *testing.T) {
+	config := ProviderConfig{
+		DefaultTags: map[string]string{
+			"cost-center": "1234",
+			"env":         "production",
+			"owner":       "platform",
+		},
+		IgnoreKeys:        []string{"CreatedBy"},
+		IgnoreKeyPrefixes: []string{"hidden-"},
+	}
+
+	testData := []struct {
+		Name        string
+		Configured  map[string]interface{}
+		Azure       map[string]interface{}
+		ExpectedTag map[string]interface{}
+		ExpectedAll map[string]interface{}
+	}{
+		{
+			Name:       "Default Tags are excluded",
+			Configured: map[string]interface{}{},
+			Azure: map[string]interface{}{
+				"cost-center": "1234",
+				"env":         "production",
+				"team":        "networking",
+			},
+			ExpectedTag: map[string]interface{}{
+				"team": "networking",
+			},
+			ExpectedAll: map[string]interface{}{
+				"cost-center": "1234",
+				"env":         "production",
+				"team":        "networking",
+			},
+		},
+		{
+			Name: "Default Tags defined on the Resource are included",
+			Configured: map[string]interface{}{
+				"env": "production",
+			},
+			Azure: map[string]interface{}{
+				"cost-center": "1234",
+				"env":         "production",
+			},
+			ExpectedTag: map[string]interface{}{
+				"env": "production",
+			},
+			ExpectedAll: map[string]interface{}{
+				"cost-center": "1234",
+				"env":         "production",
+			},
+		},
+		{
+			Name:       "Default Tags changed outside of Terraform are included",
+			Configured: map[string]interface{}{},
+			Azure: map[string]interface{}{
+				"owner": "someone-else",
+			},
+			ExpectedTag: map[string]interface{}{
+				"owner": "someone-else",
+			},
+			ExpectedAll: map[string]interface{}{
+				"owner": "someone-else",
+			},
+		},
+		{
+			Name:       "Ignored Tags are excluded",
+			Configured: map[string]interface{}{},
+			Azure: map[string]interface{}{
+				"createdby":      "policy",
+				"hidden-link":    "abc123",
+				"Hidden-Another": "def456",
+				"team":           "networking",
+			},
+			ExpectedTag: map[string]interface{}{
+				"team": "networking",
+			},
+			ExpectedAll: map[string]interface{}{
+				"team": "networking",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actualTags, actualAll := config.Flatten(v.Configured, Expand(v.Azure))
+		if !reflect.DeepEqual(actualTags, v.ExpectedTag) {
+			t.Fatalf("expected `tags` to be %+v but got %+v", v.ExpectedTag, actualTags)
+		}
+		if !reflect.DeepEqual(actualAll, v.ExpectedAll) {
+			t.Fatalf("expected `tags_all` to be %+v but got %+v", v.ExpectedAll, actualAll)
+		}
+	}
+}
+
+func TestExpandAndFlattenAndSetWithProviderConfig(t *testing.T) {
+	config := ProviderConfig{
+		DefaultTags: map[string]string{
+			"cost-center": "1234",
+		},
+		IgnoreKeys: []string{"created-by"},
+	}
+
+	configured := map[string]interface{}{
+		"env": "test",
+	}
+	azure := config.Expand(configured)
+	expectedAll := map[string]interface{}{
+		"cost-center": "1234",
+		"env":         "test",
+	}
+	if actual := Flatten(azure); !reflect.DeepEqual(actual, expectedAll) {
+		t.Fatalf("expected the Default Tags to be expanded, got %+v", actual)
+	}
+	if actual := Flatten(Expand(configured)); !reflect.DeepEqual(actual, configured) {
+		t.Fatalf("expected the Default Tags not to be expanded, got %+v", actual)
+	}
+
+	createdBy := "policy"
+	azure["created-by"] = &createdBy
+
+	// Resources supporting the Default Tags only include the Tags defined on the Resource in `tags`
+	resource := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"tags":     Schema(),
+		"tags_all": SchemaAll(),
+	}, map[string]interface{}{
+		"tags": configured,
+	})
+	if err := config.FlattenAndSet(resource, azure); err != nil {
+		t.Fatalf("flattening: %+v", err)
+	}
+	if actual := resource.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, configured) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", configured, actual)
+	}
+	if actual := resource.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedAll) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expectedAll, actual)
+	}
+
+	// otherwise (e.g. for Data Sources) all of the Tags are returned
+	dataSource := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"tags": SchemaDataSource(),
+	}, map[string]interface{}{})
+	if err := config.FlattenAndSet(dataSource, azure); err != nil {
+		t.Fatalf("flattening: %+v", err)
+	}
+	if actual := dataSource.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, Flatten(azure)) {
+		t.Fatalf("expected `tags` to contain all of the Tags, got %+v", actual)
+	}
+}
+
+func TestHasChange(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Schema   map[string]*schema.Schema
+		Old      map[string]string
+		New      map[string]string
+		Expected bool
+	}{
+		{
+			Name: "No Changes",
+			Schema: map[string]*schema.Schema{
+				"tags":     Schema(),
+				"tags_all": SchemaAll(),
+			},
+			Old: map[string]string{
+				"tags.%":       "1",
+				"tags.env":     "test",
+				"tags_all.%":   "1",
+				"tags_all.env": "test",
+			},
+			New: map[string]string{
+				"tags.%":       "1",
+				"tags.env":     "test",
+				"tags_all.%":   "1",
+				"tags_all.env": "test",
+			},
+			Expected: false,
+		},
+		{
+			Name: "Tags Changed",
+			Schema: map[string]*schema.Schema{
+				"tags":     Schema(),
+				"tags_all": SchemaAll(),
+			},
+			Old: map[string]string{
+				"tags.%":       "1",
+				"tags.env":     "test",
+				"tags_all.%":   "1",
+				"tags_all.env": "test",
+			},
+			New: map[string]string{
+				"tags.%":       "1",
+				"tags.env":     "prod",
+				"tags_all.%":   "1",
+				"tags_all.env": "prod",
+			},
+			Expected: true,
+		},
+		{
+			Name: "Default Tags Changed",
+			Schema: map[string]*schema.Schema{
+				"tags":     Schema(),
+				"tags_all": SchemaAll(),
+			},
+			Old: map[string]string{
+				"tags.%":       "1",
+				"tags.env":     "test",
+				"tags_all.%":   "1",
+				"tags_all.env": "test",
+			},
+			New: map[string]string{
+				"tags.%":               "1",
+				"tags.env":             "test",
+				"tags_all.%":           "2",
+				"tags_all.env":         "test",
+				"tags_all.cost-center": "1234",
+			},
+			Expected: true,
+		},
+		{
+			Name: "No Default Tags Support",
+			Schema: map[string]*schema.Schema{
+				"tags": Schema(),
+			},
+			Old: map[string]string{
+				"tags.%":   "1",
+				"tags.env": "test",
+			},
+			New: map[string]string{
+				"tags.%":   "1",
+				"tags.env": "test",
+			},
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		diff := &terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{},
+		}
+		for k, newValue := range v.New {
+			if v.Old[k] == newValue {
+				continue
+			}
+			diff.Attributes[k] = &terraform.ResourceAttrDiff{
+				Old: v.Old[k],
+				New: newValue,
+			}
+		}
+
+		d, err := schema.InternalMap(v.Schema).Data(&terraform.InstanceState{ID: "example", Attributes: v.Old}, diff)
+		if err != nil {
+			t.Fatalf("building ResourceData: %+v", err)
+		}
+
+		if actual := HasChange(d); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
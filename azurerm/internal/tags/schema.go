@@ -41,6 +41,18 @@ func Schema() *schema.Schema {
 	}
 }
 
+// SchemaAll returns the Schema used for `tags_all` - which are all of the Tags assigned to a
+// Resource, including any Default Tags defined in the Provider block
+func SchemaAll() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
 // Schema returns the Schema used for Tags
 func SchemaEnforceLowerCaseKeys() *schema.Schema {
 	return &schema.Schema{
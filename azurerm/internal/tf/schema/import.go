@@ -3,8 +3,11 @@ package schema
 import (
 	"fmt"
 	"log"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 // ResourceIDValidator takes a Resource ID and confirms that it's Valid
@@ -21,15 +24,57 @@ func ValidateResourceIDPriorToImport(idParser ResourceIDValidator) *schema.Resou
 // valid for this Resource prior to calling the importer - allowing for incorrect
 // Resource ID's to be caught prior to Import and subsequent crashes
 func ValidateResourceIDPriorToImportThen(idParser ResourceIDValidator, importer schema.StateFunc) *schema.ResourceImporter {
-	return &schema.ResourceImporter{
+	resourceImporter := &schema.ResourceImporter{
 		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
 
 			if err := idParser(d.Id()); err != nil {
-				return []*schema.ResourceData{d}, fmt.Errorf("Error parsing Resource ID %q: %+v", d.Id(), err)
+				return []*schema.ResourceData{d}, fmt.Errorf("Error parsing Resource ID %q: %+v%s", d.Id(), err, resourceIDMatchesHint(d.Id()))
 			}
 
 			return importer(d, meta)
 		},
 	}
+
+	importerIDValidatorsLock.Lock()
+	importerIDValidators[resourceImporter] = idParser
+	importerIDValidatorsLock.Unlock()
+
+	return resourceImporter
+}
+
+var (
+	importerIDValidators     = make(map[*schema.ResourceImporter]ResourceIDValidator)
+	importerIDValidatorsLock = &sync.RWMutex{}
+)
+
+// ResourceIDValidatorForImporter returns the Resource ID Validator used by the specified Importer, if the
+// Importer was built using ValidateResourceIDPriorToImport (or ValidateResourceIDPriorToImportThen) - which
+// allows a Resource ID to be matched to the Resource(s) it could be imported into
+func ResourceIDValidatorForImporter(importer *schema.ResourceImporter) (ResourceIDValidator, bool) {
+	if importer == nil {
+		return nil, false
+	}
+
+	importerIDValidatorsLock.RLock()
+	defer importerIDValidatorsLock.RUnlock()
+
+	validator, ok := importerIDValidators[importer]
+	return validator, ok
+}
+
+// resourceIDMatchesHint returns a hint listing the Resource(s) which the Resource ID is valid for, for use
+// in an error message when the Resource ID isn't valid for the Resource being imported
+func resourceIDMatchesHint(resourceId string) string {
+	resourceTypes := resourceid.ResourceTypesForID(resourceId)
+	if len(resourceTypes) == 0 {
+		return ""
+	}
+
+	quoted := make([]string, 0)
+	for _, resourceType := range resourceTypes {
+		quoted = append(quoted, fmt.Sprintf("`%s`", resourceType))
+	}
+
+	return fmt.Sprintf("\n\nThis looks like the ID of a %s - please ensure that this ID is being imported into the correct Resource", strings.Join(quoted, " / "))
 }
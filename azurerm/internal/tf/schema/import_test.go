@@ -3,9 +3,11 @@ package schema
 import (
 	"fmt"
 	"log"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func TestValidateResourceIDPriorToImport(t *testing.T) {
@@ -45,3 +47,60 @@ func TestValidateResourceIDPriorToImport(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceIDValidatorForImporter(t *testing.T) {
+	importer := ValidateResourceIDPriorToImport(func(input string) error {
+		if input != "valid" {
+			return fmt.Errorf("expected %q but got %q", "valid", input)
+		}
+		return nil
+	})
+
+	validator, ok := ResourceIDValidatorForImporter(importer)
+	if !ok {
+		t.Fatalf("Expected a Validator for the Importer but didn't get one")
+	}
+	if err := validator("valid"); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if err := validator("invalid"); err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	if _, ok := ResourceIDValidatorForImporter(&schema.ResourceImporter{State: schema.ImportStatePassthrough}); ok {
+		t.Fatalf("Expected no Validator for a Passthrough Importer but got one")
+	}
+
+	if _, ok := ResourceIDValidatorForImporter(nil); ok {
+		t.Fatalf("Expected no Validator for a nil Importer but got one")
+	}
+}
+
+func TestValidateResourceIDPriorToImportMatchesHint(t *testing.T) {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: "test",
+		TypeName:           "ImportHint",
+		ExampleID:          "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.ImportHint/things/thing1",
+		ResourceTypes:      []string{"azurerm_import_hint_thing"},
+		Parser: func(input string) (resourceid.Formatter, error) {
+			if !strings.HasSuffix(input, "/things/thing1") {
+				return nil, fmt.Errorf("not a thing")
+			}
+			return nil, nil
+		},
+	})
+
+	importer := ValidateResourceIDPriorToImport(func(input string) error {
+		return fmt.Errorf("not a widget")
+	})
+
+	resourceData := &schema.ResourceData{}
+	resourceData.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.ImportHint/things/thing1")
+	_, err := importer.State(resourceData, nil)
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), "`azurerm_import_hint_thing`") {
+		t.Fatalf("Expected the error to mention `azurerm_import_hint_thing` but got: %+v", err)
+	}
+}
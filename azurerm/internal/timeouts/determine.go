@@ -2,9 +2,12 @@ package timeouts
 
 import (
 	"context"
+	"log"
+	"path"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
 // ForCreate returns the context wrapped with the timeout for an Create operation
@@ -12,7 +15,7 @@ import (
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForCreate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	return buildWithTimeout(ctx, timeoutFor(ctx, d, schema.TimeoutCreate))
 }
 
 // ForCreateUpdate returns the context wrapped with the timeout for an combined Create/Update operation
@@ -32,7 +35,7 @@ func ForCreateUpdate(ctx context.Context, d *schema.ResourceData) (context.Conte
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForDelete(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	return buildWithTimeout(ctx, timeoutFor(ctx, d, schema.TimeoutDelete))
 }
 
 // ForRead returns the context wrapped with the timeout for an Read operation
@@ -40,7 +43,7 @@ func ForDelete(ctx context.Context, d *schema.ResourceData) (context.Context, co
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForRead(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	return buildWithTimeout(ctx, timeoutFor(ctx, d, schema.TimeoutRead))
 }
 
 // ForUpdate returns the context wrapped with the timeout for an Update operation
@@ -48,9 +51,104 @@ func ForRead(ctx context.Context, d *schema.ResourceData) (context.Context, cont
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForUpdate(ctx context.Context, d *schema.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	return buildWithTimeout(ctx, timeoutFor(ctx, d, schema.TimeoutUpdate))
 }
 
 func buildWithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(ctx, timeout)
 }
+
+type defaultOverridesContextKey struct{}
+
+type defaultOverrides struct {
+	resourceType string
+	defaults     *schema.ResourceTimeout
+	overrides    []features.TimeoutsFeatures
+}
+
+// WithDefaultOverrides returns a copy of the context containing the default Timeouts for the specified Resource
+// Type and the `timeouts` blocks defined within the Provider's `features` block - which the For* functions use
+// to override the Resource's defaults. A `timeouts` block within the Resource itself takes precedence over these.
+func WithDefaultOverrides(ctx context.Context, resourceType string, defaults *schema.ResourceTimeout, overrides []features.TimeoutsFeatures) context.Context {
+	return context.WithValue(ctx, defaultOverridesContextKey{}, defaultOverrides{
+		resourceType: resourceType,
+		defaults:     defaults,
+		overrides:    overrides,
+	})
+}
+
+// timeoutFor returns the Timeout for the specified operation, using any default overrides within the context
+// when the Resource's default Timeout is being used
+func timeoutFor(ctx context.Context, d *schema.ResourceData, key string) time.Duration {
+	timeout := d.Timeout(key)
+
+	v, ok := ctx.Value(defaultOverridesContextKey{}).(defaultOverrides)
+	if !ok || len(v.overrides) == 0 {
+		return timeout
+	}
+
+	// the Plugin SDK doesn't expose whether the Timeout comes from a `timeouts` block within the Resource,
+	// as such we can only override this when it matches the Resource's default
+	if existing := timeoutForKey(v.defaults, key); existing == nil || *existing != timeout {
+		return timeout
+	}
+
+	if override := timeoutForKey(DefaultsFor(v.resourceType, v.defaults, v.overrides), key); override != nil {
+		return *override
+	}
+
+	return timeout
+}
+
+func timeoutForKey(input *schema.ResourceTimeout, key string) *time.Duration {
+	if input == nil {
+		return nil
+	}
+
+	switch key {
+	case schema.TimeoutCreate:
+		return input.Create
+	case schema.TimeoutRead:
+		return input.Read
+	case schema.TimeoutUpdate:
+		return input.Update
+	case schema.TimeoutDelete:
+		return input.Delete
+	}
+
+	return nil
+}
+
+// DefaultsFor returns the default Timeouts for the specified Resource Type, using the last matching
+// override for each operation before falling back to the Resource's defaults.
+//
+// Only operations supported by the Resource (e.g. where a default is defined) can be overridden.
+func DefaultsFor(resourceType string, defaults *schema.ResourceTimeout, overrides []features.TimeoutsFeatures) *schema.ResourceTimeout {
+	if defaults == nil {
+		return nil
+	}
+
+	output := *defaults
+	for _, override := range overrides {
+		if matched, err := path.Match(override.ResourceType, resourceType); err != nil || !matched {
+			continue
+		}
+
+		log.Printf("[DEBUG] Overriding the default Timeouts for %q using %q", resourceType, override.ResourceType)
+		output.Create = overrideTimeout(output.Create, override.Create)
+		output.Read = overrideTimeout(output.Read, override.Read)
+		output.Update = overrideTimeout(output.Update, override.Update)
+		output.Delete = overrideTimeout(output.Delete, override.Delete)
+	}
+
+	return &output
+}
+
+func overrideTimeout(existing *time.Duration, override *time.Duration) *time.Duration {
+	if existing == nil || override == nil {
+		return existing
+	}
+
+	v := *override
+	return &v
+}
@@ -0,0 +1,187 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestDefaultsFor(t *testing.T) {
+	duration := func(input time.Duration) *time.Duration {
+		return &input
+	}
+	defaults := &schema.ResourceTimeout{
+		Create: duration(30 * time.Minute),
+		Read:   duration(5 * time.Minute),
+		Delete: duration(30 * time.Minute),
+	}
+	overrides := []features.TimeoutsFeatures{
+		{
+			ResourceType: "azurerm_kubernetes_*",
+			Create:       duration(3 * time.Hour),
+			Update:       duration(3 * time.Hour),
+		},
+		{
+			ResourceType: "azurerm_kubernetes_cluster",
+			Create:       duration(2 * time.Hour),
+		},
+		{
+			ResourceType: "azurerm_resource_group",
+			Delete:       duration(time.Hour),
+		},
+	}
+
+	testData := []struct {
+		ResourceType string
+		Expected     schema.ResourceTimeout
+	}{
+		{
+			// no matching overrides
+			ResourceType: "azurerm_storage_account",
+			Expected:     *defaults,
+		},
+		{
+			// matched by a glob - Update isn't supported by this resource so can't be overridden
+			ResourceType: "azurerm_kubernetes_cluster_node_pool",
+			Expected: schema.ResourceTimeout{
+				Create: duration(3 * time.Hour),
+				Read:   duration(5 * time.Minute),
+				Delete: duration(30 * time.Minute),
+			},
+		},
+		{
+			// the last matching override takes precedence
+			ResourceType: "azurerm_kubernetes_cluster",
+			Expected: schema.ResourceTimeout{
+				Create: duration(2 * time.Hour),
+				Read:   duration(5 * time.Minute),
+				Delete: duration(30 * time.Minute),
+			},
+		},
+		{
+			ResourceType: "azurerm_resource_group",
+			Expected: schema.ResourceTimeout{
+				Create: duration(30 * time.Minute),
+				Read:   duration(5 * time.Minute),
+				Delete: duration(time.Hour),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.ResourceType)
+
+		actual := DefaultsFor(v.ResourceType, defaults, overrides)
+		if !equalTimeouts(actual.Create, v.Expected.Create) || !equalTimeouts(actual.Read, v.Expected.Read) ||
+			!equalTimeouts(actual.Update, v.Expected.Update) || !equalTimeouts(actual.Delete, v.Expected.Delete) {
+			t.Fatalf("expected %s but got %s", formatTimeouts(v.Expected), formatTimeouts(*actual))
+		}
+	}
+
+	// the defaults for the Resource shouldn't be modified
+	if *defaults.Create != 30*time.Minute {
+		t.Fatalf("expected the defaults not to be modified but got %s", *defaults.Create)
+	}
+}
+
+func TestForCreateWithDefaultOverrides(t *testing.T) {
+	duration := func(input time.Duration) *time.Duration {
+		return &input
+	}
+	defaults := &schema.ResourceTimeout{
+		Create: duration(30 * time.Minute),
+		Delete: duration(30 * time.Minute),
+	}
+	overrides := []features.TimeoutsFeatures{
+		{
+			ResourceType: "azurerm_kubernetes_*",
+			Create:       duration(2 * time.Hour),
+		},
+	}
+
+	testData := []struct {
+		Name         string
+		ResourceType string
+		Configured   *schema.ResourceTimeout
+		Overrides    bool
+		Expected     time.Duration
+	}{
+		{
+			Name:         "No Overrides",
+			ResourceType: "azurerm_kubernetes_cluster",
+			Configured:   defaults,
+			Overrides:    false,
+			Expected:     30 * time.Minute,
+		},
+		{
+			Name:         "Overridden Default",
+			ResourceType: "azurerm_kubernetes_cluster",
+			Configured:   defaults,
+			Overrides:    true,
+			Expected:     2 * time.Hour,
+		},
+		{
+			Name:         "No Matching Override",
+			ResourceType: "azurerm_resource_group",
+			Configured:   defaults,
+			Overrides:    true,
+			Expected:     30 * time.Minute,
+		},
+		{
+			Name:         "Timeouts block within the Resource",
+			ResourceType: "azurerm_kubernetes_cluster",
+			Configured: &schema.ResourceTimeout{
+				Create: duration(45 * time.Minute),
+				Delete: duration(30 * time.Minute),
+			},
+			Overrides: true,
+			Expected:  45 * time.Minute,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		resource := &schema.Resource{
+			Schema:   map[string]*schema.Schema{},
+			Timeouts: v.Configured,
+		}
+		ctx := context.TODO()
+		if v.Overrides {
+			ctx = WithDefaultOverrides(ctx, v.ResourceType, defaults, overrides)
+		}
+
+		actual, cancel := ForCreate(ctx, resource.Data(nil))
+		deadline, ok := actual.Deadline()
+		cancel()
+		if !ok {
+			t.Fatalf("expected a deadline but didn't get one")
+		}
+
+		// allow for the time taken to run the test
+		if remaining := time.Until(deadline); remaining > v.Expected || remaining < v.Expected-time.Minute {
+			t.Fatalf("expected a timeout of %s but got %s", v.Expected, remaining)
+		}
+	}
+}
+
+func equalTimeouts(first *time.Duration, second *time.Duration) bool {
+	if first == nil || second == nil {
+		return first == second
+	}
+
+	return *first == *second
+}
+
+func formatTimeouts(input schema.ResourceTimeout) string {
+	format := func(v *time.Duration) string {
+		if v == nil {
+			return "nil"
+		}
+		return v.String()
+	}
+	return "create=" + format(input.Create) + " read=" + format(input.Read) + " update=" + format(input.Update) + " delete=" + format(input.Delete)
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// renderBody writes the arguments (and blocks) defined in the Schema into the Body, using the specified values.
+// Computed-only and Deprecated fields are omitted, as are Optional fields without a value - whilst Sensitive
+// fields are listed in a comment, since these must be specified manually.
+func renderBody(body *hclwrite.Body, fields map[string]*schema.Schema, values map[string]interface{}) {
+	attributes := make([]string, 0)
+	blocks := make([]string, 0)
+	sensitive := make([]string, 0)
+	rendered := make(map[string]struct{})
+
+	for _, key := range sortedKeys(fields) {
+		field := fields[key]
+		if !field.Required && !field.Optional {
+			continue
+		}
+		if field.Deprecated != "" || field.Removed != "" {
+			continue
+		}
+
+		if field.Sensitive {
+			sensitive = append(sensitive, key)
+			continue
+		}
+
+		value := values[key]
+		if !field.Required && isZeroValue(value) {
+			continue
+		}
+
+		if conflictsWithRenderedField(field, rendered) {
+			continue
+		}
+
+		if _, isBlock := field.Elem.(*schema.Resource); isBlock {
+			blocks = append(blocks, key)
+		} else {
+			attributes = append(attributes, key)
+		}
+		rendered[key] = struct{}{}
+	}
+
+	if len(sensitive) > 0 {
+		appendComments(body, fmt.Sprintf("TODO: the following Sensitive arguments (if used) must be specified manually: %s", strings.Join(sensitive, ", ")))
+	}
+
+	for _, key := range attributes {
+		body.SetAttributeValue(key, toCtyValue(fields[key], values[key]))
+	}
+
+	for _, key := range blocks {
+		elem := fields[key].Elem.(*schema.Resource)
+		for _, item := range listValues(values[key]) {
+			nested, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			block := body.AppendNewBlock(key, nil)
+			renderBody(block.Body(), elem.Schema, nested)
+		}
+	}
+}
+
+// conflictsWithRenderedField returns whether the field conflicts with a field which has already been rendered,
+// for example where the Read function sets both a field and an alternative to it
+func conflictsWithRenderedField(field *schema.Schema, rendered map[string]struct{}) bool {
+	for _, other := range field.ConflictsWith {
+		// ConflictsWith uses the full path to the field, e.g. `block.0.field`
+		segments := strings.Split(other, ".")
+		if _, ok := rendered[segments[len(segments)-1]]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// toCtyValue converts the value of the field (as returned from `d.Get`) into a cty.Value
+func toCtyValue(field *schema.Schema, value interface{}) cty.Value {
+	switch field.Type {
+	case schema.TypeBool:
+		v, _ := value.(bool)
+		return cty.BoolVal(v)
+
+	case schema.TypeInt:
+		v, _ := value.(int)
+		return cty.NumberIntVal(int64(v))
+
+	case schema.TypeFloat:
+		v, _ := value.(float64)
+		return cty.NumberFloatVal(v)
+
+	case schema.TypeString:
+		v, _ := value.(string)
+		return cty.StringVal(v)
+
+	case schema.TypeList, schema.TypeSet:
+		elem := elemSchema(field)
+		items := make([]cty.Value, 0)
+		for _, item := range listValues(value) {
+			items = append(items, toCtyValue(elem, item))
+		}
+		if len(items) == 0 {
+			return cty.ListValEmpty(cty.DynamicPseudoType)
+		}
+		return cty.TupleVal(items)
+
+	case schema.TypeMap:
+		elem := elemSchema(field)
+		v, _ := value.(map[string]interface{})
+		items := make(map[string]cty.Value)
+		for key, item := range v {
+			items[key] = toCtyValue(elem, item)
+		}
+		if len(items) == 0 {
+			return cty.EmptyObjectVal
+		}
+		return cty.ObjectVal(items)
+	}
+
+	return cty.StringVal(fmt.Sprintf("%v", value))
+}
+
+// elemSchema returns the Schema for the elements within a List, Set or Map - which are Strings when not specified
+func elemSchema(field *schema.Schema) *schema.Schema {
+	if elem, ok := field.Elem.(*schema.Schema); ok {
+		return elem
+	}
+
+	return &schema.Schema{
+		Type: schema.TypeString,
+	}
+}
+
+func listValues(input interface{}) []interface{} {
+	switch v := input.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+
+	return []interface{}{}
+}
+
+func isZeroValue(input interface{}) bool {
+	switch v := input.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+
+	return len(listValues(input)) == 0
+}
+
+func sortedKeys(input map[string]*schema.Schema) []string {
+	keys := make([]string, 0)
+	for key := range input {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var invalidResourceNameCharacters = regexp.MustCompile("[^a-z0-9_-]")
+
+// resourceNames determines a unique name for each Resource within the Terraform Configuration
+type resourceNames struct {
+	used map[string]struct{}
+}
+
+func newResourceNames() *resourceNames {
+	return &resourceNames{
+		used: make(map[string]struct{}),
+	}
+}
+
+// forResource returns a unique name for a Resource of the specified type based on the name of the Resource in Azure
+func (n *resourceNames) forResource(resourceType, name string) string {
+	base := invalidResourceNameCharacters.ReplaceAllString(strings.ToLower(name), "_")
+	if base == "" || !(base[0] >= 'a' && base[0] <= 'z' || base[0] == '_') {
+		base = fmt.Sprintf("r_%s", base)
+	}
+
+	output := base
+	for i := 2; ; i++ {
+		key := fmt.Sprintf("%s.%s", resourceType, output)
+		if _, exists := n.used[key]; !exists {
+			n.used[key] = struct{}{}
+			return output
+		}
+
+		output = fmt.Sprintf("%s_%d", base, i)
+	}
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+)
+
+func main() {
+	resourceGroupId := flag.String("resource-group-id", "", "The ID of the Resource Group containing the Resources to generate the Import Commands and Configuration for")
+	outputPath := flag.String("path", ".", "The relative path to the directory where `main.tf` and `import.sh` should be written")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp || *resourceGroupId == "" {
+		flag.Usage()
+		return
+	}
+
+	if err := run(*resourceGroupId, *outputPath); err != nil {
+		panic(err)
+	}
+}
+
+// existingResource is a Resource which exists within Azure, as returned from the `azurerm_resources` Data Source
+type existingResource struct {
+	id           string
+	name         string
+	resourceType string
+}
+
+// importableResource is a Resource which exists within Azure, along with the Terraform Resource(s) it can be imported into
+type importableResource struct {
+	existingResource
+
+	// candidates are the names of the Terraform Resources whose Resource ID parser accepts the ID of this Resource
+	candidates []string
+}
+
+func run(resourceGroupId, outputPath string) error {
+	id, err := parse.ResourceGroupID(resourceGroupId)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %+v", resourceGroupId, err)
+	}
+
+	p := provider.AzureProvider().(*schema.Provider)
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"subscription_id":            id.SubscriptionId,
+		"skip_provider_registration": true,
+		"features":                   []interface{}{map[string]interface{}{}},
+	})
+	if err := p.Configure(config); err != nil {
+		return fmt.Errorf("configuring the Provider: %+v", err)
+	}
+
+	existing, err := listResources(p, id.ResourceGroup)
+	if err != nil {
+		return err
+	}
+
+	// the Resource Group itself isn't returned from the `azurerm_resources` Data Source
+	existing = append([]existingResource{
+		{
+			id:           id.ID(),
+			name:         id.ResourceGroup,
+			resourceType: "Microsoft.Resources/resourceGroups",
+		},
+	}, existing...)
+
+	resources := make([]importableResource, 0)
+	for _, v := range existing {
+		resources = append(resources, importableResource{
+			existingResource: v,
+			candidates:       candidatesForResourceID(p.ResourcesMap, v.id),
+		})
+	}
+
+	file, imports := generate(p, resources)
+
+	if err := ioutil.WriteFile(filepath.Join(outputPath, "main.tf"), file.Bytes(), 0644); err != nil {
+		return fmt.Errorf("writing `main.tf`: %+v", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(outputPath, "import.sh"), []byte(imports), 0755); err != nil {
+		return fmt.Errorf("writing `import.sh`: %+v", err)
+	}
+
+	return nil
+}
+
+// listResources lists the Resources within the specified Resource Group using the `azurerm_resources` Data Source
+func listResources(p *schema.Provider, resourceGroup string) ([]existingResource, error) {
+	dataSource, ok := p.DataSourcesMap["azurerm_resources"]
+	if !ok {
+		return nil, fmt.Errorf("the Data Source `azurerm_resources` was not found")
+	}
+
+	d := dataSource.Data(nil)
+	if err := d.Set("resource_group_name", resourceGroup); err != nil {
+		return nil, fmt.Errorf("setting `resource_group_name`: %+v", err)
+	}
+	if err := dataSource.Read(d, p.Meta()); err != nil {
+		return nil, fmt.Errorf("listing the Resources within Resource Group %q: %+v", resourceGroup, err)
+	}
+
+	output := make([]existingResource, 0)
+	for _, raw := range d.Get("resources").([]interface{}) {
+		v := raw.(map[string]interface{})
+		output = append(output, existingResource{
+			id:           v["id"].(string),
+			name:         v["name"].(string),
+			resourceType: v["type"].(string),
+		})
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].id < output[j].id
+	})
+
+	return output, nil
+}
+
+// candidatesForResourceID returns the names of the Resources whose Importer validates the Resource ID using
+// an ID parser, where that parser accepts the specified Resource ID
+func candidatesForResourceID(resources map[string]*schema.Resource, resourceId string) []string {
+	candidates := make([]string, 0)
+	for name, resource := range resources {
+		validator, ok := azSchema.ResourceIDValidatorForImporter(resource.Importer)
+		if !ok {
+			continue
+		}
+
+		if err := validator(resourceId); err == nil {
+			candidates = append(candidates, name)
+		}
+	}
+
+	sort.Strings(candidates)
+	return candidates
+}
+
+// generate returns the Terraform Configuration and Import Commands for the specified Resources, where the
+// Configuration for each Resource is populated by Importing and then Reading the Resource
+func generate(p *schema.Provider, resources []importableResource) (*hclwrite.File, string) {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+
+	providerBlock := body.AppendNewBlock("provider", []string{"azurerm"})
+	providerBlock.Body().AppendNewBlock("features", nil)
+
+	imports := []string{
+		"#!/usr/bin/env bash",
+		"# NOTE: this file is generated by `generator-import-config` - review the generated configuration in `main.tf` prior to running this",
+		"set -e",
+		"",
+	}
+
+	names := newResourceNames()
+	for _, resource := range resources {
+		body.AppendNewline()
+
+		if len(resource.candidates) == 0 {
+			appendComments(body, fmt.Sprintf("TODO: no Resource could be matched to the %s %q (%s)", resource.resourceType, resource.name, resource.id))
+			continue
+		}
+
+		resourceType := resource.candidates[0]
+		address := fmt.Sprintf("%s.%s", resourceType, names.forResource(resourceType, resource.name))
+
+		if len(resource.candidates) > 1 {
+			appendComments(body, fmt.Sprintf("NOTE: the %s %q could also be imported as one of: %s", resource.resourceType, resource.name, strings.Join(resource.candidates[1:], ", ")))
+		}
+
+		values, err := readResource(p, resourceType, resource.id)
+		if err != nil {
+			log.Printf("[WARN] reading %q as %q: %+v", resource.id, resourceType, err)
+			appendComments(body, fmt.Sprintf("TODO: the %s %q couldn't be read - the configuration for %s must be populated manually: %+v", resource.resourceType, resource.name, address, err))
+		}
+
+		block := body.AppendNewBlock("resource", strings.Split(address, "."))
+		renderBody(block.Body(), p.ResourcesMap[resourceType].Schema, values)
+
+		imports = append(imports, fmt.Sprintf("terraform import %s %q", address, resource.id))
+	}
+
+	return file, strings.Join(imports, "\n") + "\n"
+}
+
+// readResource Imports and then Reads the Resource with the specified ID, returning the value for each field
+func readResource(p *schema.Provider, resourceType, resourceId string) (map[string]interface{}, error) {
+	resource := p.ResourcesMap[resourceType]
+
+	d := resource.Data(nil)
+	d.SetId(resourceId)
+
+	imported, err := resource.Importer.State(d, p.Meta())
+	if err != nil {
+		return nil, fmt.Errorf("importing: %+v", err)
+	}
+	if len(imported) == 0 {
+		return nil, fmt.Errorf("importing: no Resources were returned")
+	}
+
+	d = imported[0]
+	if err := resource.Read(d, p.Meta()); err != nil {
+		return nil, fmt.Errorf("reading: %+v", err)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("reading: the Resource was not found")
+	}
+
+	values := make(map[string]interface{})
+	for key := range resource.Schema {
+		values[key] = d.Get(key)
+	}
+	return values, nil
+}
+
+func appendComments(body *hclwrite.Body, comments ...string) {
+	tokens := make(hclwrite.Tokens, 0)
+	for _, comment := range comments {
+		tokens = append(tokens, &hclwrite.Token{
+			Type:  hclsyntax.TokenComment,
+			Bytes: []byte(fmt.Sprintf("# %s\n", comment)),
+		})
+	}
+	body.AppendUnstructuredTokens(tokens)
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+)
+
+func TestCandidatesForResourceID(t *testing.T) {
+	importerForSegment := func(segment string) *schema.ResourceImporter {
+		return azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			if !strings.Contains(id, segment) {
+				return fmt.Errorf("expected %q to contain %q", id, segment)
+			}
+			return nil
+		})
+	}
+
+	resources := map[string]*schema.Resource{
+		"azurerm_linux_virtual_machine": {
+			Importer: importerForSegment("/virtualMachines/"),
+		},
+		"azurerm_windows_virtual_machine": {
+			Importer: importerForSegment("/virtualMachines/"),
+		},
+		"azurerm_virtual_network": {
+			Importer: importerForSegment("/virtualNetworks/"),
+		},
+		"azurerm_passthrough": {
+			Importer: &schema.ResourceImporter{
+				State: schema.ImportStatePassthrough,
+			},
+		},
+	}
+
+	testData := []struct {
+		Input    string
+		Expected []string
+	}{
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+			Expected: []string{"azurerm_linux_virtual_machine", "azurerm_windows_virtual_machine"},
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: []string{"azurerm_virtual_network"},
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1",
+			Expected: []string{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual := candidatesForResourceID(resources, v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestRenderBody(t *testing.T) {
+	fields := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"count": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"computed": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"deprecated": {
+			Type:       schema.TypeString,
+			Optional:   true,
+			Deprecated: "use `name` instead",
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"rule": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"priority": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+	values := map[string]interface{}{
+		"name":       "example",
+		"enabled":    false,
+		"count":      2,
+		"computed":   "ignored",
+		"deprecated": "ignored",
+		"password":   "ignored",
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+		"rule": []interface{}{
+			map[string]interface{}{
+				"priority": 100,
+			},
+		},
+	}
+
+	file := hclwrite.NewEmptyFile()
+	renderBody(file.Body(), fields, values)
+
+	expected := `# TODO: the following Sensitive arguments (if used) must be specified manually: password
+count = 2
+name  = "example"
+tags = {
+  environment = "production"
+}
+rule {
+  priority = 100
+}
+`
+	if actual := string(file.Bytes()); actual != expected {
+		t.Fatalf("Expected:\n%s\nbut got:\n%s", expected, actual)
+	}
+}
+
+func TestResourceNames(t *testing.T) {
+	names := newResourceNames()
+
+	testData := []struct {
+		ResourceType string
+		Name         string
+		Expected     string
+	}{
+		{
+			ResourceType: "azurerm_virtual_network",
+			Name:         "example-network",
+			Expected:     "example-network",
+		},
+		{
+			ResourceType: "azurerm_virtual_network",
+			Name:         "Example-Network",
+			Expected:     "example-network_2",
+		},
+		{
+			ResourceType: "azurerm_subnet",
+			Name:         "example-network",
+			Expected:     "example-network",
+		},
+		{
+			ResourceType: "azurerm_storage_account",
+			Name:         "1storage.account",
+			Expected:     "r_1storage_account",
+		},
+	}
+
+	for _, v := range testData {
+		if actual := names.forResource(v.ResourceType, v.Name); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q for %q", v.Expected, actual, v.Name)
+		}
+	}
+}
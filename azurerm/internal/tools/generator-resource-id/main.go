@@ -17,6 +17,8 @@ func main() {
 	name := flag.String("name", "", "The name of this Resource Type")
 	id := flag.String("id", "", "An example of this Resource ID")
 	rewrite := flag.Bool("rewrite", false, "Should this Resource ID be parsed insensitively, to workaround an API bug?")
+	resourceTypes := flag.String("resource-types", "", "A comma-separated list of the Terraform Resources which use this Resource ID, e.g. `azurerm_subnet`")
+	caseInsensitiveSegments := flag.String("case-insensitive-segments", "", "A comma-separated list of the segments which should be matched case-insensitively, e.g. `virtualNetworks,subnets`")
 	showHelp := flag.Bool("help", false, "Display this message")
 
 	flag.Parse()
@@ -26,12 +28,12 @@ func main() {
 		return
 	}
 
-	if err := run(*servicePackagePath, *name, *id, *rewrite); err != nil {
+	if err := run(*servicePackagePath, *name, *id, *rewrite, parseCommaSeparatedList(*resourceTypes), parseCommaSeparatedList(*caseInsensitiveSegments)); err != nil {
 		panic(err)
 	}
 }
 
-func run(servicePackagePath, name, id string, shouldRewrite bool) error {
+func run(servicePackagePath, name, id string, shouldRewrite bool, resourceTypes []string, caseInsensitiveSegments []string) error {
 	servicePackage, err := parseServicePackageName(servicePackagePath)
 	if err != nil {
 		return fmt.Errorf("determining Service Package Name for %q: %+v", servicePackagePath, err)
@@ -53,13 +55,14 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 		// e.g. "webtest" in applicationInsights
 		fileName += "_id"
 	}
-	resourceId, err := NewResourceID(name, *servicePackage, id)
+	resourceId, err := NewResourceID(name, *servicePackage, id, caseInsensitiveSegments)
 	if err != nil {
 		return err
 	}
 
 	generator := ResourceIdGenerator{
 		ResourceId:    *resourceId,
+		ResourceTypes: resourceTypes,
 		ShouldRewrite: shouldRewrite,
 	}
 
@@ -86,6 +89,18 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 	return nil
 }
 
+func parseCommaSeparatedList(input string) []string {
+	output := make([]string, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			output = append(output, v)
+		}
+	}
+
+	sort.Strings(output)
+	return output
+}
+
 func parseServicePackageName(relativePath string) (*string, error) {
 	path := relativePath
 	if !filepath.IsAbs(path) {
@@ -165,8 +180,46 @@ type ResourceIdSegment struct {
 
 	// SegmentValue is the value for this segment used in the Resource ID
 	SegmentValue string
+
+	// CaseInsensitive specifies whether the SegmentKey should be matched case-insensitively when parsing
+	CaseInsensitive bool
+
+	// FromScope specifies whether this segment is part of the Scope, rather than the Path, of a Scoped Resource ID
+	// e.g. `managementGroups` for a Resource ID within a Management Group
+	FromScope bool
 }
 
+const (
+	// scopePlaceholder is used as the prefix of the Resource ID for an Extension Resource, which can exist
+	// within any Scope - for example `{scope}/providers/Microsoft.Authorization/locks/lock1`
+	scopePlaceholder = "{scope}"
+
+	// the example Scope is used in place of the `{scope}` placeholder in the generated tests and Example ID
+	exampleScopeSubscriptionId = "12345678-1234-9876-4563-123456789012"
+	exampleScopeResourceGroup  = "resGroup1"
+	exampleScope               = "/subscriptions/" + exampleScopeSubscriptionId + "/resourceGroups/" + exampleScopeResourceGroup
+
+	managementGroupScopePrefix = "/providers/Microsoft.Management/managementGroups/"
+)
+
+// ScopeType is the type of Scope which a Scoped Resource ID exists within, which is the Scope prior to the last
+// `providers` segment in the Resource ID
+type ScopeType string
+
+const (
+	// ScopeTypeNone is used for Resource ID's which exist within a Subscription, parsed using `azure.ParseAzureResourceID`
+	ScopeTypeNone ScopeType = ""
+
+	// ScopeTypeAny is used for Extension Resources, which can exist within any Scope
+	ScopeTypeAny ScopeType = "Any"
+
+	// ScopeTypeTenant is used for Resource ID's which exist within the Tenant, e.g. a Management Group
+	ScopeTypeTenant ScopeType = "Tenant"
+
+	// ScopeTypeManagementGroup is used for Resource ID's which exist within a Management Group
+	ScopeTypeManagementGroup ScopeType = "ManagementGroup"
+)
+
 type ResourceId struct {
 	TypeName string
 	IDFmt    string
@@ -177,15 +230,35 @@ type ResourceId struct {
 	HasResourceGroup  bool
 	HasSubscriptionId bool
 	Segments          []ResourceIdSegment // this has to be a slice not a map since we care about the order
+
+	// ScopeType is the type of Scope this Resource ID exists within, when this isn't a Subscription
+	ScopeType ScopeType
 }
 
-func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId, error) {
+func NewResourceID(typeName, servicePackageName, resourceId string, caseInsensitiveSegments []string) (*ResourceId, error) {
+	scopeType, err := determineScopeType(resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extension Resources exist within any Scope - so the Scope isn't part of the segments
+	idRaw := resourceId
+	if scopeType == ScopeTypeAny {
+		resourceId = strings.TrimPrefix(resourceId, scopePlaceholder)
+		idRaw = exampleScope + resourceId
+	}
+
 	// split the string, but remove the prefix of `/` since it's an empty segment
 	split := strings.Split(strings.TrimPrefix(resourceId, "/"), "/")
 	if len(split)%2 != 0 {
 		return nil, fmt.Errorf("segments weren't divisible by 2: %q", resourceId)
 	}
 
+	caseInsensitive := make(map[string]struct{})
+	for _, v := range caseInsensitiveSegments {
+		caseInsensitive[v] = struct{}{}
+	}
+
 	segments := make([]ResourceIdSegment, 0)
 	for i := 0; i < len(split); i += 2 {
 		key := split[i]
@@ -272,18 +345,38 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 		}
 
 		segment := segmentBuilder(key, value, hasSubscriptionId)
+		if _, ok := caseInsensitive[key]; ok {
+			segment.CaseInsensitive = true
+			delete(caseInsensitive, key)
+		}
+		if scopeType == ScopeTypeManagementGroup && i == 2 {
+			// the Management Group is parsed as the Scope
+			segment.FromScope = true
+		}
 		segments = append(segments, segment)
 	}
 
+	if len(caseInsensitive) > 0 {
+		missing := make([]string, 0)
+		for key := range caseInsensitive {
+			missing = append(missing, key)
+		}
+		sort.Strings(missing)
+		return nil, fmt.Errorf("the case-insensitive segments %q were not found in %q", strings.Join(missing, ", "), resourceId)
+	}
+
 	// finally build up the format string based on this information
 	fmtString := resourceId
+	if scopeType == ScopeTypeAny {
+		fmtString = "%s" + resourceId
+	}
 	hasResourceGroup := false
 	hasSubscriptionId := false
 	for _, segment := range segments {
-		if strings.EqualFold(segment.SegmentKey, "subscriptions") {
+		if scopeType == ScopeTypeNone && strings.EqualFold(segment.SegmentKey, "subscriptions") {
 			hasSubscriptionId = true
 		}
-		if strings.EqualFold(segment.SegmentKey, "resourceGroups") {
+		if scopeType == ScopeTypeNone && strings.EqualFold(segment.SegmentKey, "resourceGroups") {
 			hasResourceGroup = true
 		}
 
@@ -293,22 +386,64 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 
 	return &ResourceId{
 		IDFmt:              fmtString,
-		IDRaw:              resourceId,
+		IDRaw:              idRaw,
 		HasResourceGroup:   hasResourceGroup,
 		HasSubscriptionId:  hasSubscriptionId,
 		Segments:           segments,
 		ServicePackageName: servicePackageName,
 		TypeName:           typeName,
+		ScopeType:          scopeType,
 	}, nil
 }
 
+// determineScopeType determines the type of Scope the Resource ID exists within - Resource ID's within a
+// Subscription are parsed using `azure.ParseAzureResourceID`, whereas Resource ID's for Extension Resources
+// (prefixed with `{scope}`) and those within a Tenant or Management Group are parsed as Scoped Resource ID's
+func determineScopeType(resourceId string) (ScopeType, error) {
+	if strings.HasPrefix(resourceId, scopePlaceholder) {
+		if !strings.HasPrefix(strings.TrimPrefix(resourceId, scopePlaceholder), "/providers/") {
+			return "", fmt.Errorf("expected the Resource ID %q to be in the format `{scope}/providers/{namespace}/{type}/{name}`", resourceId)
+		}
+
+		return ScopeTypeAny, nil
+	}
+
+	if strings.HasPrefix(resourceId, "/subscriptions/") {
+		return ScopeTypeNone, nil
+	}
+
+	if !strings.HasPrefix(resourceId, "/providers/") {
+		return "", fmt.Errorf("expected the Resource ID %q to start with `/subscriptions/`, `/providers/` or `{scope}`", resourceId)
+	}
+
+	scope := resourceId[0:strings.LastIndex(resourceId, "/providers/")]
+	if scope == "" {
+		return ScopeTypeTenant, nil
+	}
+
+	if strings.HasPrefix(scope, managementGroupScopePrefix) && !strings.Contains(strings.TrimPrefix(scope, managementGroupScopePrefix), "/") {
+		return ScopeTypeManagementGroup, nil
+	}
+
+	return "", fmt.Errorf("the Scope %q for the Resource ID %q isn't supported - use `{scope}` for Extension Resources", scope, resourceId)
+}
+
 type ResourceIdGenerator struct {
 	ResourceId
 
+	// ResourceTypes are the Terraform Resources which use this Resource ID, e.g. `azurerm_subnet`
+	ResourceTypes []string
+
 	ShouldRewrite bool
 }
 
 func (id ResourceIdGenerator) Code() string {
+	// Scoped Resource ID's are parsed using the `resourceid` package rather than `azure.ParseAzureResourceID`
+	azureImport := "\n\t\"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure\""
+	if id.ScopeType != ScopeTypeNone {
+		azureImport = ""
+	}
+
 	return fmt.Sprintf(`
 package parse
 
@@ -317,8 +452,8 @@ package parse
 import (
 	"fmt"
 	"strings"
-
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+%s
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 %s
@@ -327,11 +462,15 @@ import (
 %s
 %s
 %s
-`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive())
+%s
+`, azureImport, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive(), id.codeForRegistration())
 }
 
 func (id ResourceIdGenerator) codeForType() string {
 	fields := make([]string, 0)
+	if id.ScopeType == ScopeTypeAny {
+		fields = append(fields, "\tScope\tresourceid.Scope")
+	}
 	for _, segment := range id.Segments {
 		fields = append(fields, fmt.Sprintf("\t%s\tstring", segment.FieldName))
 	}
@@ -352,10 +491,14 @@ func (id ResourceIdGenerator) codeForConstructor() string {
 		assignments = append(assignments, fmt.Sprintf("\t\t%s:\t%s,", segment.FieldName, segment.ArgumentName))
 	}
 
-	argumentsStr := strings.Join(arguments, ", ")
+	argumentsStr := fmt.Sprintf("%s string", strings.Join(arguments, ", "))
+	if id.ScopeType == ScopeTypeAny {
+		argumentsStr = fmt.Sprintf("scope resourceid.Scope, %s", argumentsStr)
+		assignments = append([]string{"\t\tScope:\tscope,"}, assignments...)
+	}
 	assignmentsStr := strings.Join(assignments, "\n")
 	return fmt.Sprintf(`
-func New%[1]sID(%[2]s string) %[1]sId {
+func New%[1]sID(%[2]s) %[1]sId {
 	return %[1]sId{
 %[3]s
 	}
@@ -391,6 +534,9 @@ func (id ResourceIdGenerator) codeForDescription() string {
 	for i := len(formatKeys); i != 0; i-- {
 		reversedKeys = append(reversedKeys, formatKeys[i-1])
 	}
+	if id.ScopeType == ScopeTypeAny {
+		reversedKeys = append(reversedKeys, "\t\tfmt.Sprintf(\"Scope %s\", id.Scope),")
+	}
 
 	formatKeysString := strings.Join(reversedKeys, "\n")
 	return fmt.Sprintf(`
@@ -406,6 +552,9 @@ func (id %[1]sId) String() string {
 
 func (id ResourceIdGenerator) codeForFormatter() string {
 	formatKeys := make([]string, 0)
+	if id.ScopeType == ScopeTypeAny {
+		formatKeys = append(formatKeys, "id.Scope.ID()")
+	}
 	for _, segment := range id.Segments {
 		formatKeys = append(formatKeys, fmt.Sprintf("id.%s", segment.FieldName))
 	}
@@ -419,6 +568,10 @@ func (id %[1]sId) ID() string {
 }
 
 func (id ResourceIdGenerator) codeForParser() string {
+	if id.ScopeType != ScopeTypeNone {
+		return id.codeForScopedParser(false)
+	}
+
 	directAssignments := make([]string, 0)
 	if id.HasSubscriptionId {
 		directAssignments = append(directAssignments, "\t\tSubscriptionId: id.SubscriptionID,")
@@ -441,6 +594,11 @@ func (id ResourceIdGenerator) codeForParser() string {
 			continue
 		}
 
+		if segment.CaseInsensitive {
+			parserStatements = append(parserStatements, fmt.Sprintf(insensitiveSegmentParserFmt, segment.FieldName, segment.SegmentKey))
+			continue
+		}
+
 		fmtString := "\tif resourceId.%[1]s, err = id.PopSegment(\"%[2]s\"); err != nil {\n\t\treturn nil, err\n\t}"
 		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey))
 	}
@@ -474,6 +632,10 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
 		return ""
 	}
 
+	if id.ScopeType != ScopeTypeNone {
+		return id.codeForScopedParser(true)
+	}
+
 	directAssignments := make([]string, 0)
 	if id.HasSubscriptionId {
 		directAssignments = append(directAssignments, "\t\tSubscriptionId: id.SubscriptionID,")
@@ -496,9 +658,68 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
 			continue
 		}
 
-		// NOTE: This becomes dramatically simpler long-term - but for now has to be long-winded
-		// to avoid subtle changes to resources until this is threaded through everywhere
-		fmtString := `
+		parserStatements = append(parserStatements, fmt.Sprintf(insensitiveSegmentParserFmt, segment.FieldName, segment.SegmentKey))
+	}
+	parserStatementsStr := strings.Join(parserStatements, "\n")
+	return fmt.Sprintf(`
+// %[1]sIDInsensitively parses an %[1]s ID into an %[1]sId struct, insensitively
+// This should only be used to parse an ID for rewriting, the %[1]sID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func %[1]sIDInsensitively(input string) (*%[1]sId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := %[1]sId{
+%[2]s
+	}
+
+%[3]s
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+`, id.TypeName, directAssignmentsStr, parserStatementsStr)
+}
+
+func (id ResourceIdGenerator) codeForRegistration() string {
+	resourceTypes := ""
+	if len(id.ResourceTypes) > 0 {
+		quoted := make([]string, 0)
+		for _, v := range id.ResourceTypes {
+			quoted = append(quoted, fmt.Sprintf("%q", v))
+		}
+		resourceTypes = fmt.Sprintf("\t\tResourceTypes:\t[]string{%s},\n", strings.Join(quoted, ", "))
+	}
+
+	return fmt.Sprintf(`
+func init() {
+	resourceid.Register(resourceid.Registration{
+		ServicePackageName: %[2]q,
+		TypeName: %[1]q,
+		ExampleID: %[3]q,
+%[4]s		Parser: func(input string) (resourceid.Formatter, error) {
+			id, err := %[1]sID(input)
+			if err != nil {
+				return nil, err
+			}
+			return id, nil
+		},
+	})
+}
+`, id.TypeName, id.ServicePackageName, id.IDRaw, resourceTypes)
+}
+
+// NOTE: This becomes dramatically simpler long-term - but for now has to be long-winded
+// to avoid subtle changes to resources until this is threaded through everywhere
+const insensitiveSegmentParserFmt = `
   // find the correct casing for the '%[2]s' segment
   %[2]sKey := "%[2]s"
   for key := range id.Path {
@@ -511,22 +732,59 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
     return nil, err
   }
 `
-		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey))
+
+// codeForScopedParser returns the Parser for a Resource ID which exists within a Scope, which is parsed using
+// `resourceid.ParseScopedResourceID` - optionally parsing all of the segments insensitively
+func (id ResourceIdGenerator) codeForScopedParser(insensitively bool) string {
+	directAssignments := make([]string, 0)
+	scopeValidation := ""
+	switch id.ScopeType {
+	case ScopeTypeAny:
+		directAssignments = append(directAssignments, "\t\tScope: id.Scope,")
+
+	case ScopeTypeTenant, ScopeTypeManagementGroup:
+		scopeValidation = fmt.Sprintf(`
+	if id.Scope.Type != resourceid.ScopeType%[1]s {
+		return nil, fmt.Errorf("expected the ID to be within a %%s but got %%s", resourceid.ScopeType%[1]s, id.Scope)
 	}
-	parserStatementsStr := strings.Join(parserStatements, "\n")
-	return fmt.Sprintf(`
-// %[1]sIDInsensitively parses an %[1]s ID into an %[1]sId struct, insensitively
+`, id.ScopeType)
+	}
+
+	parserStatements := make([]string, 0)
+	for _, segment := range id.Segments {
+		if segment.FromScope {
+			directAssignments = append(directAssignments, fmt.Sprintf("\t\t%s: id.Scope.ManagementGroupName,", segment.FieldName))
+			continue
+		}
+
+		popSegment := "PopSegment"
+		if insensitively || segment.CaseInsensitive {
+			popSegment = "PopSegmentInsensitively"
+		}
+		fmtString := "\tif resourceId.%[1]s, err = id.%[3]s(\"%[2]s\"); err != nil {\n\t\treturn nil, err\n\t}"
+		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey, popSegment))
+	}
+
+	functionName := fmt.Sprintf("%sID", id.TypeName)
+	description := fmt.Sprintf("// %[1]sID parses a %[1]s ID into an %[1]sId struct", id.TypeName)
+	if insensitively {
+		functionName = fmt.Sprintf("%sIDInsensitively", id.TypeName)
+		description = fmt.Sprintf(`// %[1]sIDInsensitively parses an %[1]s ID into an %[1]sId struct, insensitively
 // This should only be used to parse an ID for rewriting, the %[1]sID
 // method should be used instead for validation etc.
 //
 // Whilst this may seem strange, this enables Terraform have consistent casing
-// which works around issues in Core, whilst handling broken API responses.
-func %[1]sIDInsensitively(input string) (*%[1]sId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+// which works around issues in Core, whilst handling broken API responses.`, id.TypeName)
+	}
+
+	return fmt.Sprintf(`
+%[5]s
+func %[6]s(input string) (*%[1]sId, error) {
+	id, err := resourceid.ParseScopedResourceID(input)
 	if err != nil {
 		return nil, err
 	}
-
+%[4]s
 	resourceId := %[1]sId{
 %[2]s
 	}
@@ -539,7 +797,7 @@ func %[1]sIDInsensitively(input string) (*%[1]sId, error) {
 
 	return &resourceId, nil
 }
-`, id.TypeName, directAssignmentsStr, parserStatementsStr)
+`, id.TypeName, strings.Join(directAssignments, "\n"), strings.Join(parserStatements, "\n"), scopeValidation, description, functionName)
 }
 
 func (id ResourceIdGenerator) TestCode() string {
@@ -562,6 +820,9 @@ import (
 
 func (id ResourceIdGenerator) testCodeForFormatter() string {
 	arguments := make([]string, 0)
+	if id.ScopeType == ScopeTypeAny {
+		arguments = append(arguments, exampleScopeCode)
+	}
 	for _, segment := range id.Segments {
 		arguments = append(arguments, fmt.Sprintf("%q", segment.SegmentValue))
 	}
@@ -609,12 +870,12 @@ func (id ResourceIdGenerator) testCodeForParser() string {
 		assignmentsFmt := "\t\tif actual.%[1]s != v.Expected.%[1]s {\n\t\t\tt.Fatalf(\"Expected %%q but got %%q for %[1]s\", v.Expected.%[1]s, actual.%[1]s)\n\t\t}"
 		assignmentChecks = append(assignmentChecks, fmt.Sprintf(assignmentsFmt, segment.FieldName))
 	}
+	if id.ScopeType == ScopeTypeAny {
+		assignmentChecks = append([]string{scopeAssignmentCheck}, assignmentChecks...)
+	}
 
 	// add a successful test case
-	expectAssignments := make([]string, 0)
-	for _, segment := range id.Segments {
-		expectAssignments = append(expectAssignments, fmt.Sprintf("\t\t\t\t%s:\t%q,", segment.FieldName, segment.SegmentValue))
-	}
+	expectAssignments := id.testCodeForExpectedAssignments(exampleScopeCode)
 	testCases = append(testCases, fmt.Sprintf(`
 		{
 			// valid
@@ -624,6 +885,18 @@ func (id ResourceIdGenerator) testCodeForParser() string {
 			},
 		},
 `, id.IDRaw, id.TypeName, strings.Join(expectAssignments, "\n")))
+	testCases = append(testCases, id.testCasesForOtherScopes()...)
+
+	if caseInsensitiveId := id.withCaseInsensitiveSegmentsTransformed(); caseInsensitiveId != id.IDRaw {
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// mixed-cased case-insensitive segments
+			Input: "%[1]s",
+			Expected: &%[2]sId{
+%[3]s
+			},
+		},`, caseInsensitiveId, id.TypeName, strings.Join(expectAssignments, "\n")))
+	}
 
 	// add an intentionally failing upper-cased test case
 	testCases = append(testCases, fmt.Sprintf(`
@@ -701,12 +974,12 @@ func (id ResourceIdGenerator) testCodeForParserInsensitive() string {
 		assignmentsFmt := "\t\tif actual.%[1]s != v.Expected.%[1]s {\n\t\t\tt.Fatalf(\"Expected %%q but got %%q for %[1]s\", v.Expected.%[1]s, actual.%[1]s)\n\t\t}"
 		assignmentChecks = append(assignmentChecks, fmt.Sprintf(assignmentsFmt, segment.FieldName))
 	}
+	if id.ScopeType == ScopeTypeAny {
+		assignmentChecks = append([]string{scopeAssignmentCheck}, assignmentChecks...)
+	}
 
 	// add a successful test case
-	expectAssignments := make([]string, 0)
-	for _, segment := range id.Segments {
-		expectAssignments = append(expectAssignments, fmt.Sprintf("\t\t\t\t%s:\t%q,", segment.FieldName, segment.SegmentValue))
-	}
+	expectAssignments := id.testCodeForExpectedAssignments(exampleScopeCode)
 	testCases = append(testCases, fmt.Sprintf(`
 		{
 			// valid
@@ -716,6 +989,7 @@ func (id ResourceIdGenerator) testCodeForParserInsensitive() string {
 			},
 		},
 `, id.IDRaw, id.TypeName, strings.Join(expectAssignments, "\n")))
+	testCases = append(testCases, id.testCasesForOtherScopes()...)
 
 	var testCaseWithTransformation = func(testCaseName string, transform func(in string) string) string {
 		resourceIdWithTransform := id.IDRaw
@@ -725,6 +999,11 @@ func (id ResourceIdGenerator) testCodeForParserInsensitive() string {
 				continue
 			}
 
+			// the Scope is always parsed case-sensitively
+			if segment.FromScope {
+				continue
+			}
+
 			transformedKey := transform(segment.SegmentKey)
 			resourceIdWithTransform = strings.Replace(resourceIdWithTransform, segment.SegmentKey, transformedKey, 1)
 		}
@@ -785,6 +1064,87 @@ func Test%[1]sIDInsensitively(t *testing.T) {
 `, id.TypeName, testCasesStr, assignmentCheckStr)
 }
 
+const (
+	// exampleScopeCode is the code for the example Scope used in the generated tests for Extension Resources
+	exampleScopeCode = `resourceid.NewResourceGroupScope("` + exampleScopeSubscriptionId + `", "` + exampleScopeResourceGroup + `")`
+
+	scopeAssignmentCheck = "\t\tif actual.Scope.ID() != v.Expected.Scope.ID() {\n\t\t\tt.Fatalf(\"Expected %q but got %q for Scope\", v.Expected.Scope.ID(), actual.Scope.ID())\n\t\t}"
+)
+
+// testCodeForExpectedAssignments returns the assignments for the expected Resource ID in the generated tests,
+// using the specified code for the Scope (for Extension Resources)
+func (id ResourceIdGenerator) testCodeForExpectedAssignments(scopeCode string) []string {
+	expectAssignments := make([]string, 0)
+	if id.ScopeType == ScopeTypeAny {
+		expectAssignments = append(expectAssignments, fmt.Sprintf("\t\t\t\tScope:\t%s,", scopeCode))
+	}
+	for _, segment := range id.Segments {
+		expectAssignments = append(expectAssignments, fmt.Sprintf("\t\t\t\t%s:\t%q,", segment.FieldName, segment.SegmentValue))
+	}
+	return expectAssignments
+}
+
+// testCasesForOtherScopes returns the valid test cases for an Extension Resource within a Subscription
+// and within another Resource, in addition to the Resource Group used in the Example ID
+func (id ResourceIdGenerator) testCasesForOtherScopes() []string {
+	if id.ScopeType != ScopeTypeAny {
+		return []string{}
+	}
+
+	resourceScopeId := exampleScope + "/providers/Microsoft.Storage/storageAccounts/account1"
+	scopes := []struct {
+		description string
+		scopeId     string
+		scopeCode   string
+	}{
+		{
+			description: "valid within a Subscription",
+			scopeId:     "/subscriptions/" + exampleScopeSubscriptionId,
+			scopeCode:   fmt.Sprintf("resourceid.NewSubscriptionScope(%q)", exampleScopeSubscriptionId),
+		},
+		{
+			description: "valid within a Resource",
+			scopeId:     resourceScopeId,
+			scopeCode:   fmt.Sprintf("resourceid.Scope{Type: resourceid.ScopeTypeResource, ResourceId: %q}", resourceScopeId),
+		},
+	}
+
+	testCases := make([]string, 0)
+	for _, scope := range scopes {
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// %[1]s
+			Input: "%[2]s",
+			Expected: &%[3]sId{
+%[4]s
+			},
+		},`, scope.description, scope.scopeId+strings.TrimPrefix(id.IDRaw, exampleScope), id.TypeName, strings.Join(id.testCodeForExpectedAssignments(scope.scopeCode), "\n")))
+	}
+	return testCases
+}
+
+// withCaseInsensitiveSegmentsTransformed returns the Example ID with the keys of the case-insensitive
+// segments in mixed-case, which should be parsed successfully
+func (id ResourceIdGenerator) withCaseInsensitiveSegmentsTransformed() string {
+	output := id.IDRaw
+	for _, segment := range id.Segments {
+		if !segment.CaseInsensitive {
+			continue
+		}
+
+		transformed := make([]rune, 0)
+		for i, c := range segment.SegmentKey {
+			if i%2 == 0 {
+				transformed = append(transformed, unicode.ToUpper(c))
+			} else {
+				transformed = append(transformed, unicode.ToLower(c))
+			}
+		}
+		output = strings.Replace(output, fmt.Sprintf("/%s/%s", segment.SegmentKey, segment.SegmentValue), fmt.Sprintf("/%s/%s", string(transformed), segment.SegmentValue), 1)
+	}
+	return output
+}
+
 func (id ResourceIdGenerator) ValidatorCode() string {
 	return fmt.Sprintf(`package validate
 
@@ -54,3 +54,104 @@ func Test(t *testing.T) {
 		}
 	}
 }
+
+func TestDetermineScopeType(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected ScopeType
+		err      bool
+	}{
+		{
+			in:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			expected: ScopeTypeNone,
+		},
+		{
+			in:       "{scope}/providers/Microsoft.Authorization/locks/lock1",
+			expected: ScopeTypeAny,
+		},
+		{
+			in:  "{scope}/locks/lock1",
+			err: true,
+		},
+		{
+			in:       "/providers/Microsoft.Foo/things/thing1",
+			expected: ScopeTypeTenant,
+		},
+		{
+			in:       "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Foo/things/thing1",
+			expected: ScopeTypeManagementGroup,
+		},
+		{
+			in:  "/providers/Microsoft.Foo/things/thing1/providers/Microsoft.Bar/others/other1",
+			err: true,
+		},
+		{
+			in:  "/things/thing1",
+			err: true,
+		},
+	}
+
+	for idx, c := range cases {
+		actual, err := determineScopeType(c.in)
+		if err != nil {
+			if c.err {
+				continue
+			}
+
+			t.Fatalf("%d. expected no error but got: %+v", idx, err)
+		}
+		if c.err {
+			t.Fatalf("%d. expected an error but didn't get one", idx)
+		}
+
+		if actual != c.expected {
+			t.Fatalf("%d. %q (expect) != %q (actual)", idx, c.expected, actual)
+		}
+	}
+}
+
+func TestNewResourceIDWithinAScope(t *testing.T) {
+	id, err := NewResourceID("ManagementLock", "resource", "{scope}/providers/Microsoft.Authorization/locks/lock1", nil)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	if id.IDFmt != "%s/providers/Microsoft.Authorization/locks/%s" {
+		t.Fatalf("unexpected IDFmt %q", id.IDFmt)
+	}
+	if id.IDRaw != exampleScope+"/providers/Microsoft.Authorization/locks/lock1" {
+		t.Fatalf("unexpected IDRaw %q", id.IDRaw)
+	}
+	if id.HasSubscriptionId || id.HasResourceGroup {
+		t.Fatalf("expected the Subscription ID and Resource Group to be part of the Scope")
+	}
+	if len(id.Segments) != 1 || id.Segments[0].FieldName != "LockName" {
+		t.Fatalf("expected a single segment `LockName` but got %+v", id.Segments)
+	}
+
+	id, err = NewResourceID("Thing", "foo", "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Foo/things/thing1", nil)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if len(id.Segments) != 2 || !id.Segments[0].FromScope || id.Segments[1].FromScope {
+		t.Fatalf("expected only the Management Group segment to be from the Scope but got %+v", id.Segments)
+	}
+}
+
+func TestNewResourceIDCaseInsensitiveSegments(t *testing.T) {
+	resourceId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	id, err := NewResourceID("Subnet", "network", resourceId, []string{"subnets"})
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	for _, segment := range id.Segments {
+		if segment.CaseInsensitive != (segment.SegmentKey == "subnets") {
+			t.Fatalf("unexpected value for CaseInsensitive for the segment %q", segment.SegmentKey)
+		}
+	}
+
+	if _, err := NewResourceID("Subnet", "network", resourceId, []string{"networkInterfaces"}); err == nil {
+		t.Fatalf("expected an error for an unknown case-insensitive segment but didn't get one")
+	}
+}
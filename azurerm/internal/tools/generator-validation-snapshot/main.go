@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/go-azure-helpers/sender"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/snapshot"
+)
+
+func main() {
+	filePath := flag.String("path", "", "The relative path to the root directory")
+	environment := flag.String("environment", "public", "The Azure Environment to generate the Snapshot for (public, usgovernment, china or german)")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	if err := run(*filePath, *environment); err != nil {
+		panic(err)
+	}
+}
+
+func run(rootDirectory, environmentName string) error {
+	ctx := context.Background()
+
+	env, err := authentication.DetermineEnvironment(environmentName)
+	if err != nil {
+		return fmt.Errorf("determining Azure Environment %q: %+v", environmentName, err)
+	}
+
+	fileName, ok := snapshot.FileName(env.Name)
+	if !ok {
+		return fmt.Errorf("Snapshots aren't supported for the Azure Environment %q", env.Name)
+	}
+
+	client, err := buildProvidersClient(environmentName, env.ActiveDirectoryEndpoint, env.TokenAudience, env.ResourceManagerEndpoint)
+	if err != nil {
+		return err
+	}
+
+	locations, err := location.AvailableLocations(ctx, env)
+	if err != nil {
+		return fmt.Errorf("retrieving Locations: %+v", err)
+	}
+	if locations.Locations == nil {
+		return fmt.Errorf("retrieving Locations: no Locations were returned for %q", env.Name)
+	}
+
+	providers, err := resourceproviders.AvailableResourceProviders(ctx, client)
+	if err != nil {
+		return fmt.Errorf("retrieving Resource Providers: %+v", err)
+	}
+
+	output := snapshot.Snapshot{
+		Version:           snapshot.Version,
+		Environment:       env.Name,
+		GeneratedOn:       time.Now().UTC().Format("2006-01-02"),
+		Locations:         sortedUnique(*locations.Locations),
+		ResourceProviders: sortedUnique(*providers),
+	}
+
+	contents, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing Snapshot: %+v", err)
+	}
+
+	outputPath := filepath.Join(rootDirectory, "azurerm", "internal", "snapshot", "data", fileName)
+	if err := ioutil.WriteFile(outputPath, append(contents, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing %q: %+v", outputPath, err)
+	}
+
+	return nil
+}
+
+// buildProvidersClient builds a Resource Providers client, authenticating using either a Service Principal
+// (using the `ARM_*` Environment Variables) or the Azure CLI
+func buildProvidersClient(environmentName, activeDirectoryEndpoint, tokenAudience, resourceManagerEndpoint string) (*resources.ProvidersClient, error) {
+	builder := authentication.Builder{
+		SubscriptionID: os.Getenv("ARM_SUBSCRIPTION_ID"),
+		ClientID:       os.Getenv("ARM_CLIENT_ID"),
+		TenantID:       os.Getenv("ARM_TENANT_ID"),
+		ClientSecret:   os.Getenv("ARM_CLIENT_SECRET"),
+		Environment:    environmentName,
+
+		SupportsClientSecretAuth: true,
+		SupportsAzureCliToken:    true,
+	}
+	config, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("building Authentication Config: %+v", err)
+	}
+
+	oauthConfig, err := config.BuildOAuthConfig(activeDirectoryEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("building OAuth Config: %+v", err)
+	}
+
+	s := sender.BuildSender("AzureRM")
+	authorizer, err := config.GetAuthorizationToken(s, oauthConfig, tokenAudience)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining an Authorization Token: %+v", err)
+	}
+
+	client := resources.NewProvidersClientWithBaseURI(resourceManagerEndpoint, config.SubscriptionID)
+	client.Authorizer = authorizer
+	client.Sender = s
+	return &client, nil
+}
+
+func sortedUnique(input []string) []string {
+	unique := make(map[string]struct{})
+	for _, v := range input {
+		unique[v] = struct{}{}
+	}
+
+	output := make([]string, 0)
+	for v := range unique {
+		output = append(output, v)
+	}
+	sort.Strings(output)
+	return output
+}